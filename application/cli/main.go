@@ -68,6 +68,7 @@ func main() {
 
 	depositsService := deposits.NewService(
 		depositsStore.NewStore(db),
+		investorsService,
 	)
 
 	PlayThrough(investorsService, depositsService)
@@ -82,6 +83,22 @@ func PlayThrough(investorsService *investors.Service, depositsService *deposits.
 		panic(err)
 	}
 
+	// Profile needed for the investor to be eligible for the deposit's wrappers
+	dateOfBirth, err := investors.ParseDateOfBirth("1990-01-31")
+	if err != nil {
+		panic(err)
+	}
+	address, err := investors.NewAddress([]string{"10 Downing Street", "London"}, "SW1A 2AA", "GB")
+	if err != nil {
+		panic(err)
+	}
+	investor.Profile = investors.Profile{
+		DateOfBirth:  dateOfBirth,
+		NINumber:     "AB123456C",
+		TaxResidency: investors.TaxResidencyUK,
+		Address:      address,
+	}
+
 	// Onboard
 	err = investorsService.Onboard(ctx, investor)
 	if err != nil {
@@ -104,7 +121,7 @@ func PlayThrough(investorsService *investors.Service, depositsService *deposits.
 	// Add GIA account to Pot A
 	accountGIA, err := deposits.NewAccount(
 		deposits.WrapperTypeGIA,
-		10_000,
+		deposits.Money{Amount: 10_000, Currency: deposits.CurrencyGBP},
 	)
 	if err != nil {
 		panic(err)
@@ -117,7 +134,7 @@ func PlayThrough(investorsService *investors.Service, depositsService *deposits.
 	// Add ISA account to Pot A
 	accountISA, err := deposits.NewAccount(
 		deposits.WrapperTypeISA,
-		20_000,
+		deposits.Money{Amount: 20_000, Currency: deposits.CurrencyGBP},
 	)
 	if err != nil {
 		panic(err)
@@ -130,7 +147,7 @@ func PlayThrough(investorsService *investors.Service, depositsService *deposits.
 	// Add SIPP account to Pot A
 	accountSIPP, err := deposits.NewAccount(
 		deposits.WrapperTypeSIPP,
-		50_000,
+		deposits.Money{Amount: 50_000, Currency: deposits.CurrencyGBP},
 	)
 	if err != nil {
 		panic(err)
@@ -150,7 +167,7 @@ func PlayThrough(investorsService *investors.Service, depositsService *deposits.
 	// Add GIA account to Pot B
 	accountGIA2, err := deposits.NewAccount(
 		deposits.WrapperTypeGIA,
-		20_000,
+		deposits.Money{Amount: 20_000, Currency: deposits.CurrencyGBP},
 	)
 	if err != nil {
 		panic(err)
@@ -174,13 +191,13 @@ func PlayThrough(investorsService *investors.Service, depositsService *deposits.
 	}
 
 	// We can create receipts
-	receipt, err := deposits.NewReceipt(5_000)
+	receipt, err := deposits.NewReceipt(deposits.Money{Amount: 5_000, Currency: deposits.CurrencyGBP})
 	if err != nil {
 		panic(err)
 	}
 
 	// And receive them against an account
-	err = depositsService.ReceiveReceipt(ctx, accountGIA.Id, receipt)
+	_, _, err = depositsService.ReceiveReceipt(ctx, accountGIA.Id, receipt)
 	if err != nil {
 		panic(err)
 	}
@@ -199,31 +216,31 @@ func PlayThrough(investorsService *investors.Service, depositsService *deposits.
 	fmt.Println(string(data))
 
 	// GIA Accounts can go over
-	receipt, err = deposits.NewReceipt(100_000)
+	receipt, err = deposits.NewReceipt(deposits.Money{Amount: 100_000, Currency: deposits.CurrencyGBP})
 	if err != nil {
 		panic(err)
 	}
-	err = depositsService.ReceiveReceipt(ctx, accountGIA.Id, receipt)
+	_, _, err = depositsService.ReceiveReceipt(ctx, accountGIA.Id, receipt)
 	if err != nil {
 		panic(err)
 	}
 
 	// ISA Accounts can't go over
-	receipt, err = deposits.NewReceipt(100_000)
+	receipt, err = deposits.NewReceipt(deposits.Money{Amount: 100_000, Currency: deposits.CurrencyGBP})
 	if err != nil {
 		panic(err)
 	}
-	err = depositsService.ReceiveReceipt(ctx, accountISA.Id, receipt)
+	_, _, err = depositsService.ReceiveReceipt(ctx, accountISA.Id, receipt)
 	if err == nil {
 		panic("ISA Account was allowed to go over the limit")
 	}
 
 	// SIPP Accounts can't go over
-	receipt, err = deposits.NewReceipt(100_000)
+	receipt, err = deposits.NewReceipt(deposits.Money{Amount: 100_000, Currency: deposits.CurrencyGBP})
 	if err != nil {
 		panic(err)
 	}
-	err = depositsService.ReceiveReceipt(ctx, accountSIPP.Id, receipt)
+	_, _, err = depositsService.ReceiveReceipt(ctx, accountSIPP.Id, receipt)
 	if err == nil {
 		panic("SIPP Account was allowed to go over the limit")
 	}
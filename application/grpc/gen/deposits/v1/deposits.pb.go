@@ -9,6 +9,7 @@ package depositsv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,13 +21,286 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ReliefClaimBatchStatus int32
+
+const (
+	ReliefClaimBatchStatus_RELIEF_CLAIM_BATCH_STATUS_UNSPECIFIED ReliefClaimBatchStatus = 0
+	ReliefClaimBatchStatus_RELIEF_CLAIM_BATCH_STATUS_SUBMITTED   ReliefClaimBatchStatus = 1
+	ReliefClaimBatchStatus_RELIEF_CLAIM_BATCH_STATUS_PAID        ReliefClaimBatchStatus = 2
+)
+
+// Enum value maps for ReliefClaimBatchStatus.
+var (
+	ReliefClaimBatchStatus_name = map[int32]string{
+		0: "RELIEF_CLAIM_BATCH_STATUS_UNSPECIFIED",
+		1: "RELIEF_CLAIM_BATCH_STATUS_SUBMITTED",
+		2: "RELIEF_CLAIM_BATCH_STATUS_PAID",
+	}
+	ReliefClaimBatchStatus_value = map[string]int32{
+		"RELIEF_CLAIM_BATCH_STATUS_UNSPECIFIED": 0,
+		"RELIEF_CLAIM_BATCH_STATUS_SUBMITTED":   1,
+		"RELIEF_CLAIM_BATCH_STATUS_PAID":        2,
+	}
+)
+
+func (x ReliefClaimBatchStatus) Enum() *ReliefClaimBatchStatus {
+	p := new(ReliefClaimBatchStatus)
+	*p = x
+	return p
+}
+
+func (x ReliefClaimBatchStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReliefClaimBatchStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_deposits_v1_deposits_proto_enumTypes[0].Descriptor()
+}
+
+func (ReliefClaimBatchStatus) Type() protoreflect.EnumType {
+	return &file_deposits_v1_deposits_proto_enumTypes[0]
+}
+
+func (x ReliefClaimBatchStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReliefClaimBatchStatus.Descriptor instead.
+func (ReliefClaimBatchStatus) EnumDescriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{0}
+}
+
+type ReliefClaimStatus int32
+
+const (
+	ReliefClaimStatus_RELIEF_CLAIM_STATUS_UNSPECIFIED ReliefClaimStatus = 0
+	ReliefClaimStatus_RELIEF_CLAIM_STATUS_PENDING     ReliefClaimStatus = 1
+	ReliefClaimStatus_RELIEF_CLAIM_STATUS_CLAIMED     ReliefClaimStatus = 2
+	ReliefClaimStatus_RELIEF_CLAIM_STATUS_PAID        ReliefClaimStatus = 3
+	ReliefClaimStatus_RELIEF_CLAIM_STATUS_CANCELLED   ReliefClaimStatus = 4
+)
+
+// Enum value maps for ReliefClaimStatus.
+var (
+	ReliefClaimStatus_name = map[int32]string{
+		0: "RELIEF_CLAIM_STATUS_UNSPECIFIED",
+		1: "RELIEF_CLAIM_STATUS_PENDING",
+		2: "RELIEF_CLAIM_STATUS_CLAIMED",
+		3: "RELIEF_CLAIM_STATUS_PAID",
+		4: "RELIEF_CLAIM_STATUS_CANCELLED",
+	}
+	ReliefClaimStatus_value = map[string]int32{
+		"RELIEF_CLAIM_STATUS_UNSPECIFIED": 0,
+		"RELIEF_CLAIM_STATUS_PENDING":     1,
+		"RELIEF_CLAIM_STATUS_CLAIMED":     2,
+		"RELIEF_CLAIM_STATUS_PAID":        3,
+		"RELIEF_CLAIM_STATUS_CANCELLED":   4,
+	}
+)
+
+func (x ReliefClaimStatus) Enum() *ReliefClaimStatus {
+	p := new(ReliefClaimStatus)
+	*p = x
+	return p
+}
+
+func (x ReliefClaimStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReliefClaimStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_deposits_v1_deposits_proto_enumTypes[1].Descriptor()
+}
+
+func (ReliefClaimStatus) Type() protoreflect.EnumType {
+	return &file_deposits_v1_deposits_proto_enumTypes[1]
+}
+
+func (x ReliefClaimStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReliefClaimStatus.Descriptor instead.
+func (ReliefClaimStatus) EnumDescriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{1}
+}
+
+type AllocationStrategy int32
+
+const (
+	AllocationStrategy_ALLOCATION_STRATEGY_UNSPECIFIED AllocationStrategy = 0
+	// Split in proportion to the accounts' nominal amounts, ISAs only take what's left of the investor's annual allowance
+	// with the rest split between the other accounts
+	AllocationStrategy_ALLOCATION_STRATEGY_PROPORTIONAL AllocationStrategy = 1
+	// Fill accounts up to their nominal in wrapper order, overflowing to the GIA, ISAs only take what's left of the
+	// investor's annual allowance
+	AllocationStrategy_ALLOCATION_STRATEGY_WATERFALL AllocationStrategy = 2
+)
+
+// Enum value maps for AllocationStrategy.
+var (
+	AllocationStrategy_name = map[int32]string{
+		0: "ALLOCATION_STRATEGY_UNSPECIFIED",
+		1: "ALLOCATION_STRATEGY_PROPORTIONAL",
+		2: "ALLOCATION_STRATEGY_WATERFALL",
+	}
+	AllocationStrategy_value = map[string]int32{
+		"ALLOCATION_STRATEGY_UNSPECIFIED":  0,
+		"ALLOCATION_STRATEGY_PROPORTIONAL": 1,
+		"ALLOCATION_STRATEGY_WATERFALL":    2,
+	}
+)
+
+func (x AllocationStrategy) Enum() *AllocationStrategy {
+	p := new(AllocationStrategy)
+	*p = x
+	return p
+}
+
+func (x AllocationStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AllocationStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_deposits_v1_deposits_proto_enumTypes[2].Descriptor()
+}
+
+func (AllocationStrategy) Type() protoreflect.EnumType {
+	return &file_deposits_v1_deposits_proto_enumTypes[2]
+}
+
+func (x AllocationStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AllocationStrategy.Descriptor instead.
+func (AllocationStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{2}
+}
+
+type ReversalReason int32
+
+const (
+	ReversalReason_REVERSAL_REASON_UNSPECIFIED ReversalReason = 0
+	ReversalReason_REVERSAL_REASON_BOUNCED     ReversalReason = 1
+	ReversalReason_REVERSAL_REASON_RECALLED    ReversalReason = 2
+)
+
+// Enum value maps for ReversalReason.
+var (
+	ReversalReason_name = map[int32]string{
+		0: "REVERSAL_REASON_UNSPECIFIED",
+		1: "REVERSAL_REASON_BOUNCED",
+		2: "REVERSAL_REASON_RECALLED",
+	}
+	ReversalReason_value = map[string]int32{
+		"REVERSAL_REASON_UNSPECIFIED": 0,
+		"REVERSAL_REASON_BOUNCED":     1,
+		"REVERSAL_REASON_RECALLED":    2,
+	}
+)
+
+func (x ReversalReason) Enum() *ReversalReason {
+	p := new(ReversalReason)
+	*p = x
+	return p
+}
+
+func (x ReversalReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReversalReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_deposits_v1_deposits_proto_enumTypes[3].Descriptor()
+}
+
+func (ReversalReason) Type() protoreflect.EnumType {
+	return &file_deposits_v1_deposits_proto_enumTypes[3]
+}
+
+func (x ReversalReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReversalReason.Descriptor instead.
+func (ReversalReason) EnumDescriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{3}
+}
+
+type DepositStatus int32
+
+const (
+	DepositStatus_DEPOSIT_STATUS_UNSPECIFIED      DepositStatus = 0
+	DepositStatus_DEPOSIT_STATUS_DRAFT            DepositStatus = 1
+	DepositStatus_DEPOSIT_STATUS_OPEN             DepositStatus = 2
+	DepositStatus_DEPOSIT_STATUS_PARTIALLY_FUNDED DepositStatus = 3
+	DepositStatus_DEPOSIT_STATUS_FUNDED           DepositStatus = 4
+	// Closed and cancelled deposits can't receive anything
+	DepositStatus_DEPOSIT_STATUS_CLOSED    DepositStatus = 5
+	DepositStatus_DEPOSIT_STATUS_CANCELLED DepositStatus = 6
+)
+
+// Enum value maps for DepositStatus.
+var (
+	DepositStatus_name = map[int32]string{
+		0: "DEPOSIT_STATUS_UNSPECIFIED",
+		1: "DEPOSIT_STATUS_DRAFT",
+		2: "DEPOSIT_STATUS_OPEN",
+		3: "DEPOSIT_STATUS_PARTIALLY_FUNDED",
+		4: "DEPOSIT_STATUS_FUNDED",
+		5: "DEPOSIT_STATUS_CLOSED",
+		6: "DEPOSIT_STATUS_CANCELLED",
+	}
+	DepositStatus_value = map[string]int32{
+		"DEPOSIT_STATUS_UNSPECIFIED":      0,
+		"DEPOSIT_STATUS_DRAFT":            1,
+		"DEPOSIT_STATUS_OPEN":             2,
+		"DEPOSIT_STATUS_PARTIALLY_FUNDED": 3,
+		"DEPOSIT_STATUS_FUNDED":           4,
+		"DEPOSIT_STATUS_CLOSED":           5,
+		"DEPOSIT_STATUS_CANCELLED":        6,
+	}
+)
+
+func (x DepositStatus) Enum() *DepositStatus {
+	p := new(DepositStatus)
+	*p = x
+	return p
+}
+
+func (x DepositStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DepositStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_deposits_v1_deposits_proto_enumTypes[4].Descriptor()
+}
+
+func (DepositStatus) Type() protoreflect.EnumType {
+	return &file_deposits_v1_deposits_proto_enumTypes[4]
+}
+
+func (x DepositStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DepositStatus.Descriptor instead.
+func (DepositStatus) EnumDescriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{4}
+}
+
+// WrapperType values are named WRAPPER_TYPE_<code> after the codes in the wrapper registry
 type WrapperType int32
 
 const (
-	WrapperType_WRAPPER_TYPE_UNSPECIFIED WrapperType = 0
-	WrapperType_WRAPPER_TYPE_GIA         WrapperType = 1
-	WrapperType_WRAPPER_TYPE_ISA         WrapperType = 2
-	WrapperType_WRAPPER_TYPE_SIPP        WrapperType = 3
+	WrapperType_WRAPPER_TYPE_UNSPECIFIED  WrapperType = 0
+	WrapperType_WRAPPER_TYPE_GIA          WrapperType = 1
+	WrapperType_WRAPPER_TYPE_ISA          WrapperType = 2
+	WrapperType_WRAPPER_TYPE_SIPP         WrapperType = 3
+	WrapperType_WRAPPER_TYPE_LIFETIME_ISA WrapperType = 4
+	WrapperType_WRAPPER_TYPE_JUNIOR_ISA   WrapperType = 5
+	WrapperType_WRAPPER_TYPE_JISA_TO_ISA  WrapperType = 6
+	WrapperType_WRAPPER_TYPE_CASH_ISA     WrapperType = 7
 )
 
 // Enum value maps for WrapperType.
@@ -36,12 +310,20 @@ var (
 		1: "WRAPPER_TYPE_GIA",
 		2: "WRAPPER_TYPE_ISA",
 		3: "WRAPPER_TYPE_SIPP",
+		4: "WRAPPER_TYPE_LIFETIME_ISA",
+		5: "WRAPPER_TYPE_JUNIOR_ISA",
+		6: "WRAPPER_TYPE_JISA_TO_ISA",
+		7: "WRAPPER_TYPE_CASH_ISA",
 	}
 	WrapperType_value = map[string]int32{
-		"WRAPPER_TYPE_UNSPECIFIED": 0,
-		"WRAPPER_TYPE_GIA":         1,
-		"WRAPPER_TYPE_ISA":         2,
-		"WRAPPER_TYPE_SIPP":        3,
+		"WRAPPER_TYPE_UNSPECIFIED":  0,
+		"WRAPPER_TYPE_GIA":          1,
+		"WRAPPER_TYPE_ISA":          2,
+		"WRAPPER_TYPE_SIPP":         3,
+		"WRAPPER_TYPE_LIFETIME_ISA": 4,
+		"WRAPPER_TYPE_JUNIOR_ISA":   5,
+		"WRAPPER_TYPE_JISA_TO_ISA":  6,
+		"WRAPPER_TYPE_CASH_ISA":     7,
 	}
 )
 
@@ -56,11 +338,11 @@ func (x WrapperType) String() string {
 }
 
 func (WrapperType) Descriptor() protoreflect.EnumDescriptor {
-	return file_deposits_v1_deposits_proto_enumTypes[0].Descriptor()
+	return file_deposits_v1_deposits_proto_enumTypes[5].Descriptor()
 }
 
 func (WrapperType) Type() protoreflect.EnumType {
-	return &file_deposits_v1_deposits_proto_enumTypes[0]
+	return &file_deposits_v1_deposits_proto_enumTypes[5]
 }
 
 func (x WrapperType) Number() protoreflect.EnumNumber {
@@ -69,20 +351,20 @@ func (x WrapperType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use WrapperType.Descriptor instead.
 func (WrapperType) EnumDescriptor() ([]byte, []int) {
-	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{0}
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{5}
 }
 
-type ReceiveReceiptRequest struct {
+type ExportReliefClaimsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccountId string   `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	Receipt   *Receipt `protobuf:"bytes,2,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	// Claim period in the "2024-05" format
+	Period string `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
 }
 
-func (x *ReceiveReceiptRequest) Reset() {
-	*x = ReceiveReceiptRequest{}
+func (x *ExportReliefClaimsRequest) Reset() {
+	*x = ExportReliefClaimsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_deposits_v1_deposits_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -90,13 +372,13 @@ func (x *ReceiveReceiptRequest) Reset() {
 	}
 }
 
-func (x *ReceiveReceiptRequest) String() string {
+func (x *ExportReliefClaimsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReceiveReceiptRequest) ProtoMessage() {}
+func (*ExportReliefClaimsRequest) ProtoMessage() {}
 
-func (x *ReceiveReceiptRequest) ProtoReflect() protoreflect.Message {
+func (x *ExportReliefClaimsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_deposits_v1_deposits_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -108,35 +390,28 @@ func (x *ReceiveReceiptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReceiveReceiptRequest.ProtoReflect.Descriptor instead.
-func (*ReceiveReceiptRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportReliefClaimsRequest.ProtoReflect.Descriptor instead.
+func (*ExportReliefClaimsRequest) Descriptor() ([]byte, []int) {
 	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *ReceiveReceiptRequest) GetAccountId() string {
+func (x *ExportReliefClaimsRequest) GetPeriod() string {
 	if x != nil {
-		return x.AccountId
+		return x.Period
 	}
 	return ""
 }
 
-func (x *ReceiveReceiptRequest) GetReceipt() *Receipt {
-	if x != nil {
-		return x.Receipt
-	}
-	return nil
-}
-
-type ReceiveReceiptResponse struct {
+type ExportReliefClaimsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Receipt *Receipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	Batch *ReliefClaimBatch `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
 }
 
-func (x *ReceiveReceiptResponse) Reset() {
-	*x = ReceiveReceiptResponse{}
+func (x *ExportReliefClaimsResponse) Reset() {
+	*x = ExportReliefClaimsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_deposits_v1_deposits_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -144,13 +419,13 @@ func (x *ReceiveReceiptResponse) Reset() {
 	}
 }
 
-func (x *ReceiveReceiptResponse) String() string {
+func (x *ExportReliefClaimsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReceiveReceiptResponse) ProtoMessage() {}
+func (*ExportReliefClaimsResponse) ProtoMessage() {}
 
-func (x *ReceiveReceiptResponse) ProtoReflect() protoreflect.Message {
+func (x *ExportReliefClaimsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_deposits_v1_deposits_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -162,29 +437,28 @@ func (x *ReceiveReceiptResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReceiveReceiptResponse.ProtoReflect.Descriptor instead.
-func (*ReceiveReceiptResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportReliefClaimsResponse.ProtoReflect.Descriptor instead.
+func (*ExportReliefClaimsResponse) Descriptor() ([]byte, []int) {
 	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *ReceiveReceiptResponse) GetReceipt() *Receipt {
+func (x *ExportReliefClaimsResponse) GetBatch() *ReliefClaimBatch {
 	if x != nil {
-		return x.Receipt
+		return x.Batch
 	}
 	return nil
 }
 
-type Receipt struct {
+type MarkReliefClaimBatchPaidRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id              string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	AllocatedAmount int64  `protobuf:"varint,2,opt,name=allocated_amount,json=allocatedAmount,proto3" json:"allocated_amount,omitempty"`
+	BatchId string `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
 }
 
-func (x *Receipt) Reset() {
-	*x = Receipt{}
+func (x *MarkReliefClaimBatchPaidRequest) Reset() {
+	*x = MarkReliefClaimBatchPaidRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_deposits_v1_deposits_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -192,13 +466,13 @@ func (x *Receipt) Reset() {
 	}
 }
 
-func (x *Receipt) String() string {
+func (x *MarkReliefClaimBatchPaidRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Receipt) ProtoMessage() {}
+func (*MarkReliefClaimBatchPaidRequest) ProtoMessage() {}
 
-func (x *Receipt) ProtoReflect() protoreflect.Message {
+func (x *MarkReliefClaimBatchPaidRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_deposits_v1_deposits_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -210,35 +484,28 @@ func (x *Receipt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Receipt.ProtoReflect.Descriptor instead.
-func (*Receipt) Descriptor() ([]byte, []int) {
+// Deprecated: Use MarkReliefClaimBatchPaidRequest.ProtoReflect.Descriptor instead.
+func (*MarkReliefClaimBatchPaidRequest) Descriptor() ([]byte, []int) {
 	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Receipt) GetId() string {
+func (x *MarkReliefClaimBatchPaidRequest) GetBatchId() string {
 	if x != nil {
-		return x.Id
+		return x.BatchId
 	}
 	return ""
 }
 
-func (x *Receipt) GetAllocatedAmount() int64 {
-	if x != nil {
-		return x.AllocatedAmount
-	}
-	return 0
-}
-
-type GetRequest struct {
+type MarkReliefClaimBatchPaidResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Batch *ReliefClaimBatch `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
 }
 
-func (x *GetRequest) Reset() {
-	*x = GetRequest{}
+func (x *MarkReliefClaimBatchPaidResponse) Reset() {
+	*x = MarkReliefClaimBatchPaidResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_deposits_v1_deposits_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -246,13 +513,13 @@ func (x *GetRequest) Reset() {
 	}
 }
 
-func (x *GetRequest) String() string {
+func (x *MarkReliefClaimBatchPaidResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRequest) ProtoMessage() {}
+func (*MarkReliefClaimBatchPaidResponse) ProtoMessage() {}
 
-func (x *GetRequest) ProtoReflect() protoreflect.Message {
+func (x *MarkReliefClaimBatchPaidResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_deposits_v1_deposits_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -264,28 +531,32 @@ func (x *GetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
-func (*GetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MarkReliefClaimBatchPaidResponse.ProtoReflect.Descriptor instead.
+func (*MarkReliefClaimBatchPaidResponse) Descriptor() ([]byte, []int) {
 	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetRequest) GetId() string {
+func (x *MarkReliefClaimBatchPaidResponse) GetBatch() *ReliefClaimBatch {
 	if x != nil {
-		return x.Id
+		return x.Batch
 	}
-	return ""
+	return nil
 }
 
-type GetResponse struct {
+type ReliefClaimBatch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Deposit *Deposit `protobuf:"bytes,1,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Period            string                 `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	Status            ReliefClaimBatchStatus `protobuf:"varint,3,opt,name=status,proto3,enum=deposits.v1.ReliefClaimBatchStatus" json:"status,omitempty"`
+	Claims            []*ReliefClaim         `protobuf:"bytes,4,rep,name=claims,proto3" json:"claims,omitempty"`
+	TotalReliefAmount *Money                 `protobuf:"bytes,5,opt,name=total_relief_amount,json=totalReliefAmount,proto3" json:"total_relief_amount,omitempty"`
 }
 
-func (x *GetResponse) Reset() {
-	*x = GetResponse{}
+func (x *ReliefClaimBatch) Reset() {
+	*x = ReliefClaimBatch{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_deposits_v1_deposits_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -293,13 +564,13 @@ func (x *GetResponse) Reset() {
 	}
 }
 
-func (x *GetResponse) String() string {
+func (x *ReliefClaimBatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetResponse) ProtoMessage() {}
+func (*ReliefClaimBatch) ProtoMessage() {}
 
-func (x *GetResponse) ProtoReflect() protoreflect.Message {
+func (x *ReliefClaimBatch) ProtoReflect() protoreflect.Message {
 	mi := &file_deposits_v1_deposits_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -311,29 +582,64 @@ func (x *GetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetResponse.ProtoReflect.Descriptor instead.
-func (*GetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReliefClaimBatch.ProtoReflect.Descriptor instead.
+func (*ReliefClaimBatch) Descriptor() ([]byte, []int) {
 	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetResponse) GetDeposit() *Deposit {
+func (x *ReliefClaimBatch) GetId() string {
 	if x != nil {
-		return x.Deposit
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReliefClaimBatch) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *ReliefClaimBatch) GetStatus() ReliefClaimBatchStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReliefClaimBatchStatus_RELIEF_CLAIM_BATCH_STATUS_UNSPECIFIED
+}
+
+func (x *ReliefClaimBatch) GetClaims() []*ReliefClaim {
+	if x != nil {
+		return x.Claims
 	}
 	return nil
 }
 
-type CreateRequest struct {
+func (x *ReliefClaimBatch) GetTotalReliefAmount() *Money {
+	if x != nil {
+		return x.TotalReliefAmount
+	}
+	return nil
+}
+
+type ReliefClaim struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InvestorId string   `protobuf:"bytes,1,opt,name=investor_id,json=investorId,proto3" json:"investor_id,omitempty"`
-	Deposit    *Deposit `protobuf:"bytes,2,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	Id              string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	InvestorId      string            `protobuf:"bytes,2,opt,name=investor_id,json=investorId,proto3" json:"investor_id,omitempty"`
+	AccountId       string            `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	ReceiptId       string            `protobuf:"bytes,4,opt,name=receipt_id,json=receiptId,proto3" json:"receipt_id,omitempty"`
+	NetAmount       *Money            `protobuf:"bytes,5,opt,name=net_amount,json=netAmount,proto3" json:"net_amount,omitempty"`
+	GrossAmount     *Money            `protobuf:"bytes,6,opt,name=gross_amount,json=grossAmount,proto3" json:"gross_amount,omitempty"`
+	ReliefAmount    *Money            `protobuf:"bytes,7,opt,name=relief_amount,json=reliefAmount,proto3" json:"relief_amount,omitempty"`
+	Status          ReliefClaimStatus `protobuf:"varint,8,opt,name=status,proto3,enum=deposits.v1.ReliefClaimStatus" json:"status,omitempty"`
+	ReliefReceiptId string            `protobuf:"bytes,9,opt,name=relief_receipt_id,json=reliefReceiptId,proto3" json:"relief_receipt_id,omitempty"`
 }
 
-func (x *CreateRequest) Reset() {
-	*x = CreateRequest{}
+func (x *ReliefClaim) Reset() {
+	*x = ReliefClaim{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_deposits_v1_deposits_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -341,13 +647,13 @@ func (x *CreateRequest) Reset() {
 	}
 }
 
-func (x *CreateRequest) String() string {
+func (x *ReliefClaim) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRequest) ProtoMessage() {}
+func (*ReliefClaim) ProtoMessage() {}
 
-func (x *CreateRequest) ProtoReflect() protoreflect.Message {
+func (x *ReliefClaim) ProtoReflect() protoreflect.Message {
 	mi := &file_deposits_v1_deposits_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -359,35 +665,86 @@ func (x *CreateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRequest.ProtoReflect.Descriptor instead.
-func (*CreateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReliefClaim.ProtoReflect.Descriptor instead.
+func (*ReliefClaim) Descriptor() ([]byte, []int) {
 	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CreateRequest) GetInvestorId() string {
+func (x *ReliefClaim) GetId() string {
 	if x != nil {
-		return x.InvestorId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateRequest) GetDeposit() *Deposit {
+func (x *ReliefClaim) GetInvestorId() string {
 	if x != nil {
-		return x.Deposit
+		return x.InvestorId
 	}
-	return nil
+	return ""
 }
 
-type CreateResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Deposit *Deposit `protobuf:"bytes,1,opt,name=deposit,proto3" json:"deposit,omitempty"`
+func (x *ReliefClaim) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
 }
 
-func (x *CreateResponse) Reset() {
-	*x = CreateResponse{}
+func (x *ReliefClaim) GetReceiptId() string {
+	if x != nil {
+		return x.ReceiptId
+	}
+	return ""
+}
+
+func (x *ReliefClaim) GetNetAmount() *Money {
+	if x != nil {
+		return x.NetAmount
+	}
+	return nil
+}
+
+func (x *ReliefClaim) GetGrossAmount() *Money {
+	if x != nil {
+		return x.GrossAmount
+	}
+	return nil
+}
+
+func (x *ReliefClaim) GetReliefAmount() *Money {
+	if x != nil {
+		return x.ReliefAmount
+	}
+	return nil
+}
+
+func (x *ReliefClaim) GetStatus() ReliefClaimStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReliefClaimStatus_RELIEF_CLAIM_STATUS_UNSPECIFIED
+}
+
+func (x *ReliefClaim) GetReliefReceiptId() string {
+	if x != nil {
+		return x.ReliefReceiptId
+	}
+	return ""
+}
+
+type GetAnnualAllowanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvestorId string `protobuf:"bytes,1,opt,name=investor_id,json=investorId,proto3" json:"investor_id,omitempty"`
+	// Tax year in the "2024/25" format, defaults to the current tax year
+	TaxYear string `protobuf:"bytes,2,opt,name=tax_year,json=taxYear,proto3" json:"tax_year,omitempty"`
+}
+
+func (x *GetAnnualAllowanceRequest) Reset() {
+	*x = GetAnnualAllowanceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_deposits_v1_deposits_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -395,13 +752,13 @@ func (x *CreateResponse) Reset() {
 	}
 }
 
-func (x *CreateResponse) String() string {
+func (x *GetAnnualAllowanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateResponse) ProtoMessage() {}
+func (*GetAnnualAllowanceRequest) ProtoMessage() {}
 
-func (x *CreateResponse) ProtoReflect() protoreflect.Message {
+func (x *GetAnnualAllowanceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_deposits_v1_deposits_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -413,29 +770,35 @@ func (x *CreateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateResponse.ProtoReflect.Descriptor instead.
-func (*CreateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAnnualAllowanceRequest.ProtoReflect.Descriptor instead.
+func (*GetAnnualAllowanceRequest) Descriptor() ([]byte, []int) {
 	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *CreateResponse) GetDeposit() *Deposit {
+func (x *GetAnnualAllowanceRequest) GetInvestorId() string {
 	if x != nil {
-		return x.Deposit
+		return x.InvestorId
 	}
-	return nil
+	return ""
 }
 
-type Deposit struct {
+func (x *GetAnnualAllowanceRequest) GetTaxYear() string {
+	if x != nil {
+		return x.TaxYear
+	}
+	return ""
+}
+
+type GetAnnualAllowanceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Pots []*Pot `protobuf:"bytes,2,rep,name=pots,proto3" json:"pots,omitempty"`
+	Allowance *AnnualAllowance `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
 }
 
-func (x *Deposit) Reset() {
-	*x = Deposit{}
+func (x *GetAnnualAllowanceResponse) Reset() {
+	*x = GetAnnualAllowanceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_deposits_v1_deposits_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -443,13 +806,13 @@ func (x *Deposit) Reset() {
 	}
 }
 
-func (x *Deposit) String() string {
+func (x *GetAnnualAllowanceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Deposit) ProtoMessage() {}
+func (*GetAnnualAllowanceResponse) ProtoMessage() {}
 
-func (x *Deposit) ProtoReflect() protoreflect.Message {
+func (x *GetAnnualAllowanceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_deposits_v1_deposits_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -461,37 +824,32 @@ func (x *Deposit) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Deposit.ProtoReflect.Descriptor instead.
-func (*Deposit) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAnnualAllowanceResponse.ProtoReflect.Descriptor instead.
+func (*GetAnnualAllowanceResponse) Descriptor() ([]byte, []int) {
 	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Deposit) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Deposit) GetPots() []*Pot {
+func (x *GetAnnualAllowanceResponse) GetAllowance() *AnnualAllowance {
 	if x != nil {
-		return x.Pots
+		return x.Allowance
 	}
 	return nil
 }
 
-type Pot struct {
+type AnnualAllowance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name     string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Accounts []*Account `protobuf:"bytes,3,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	InvestorId string `protobuf:"bytes,1,opt,name=investor_id,json=investorId,proto3" json:"investor_id,omitempty"`
+	TaxYear    string `protobuf:"bytes,2,opt,name=tax_year,json=taxYear,proto3" json:"tax_year,omitempty"`
+	Limit      *Money `protobuf:"bytes,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Used       *Money `protobuf:"bytes,4,opt,name=used,proto3" json:"used,omitempty"`
+	Remaining  *Money `protobuf:"bytes,5,opt,name=remaining,proto3" json:"remaining,omitempty"`
 }
 
-func (x *Pot) Reset() {
-	*x = Pot{}
+func (x *AnnualAllowance) Reset() {
+	*x = AnnualAllowance{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_deposits_v1_deposits_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -499,13 +857,13 @@ func (x *Pot) Reset() {
 	}
 }
 
-func (x *Pot) String() string {
+func (x *AnnualAllowance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pot) ProtoMessage() {}
+func (*AnnualAllowance) ProtoMessage() {}
 
-func (x *Pot) ProtoReflect() protoreflect.Message {
+func (x *AnnualAllowance) ProtoReflect() protoreflect.Message {
 	mi := &file_deposits_v1_deposits_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -517,45 +875,60 @@ func (x *Pot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pot.ProtoReflect.Descriptor instead.
-func (*Pot) Descriptor() ([]byte, []int) {
+// Deprecated: Use AnnualAllowance.ProtoReflect.Descriptor instead.
+func (*AnnualAllowance) Descriptor() ([]byte, []int) {
 	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Pot) GetId() string {
+func (x *AnnualAllowance) GetInvestorId() string {
 	if x != nil {
-		return x.Id
+		return x.InvestorId
 	}
 	return ""
 }
 
-func (x *Pot) GetName() string {
+func (x *AnnualAllowance) GetTaxYear() string {
 	if x != nil {
-		return x.Name
+		return x.TaxYear
 	}
 	return ""
 }
 
-func (x *Pot) GetAccounts() []*Account {
+func (x *AnnualAllowance) GetLimit() *Money {
 	if x != nil {
-		return x.Accounts
+		return x.Limit
 	}
 	return nil
 }
 
-type Account struct {
+func (x *AnnualAllowance) GetUsed() *Money {
+	if x != nil {
+		return x.Used
+	}
+	return nil
+}
+
+func (x *AnnualAllowance) GetRemaining() *Money {
+	if x != nil {
+		return x.Remaining
+	}
+	return nil
+}
+
+type ReceiveReceiptRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                   string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	WrapperType          WrapperType `protobuf:"varint,2,opt,name=wrapper_type,json=wrapperType,proto3,enum=deposits.v1.WrapperType" json:"wrapper_type,omitempty"`
-	NominalAmount        int64       `protobuf:"varint,3,opt,name=nominal_amount,json=nominalAmount,proto3" json:"nominal_amount,omitempty"`
-	TotalAllocatedAmount int64       `protobuf:"varint,4,opt,name=total_allocated_amount,json=totalAllocatedAmount,proto3" json:"total_allocated_amount,omitempty"`
+	AccountId string   `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Receipt   *Receipt `protobuf:"bytes,2,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	// Send what a capped account can't take to the GIA in the same pot, or the deposit's suspense balance if there isn't one,
+	// instead of failing. A receipt for an account with no room left goes there whole, without an overflow_receipt
+	Overflow bool `protobuf:"varint,3,opt,name=overflow,proto3" json:"overflow,omitempty"`
 }
 
-func (x *Account) Reset() {
-	*x = Account{}
+func (x *ReceiveReceiptRequest) Reset() {
+	*x = ReceiveReceiptRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_deposits_v1_deposits_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -563,13 +936,13 @@ func (x *Account) Reset() {
 	}
 }
 
-func (x *Account) String() string {
+func (x *ReceiveReceiptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Account) ProtoMessage() {}
+func (*ReceiveReceiptRequest) ProtoMessage() {}
 
-func (x *Account) ProtoReflect() protoreflect.Message {
+func (x *ReceiveReceiptRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_deposits_v1_deposits_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -581,218 +954,3683 @@ func (x *Account) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Account.ProtoReflect.Descriptor instead.
-func (*Account) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReceiveReceiptRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveReceiptRequest) Descriptor() ([]byte, []int) {
 	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Account) GetId() string {
+func (x *ReceiveReceiptRequest) GetAccountId() string {
 	if x != nil {
-		return x.Id
+		return x.AccountId
 	}
 	return ""
 }
 
-func (x *Account) GetWrapperType() WrapperType {
+func (x *ReceiveReceiptRequest) GetReceipt() *Receipt {
 	if x != nil {
-		return x.WrapperType
+		return x.Receipt
 	}
-	return WrapperType_WRAPPER_TYPE_UNSPECIFIED
+	return nil
 }
 
-func (x *Account) GetNominalAmount() int64 {
+func (x *ReceiveReceiptRequest) GetOverflow() bool {
 	if x != nil {
-		return x.NominalAmount
+		return x.Overflow
 	}
-	return 0
+	return false
 }
 
-func (x *Account) GetTotalAllocatedAmount() int64 {
-	if x != nil {
-		return x.TotalAllocatedAmount
+type ReceiveReceiptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Receipt *Receipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	// The excess of an overflowed receipt, linked to it by overflow_of
+	OverflowReceipt *Receipt `protobuf:"bytes,2,opt,name=overflow_receipt,json=overflowReceipt,proto3" json:"overflow_receipt,omitempty"`
+}
+
+func (x *ReceiveReceiptResponse) Reset() {
+	*x = ReceiveReceiptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-var File_deposits_v1_deposits_proto protoreflect.FileDescriptor
+func (x *ReceiveReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-var file_deposits_v1_deposits_proto_rawDesc = []byte{
-	0x0a, 0x1a, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x64, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x22, 0x66, 0x0a, 0x15, 0x52, 0x65, 0x63,
-	0x65, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
-	0x64, 0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70,
-	0x74, 0x22, 0x48, 0x0a, 0x16, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x65,
-	0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x72,
-	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69,
-	0x70, 0x74, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x22, 0x44, 0x0a, 0x07, 0x52,
-	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x22, 0x1c, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x3d, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e,
-	0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x22, 0x60,
-	0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x49, 0x64,
-	0x12, 0x2e, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x22, 0x40, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x22, 0x3f, 0x0a, 0x07, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a,
-	0x04, 0x70, 0x6f, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x64, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x74, 0x52, 0x04, 0x70,
-	0x6f, 0x74, 0x73, 0x22, 0x5b, 0x0a, 0x03, 0x50, 0x6f, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30,
-	0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
-	0x22, 0xb3, 0x01, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x0c,
-	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x77, 0x72,
-	0x61, 0x70, 0x70, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x6f, 0x6d,
-	0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0d, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x34, 0x0a, 0x16, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x2a, 0x6e, 0x0a, 0x0b, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65,
-	0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x57, 0x52, 0x41, 0x50, 0x50, 0x45, 0x52,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x57, 0x52, 0x41, 0x50, 0x50, 0x45, 0x52, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x47, 0x49, 0x41, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x57, 0x52, 0x41,
-	0x50, 0x50, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x53, 0x41, 0x10, 0x02, 0x12,
-	0x15, 0x0a, 0x11, 0x57, 0x52, 0x41, 0x50, 0x50, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x53, 0x49, 0x50, 0x50, 0x10, 0x03, 0x32, 0xe9, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1b, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a,
-	0x03, 0x47, 0x65, 0x74, 0x12, 0x17, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
-	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x65, 0x69,
-	0x76, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x22, 0x2e, 0x64, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x52,
-	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
-	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65,
-	0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x42, 0xb5, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x61, 0x69, 0x6e, 0x76, 0x6d, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
-	0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x73, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x76, 0x31, 0xa2,
-	0x02, 0x03, 0x44, 0x58, 0x58, 0xaa, 0x02, 0x0b, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73,
-	0x2e, 0x56, 0x31, 0xca, 0x02, 0x0b, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x5c, 0x56,
-	0x31, 0xe2, 0x02, 0x17, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x5c, 0x56, 0x31, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x44, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+func (*ReceiveReceiptResponse) ProtoMessage() {}
+
+func (x *ReceiveReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var (
-	file_deposits_v1_deposits_proto_rawDescOnce sync.Once
-	file_deposits_v1_deposits_proto_rawDescData = file_deposits_v1_deposits_proto_rawDesc
-)
+// Deprecated: Use ReceiveReceiptResponse.ProtoReflect.Descriptor instead.
+func (*ReceiveReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{10}
+}
 
-func file_deposits_v1_deposits_proto_rawDescGZIP() []byte {
-	file_deposits_v1_deposits_proto_rawDescOnce.Do(func() {
-		file_deposits_v1_deposits_proto_rawDescData = protoimpl.X.CompressGZIP(file_deposits_v1_deposits_proto_rawDescData)
-	})
-	return file_deposits_v1_deposits_proto_rawDescData
+func (x *ReceiveReceiptResponse) GetReceipt() *Receipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
 }
 
-var file_deposits_v1_deposits_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_deposits_v1_deposits_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
-var file_deposits_v1_deposits_proto_goTypes = []any{
-	(WrapperType)(0),               // 0: deposits.v1.WrapperType
-	(*ReceiveReceiptRequest)(nil),  // 1: deposits.v1.ReceiveReceiptRequest
-	(*ReceiveReceiptResponse)(nil), // 2: deposits.v1.ReceiveReceiptResponse
-	(*Receipt)(nil),                // 3: deposits.v1.Receipt
-	(*GetRequest)(nil),             // 4: deposits.v1.GetRequest
-	(*GetResponse)(nil),            // 5: deposits.v1.GetResponse
-	(*CreateRequest)(nil),          // 6: deposits.v1.CreateRequest
-	(*CreateResponse)(nil),         // 7: deposits.v1.CreateResponse
-	(*Deposit)(nil),                // 8: deposits.v1.Deposit
-	(*Pot)(nil),                    // 9: deposits.v1.Pot
-	(*Account)(nil),                // 10: deposits.v1.Account
+func (x *ReceiveReceiptResponse) GetOverflowReceipt() *Receipt {
+	if x != nil {
+		return x.OverflowReceipt
+	}
+	return nil
 }
-var file_deposits_v1_deposits_proto_depIdxs = []int32{
-	3,  // 0: deposits.v1.ReceiveReceiptRequest.receipt:type_name -> deposits.v1.Receipt
-	3,  // 1: deposits.v1.ReceiveReceiptResponse.receipt:type_name -> deposits.v1.Receipt
-	8,  // 2: deposits.v1.GetResponse.deposit:type_name -> deposits.v1.Deposit
-	8,  // 3: deposits.v1.CreateRequest.deposit:type_name -> deposits.v1.Deposit
-	8,  // 4: deposits.v1.CreateResponse.deposit:type_name -> deposits.v1.Deposit
-	9,  // 5: deposits.v1.Deposit.pots:type_name -> deposits.v1.Pot
-	10, // 6: deposits.v1.Pot.accounts:type_name -> deposits.v1.Account
-	0,  // 7: deposits.v1.Account.wrapper_type:type_name -> deposits.v1.WrapperType
-	6,  // 8: deposits.v1.DepositsService.Create:input_type -> deposits.v1.CreateRequest
-	4,  // 9: deposits.v1.DepositsService.Get:input_type -> deposits.v1.GetRequest
-	1,  // 10: deposits.v1.DepositsService.ReceiveReceipt:input_type -> deposits.v1.ReceiveReceiptRequest
-	7,  // 11: deposits.v1.DepositsService.Create:output_type -> deposits.v1.CreateResponse
-	5,  // 12: deposits.v1.DepositsService.Get:output_type -> deposits.v1.GetResponse
-	2,  // 13: deposits.v1.DepositsService.ReceiveReceipt:output_type -> deposits.v1.ReceiveReceiptResponse
-	11, // [11:14] is the sub-list for method output_type
-	8,  // [8:11] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+
+type ReceiveDepositReceiptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DepositId       string             `protobuf:"bytes,1,opt,name=deposit_id,json=depositId,proto3" json:"deposit_id,omitempty"`
+	AllocatedAmount *Money             `protobuf:"bytes,2,opt,name=allocated_amount,json=allocatedAmount,proto3" json:"allocated_amount,omitempty"`
+	Strategy        AllocationStrategy `protobuf:"varint,3,opt,name=strategy,proto3,enum=deposits.v1.AllocationStrategy" json:"strategy,omitempty"`
+	// Order waterfalls fill wrappers in, each at most once, defaults to ISAs, then SIPP, then GIA
+	WaterfallOrder []WrapperType `protobuf:"varint,4,rep,packed,name=waterfall_order,json=waterfallOrder,proto3,enum=deposits.v1.WrapperType" json:"waterfall_order,omitempty"`
+	// Client or bank supplied payment reference, a deposit receipt is only received once for each key
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// When the bank received the payment, which decides the tax year its ISA shares use allowance in, defaults to when
+	// it's received here
+	ReceivedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
 }
 
-func init() { file_deposits_v1_deposits_proto_init() }
-func file_deposits_v1_deposits_proto_init() {
-	if File_deposits_v1_deposits_proto != nil {
-		return
+func (x *ReceiveDepositReceiptRequest) Reset() {
+	*x = ReceiveDepositReceiptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_deposits_v1_deposits_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*ReceiveReceiptRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_deposits_v1_deposits_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*ReceiveReceiptResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+}
+
+func (x *ReceiveDepositReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveDepositReceiptRequest) ProtoMessage() {}
+
+func (x *ReceiveDepositReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_deposits_v1_deposits_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*Receipt); i {
-			case 0:
-				return &v.state
-			case 1:
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveDepositReceiptRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveDepositReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReceiveDepositReceiptRequest) GetDepositId() string {
+	if x != nil {
+		return x.DepositId
+	}
+	return ""
+}
+
+func (x *ReceiveDepositReceiptRequest) GetAllocatedAmount() *Money {
+	if x != nil {
+		return x.AllocatedAmount
+	}
+	return nil
+}
+
+func (x *ReceiveDepositReceiptRequest) GetStrategy() AllocationStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return AllocationStrategy_ALLOCATION_STRATEGY_UNSPECIFIED
+}
+
+func (x *ReceiveDepositReceiptRequest) GetWaterfallOrder() []WrapperType {
+	if x != nil {
+		return x.WaterfallOrder
+	}
+	return nil
+}
+
+func (x *ReceiveDepositReceiptRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *ReceiveDepositReceiptRequest) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+type ReceiveDepositReceiptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DepositReceipt *DepositReceipt `protobuf:"bytes,1,opt,name=deposit_receipt,json=depositReceipt,proto3" json:"deposit_receipt,omitempty"`
+}
+
+func (x *ReceiveDepositReceiptResponse) Reset() {
+	*x = ReceiveDepositReceiptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiveDepositReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveDepositReceiptResponse) ProtoMessage() {}
+
+func (x *ReceiveDepositReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveDepositReceiptResponse.ProtoReflect.Descriptor instead.
+func (*ReceiveDepositReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReceiveDepositReceiptResponse) GetDepositReceipt() *DepositReceipt {
+	if x != nil {
+		return x.DepositReceipt
+	}
+	return nil
+}
+
+type DepositReceipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DepositId       string `protobuf:"bytes,2,opt,name=deposit_id,json=depositId,proto3" json:"deposit_id,omitempty"`
+	AllocatedAmount *Money `protobuf:"bytes,3,opt,name=allocated_amount,json=allocatedAmount,proto3" json:"allocated_amount,omitempty"`
+	IdempotencyKey  string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// The child receipt received by each account
+	Receipts   []*Receipt             `protobuf:"bytes,5,rep,name=receipts,proto3" json:"receipts,omitempty"`
+	ReceivedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+}
+
+func (x *DepositReceipt) Reset() {
+	*x = DepositReceipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositReceipt) ProtoMessage() {}
+
+func (x *DepositReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositReceipt.ProtoReflect.Descriptor instead.
+func (*DepositReceipt) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DepositReceipt) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DepositReceipt) GetDepositId() string {
+	if x != nil {
+		return x.DepositId
+	}
+	return ""
+}
+
+func (x *DepositReceipt) GetAllocatedAmount() *Money {
+	if x != nil {
+		return x.AllocatedAmount
+	}
+	return nil
+}
+
+func (x *DepositReceipt) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *DepositReceipt) GetReceipts() []*Receipt {
+	if x != nil {
+		return x.Receipts
+	}
+	return nil
+}
+
+func (x *DepositReceipt) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+type ReceiveUnallocatedReceiptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A payment that couldn't be matched to an account, with the account_reference it arrived with
+	Receipt *Receipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+}
+
+func (x *ReceiveUnallocatedReceiptRequest) Reset() {
+	*x = ReceiveUnallocatedReceiptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiveUnallocatedReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveUnallocatedReceiptRequest) ProtoMessage() {}
+
+func (x *ReceiveUnallocatedReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveUnallocatedReceiptRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveUnallocatedReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReceiveUnallocatedReceiptRequest) GetReceipt() *Receipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+type ReceiveUnallocatedReceiptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Receipt *Receipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+}
+
+func (x *ReceiveUnallocatedReceiptResponse) Reset() {
+	*x = ReceiveUnallocatedReceiptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiveUnallocatedReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveUnallocatedReceiptResponse) ProtoMessage() {}
+
+func (x *ReceiveUnallocatedReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveUnallocatedReceiptResponse.ProtoReflect.Descriptor instead.
+func (*ReceiveUnallocatedReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReceiveUnallocatedReceiptResponse) GetReceipt() *Receipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+type ListUnallocatedReceiptsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only list overflow held in this deposit's suspense balance
+	DepositId string `protobuf:"bytes,1,opt,name=deposit_id,json=depositId,proto3" json:"deposit_id,omitempty"`
+}
+
+func (x *ListUnallocatedReceiptsRequest) Reset() {
+	*x = ListUnallocatedReceiptsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUnallocatedReceiptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUnallocatedReceiptsRequest) ProtoMessage() {}
+
+func (x *ListUnallocatedReceiptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUnallocatedReceiptsRequest.ProtoReflect.Descriptor instead.
+func (*ListUnallocatedReceiptsRequest) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListUnallocatedReceiptsRequest) GetDepositId() string {
+	if x != nil {
+		return x.DepositId
+	}
+	return ""
+}
+
+type ListUnallocatedReceiptsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Receipts with an unallocated amount left, oldest first
+	Receipts []*Receipt `protobuf:"bytes,1,rep,name=receipts,proto3" json:"receipts,omitempty"`
+}
+
+func (x *ListUnallocatedReceiptsResponse) Reset() {
+	*x = ListUnallocatedReceiptsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUnallocatedReceiptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUnallocatedReceiptsResponse) ProtoMessage() {}
+
+func (x *ListUnallocatedReceiptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUnallocatedReceiptsResponse.ProtoReflect.Descriptor instead.
+func (*ListUnallocatedReceiptsResponse) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListUnallocatedReceiptsResponse) GetReceipts() []*Receipt {
+	if x != nil {
+		return x.Receipts
+	}
+	return nil
+}
+
+// AllocateUnallocatedReceiptRequest is recorded as allocated by the authenticated actor making the call
+type AllocateUnallocatedReceiptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReceiptId string `protobuf:"bytes,1,opt,name=receipt_id,json=receiptId,proto3" json:"receipt_id,omitempty"`
+	AccountId string `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount    *Money `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Client supplied reference, a repeat of the allocation with the same key returns the original allocation
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+}
+
+func (x *AllocateUnallocatedReceiptRequest) Reset() {
+	*x = AllocateUnallocatedReceiptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllocateUnallocatedReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllocateUnallocatedReceiptRequest) ProtoMessage() {}
+
+func (x *AllocateUnallocatedReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllocateUnallocatedReceiptRequest.ProtoReflect.Descriptor instead.
+func (*AllocateUnallocatedReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AllocateUnallocatedReceiptRequest) GetReceiptId() string {
+	if x != nil {
+		return x.ReceiptId
+	}
+	return ""
+}
+
+func (x *AllocateUnallocatedReceiptRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *AllocateUnallocatedReceiptRequest) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *AllocateUnallocatedReceiptRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type AllocateUnallocatedReceiptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Allocation *SuspenseAllocation `protobuf:"bytes,1,opt,name=allocation,proto3" json:"allocation,omitempty"`
+}
+
+func (x *AllocateUnallocatedReceiptResponse) Reset() {
+	*x = AllocateUnallocatedReceiptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllocateUnallocatedReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllocateUnallocatedReceiptResponse) ProtoMessage() {}
+
+func (x *AllocateUnallocatedReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllocateUnallocatedReceiptResponse.ProtoReflect.Descriptor instead.
+func (*AllocateUnallocatedReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AllocateUnallocatedReceiptResponse) GetAllocation() *SuspenseAllocation {
+	if x != nil {
+		return x.Allocation
+	}
+	return nil
+}
+
+type ListSuspenseAllocationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReceiptId string `protobuf:"bytes,1,opt,name=receipt_id,json=receiptId,proto3" json:"receipt_id,omitempty"`
+}
+
+func (x *ListSuspenseAllocationsRequest) Reset() {
+	*x = ListSuspenseAllocationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSuspenseAllocationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSuspenseAllocationsRequest) ProtoMessage() {}
+
+func (x *ListSuspenseAllocationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSuspenseAllocationsRequest.ProtoReflect.Descriptor instead.
+func (*ListSuspenseAllocationsRequest) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListSuspenseAllocationsRequest) GetReceiptId() string {
+	if x != nil {
+		return x.ReceiptId
+	}
+	return ""
+}
+
+type ListSuspenseAllocationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Allocations made from the receipt, oldest first
+	Allocations []*SuspenseAllocation `protobuf:"bytes,1,rep,name=allocations,proto3" json:"allocations,omitempty"`
+}
+
+func (x *ListSuspenseAllocationsResponse) Reset() {
+	*x = ListSuspenseAllocationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSuspenseAllocationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSuspenseAllocationsResponse) ProtoMessage() {}
+
+func (x *ListSuspenseAllocationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSuspenseAllocationsResponse.ProtoReflect.Descriptor instead.
+func (*ListSuspenseAllocationsResponse) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListSuspenseAllocationsResponse) GetAllocations() []*SuspenseAllocation {
+	if x != nil {
+		return x.Allocations
+	}
+	return nil
+}
+
+type SuspenseAllocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UnallocatedReceiptId string `protobuf:"bytes,2,opt,name=unallocated_receipt_id,json=unallocatedReceiptId,proto3" json:"unallocated_receipt_id,omitempty"`
+	// The receipt the account received for the allocation
+	ReceiptId      string                 `protobuf:"bytes,3,opt,name=receipt_id,json=receiptId,proto3" json:"receipt_id,omitempty"`
+	AccountId      string                 `protobuf:"bytes,4,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount         *Money                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	AllocatedBy    string                 `protobuf:"bytes,6,opt,name=allocated_by,json=allocatedBy,proto3" json:"allocated_by,omitempty"`
+	AllocatedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=allocated_at,json=allocatedAt,proto3" json:"allocated_at,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,8,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+}
+
+func (x *SuspenseAllocation) Reset() {
+	*x = SuspenseAllocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuspenseAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspenseAllocation) ProtoMessage() {}
+
+func (x *SuspenseAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspenseAllocation.ProtoReflect.Descriptor instead.
+func (*SuspenseAllocation) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SuspenseAllocation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SuspenseAllocation) GetUnallocatedReceiptId() string {
+	if x != nil {
+		return x.UnallocatedReceiptId
+	}
+	return ""
+}
+
+func (x *SuspenseAllocation) GetReceiptId() string {
+	if x != nil {
+		return x.ReceiptId
+	}
+	return ""
+}
+
+func (x *SuspenseAllocation) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *SuspenseAllocation) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *SuspenseAllocation) GetAllocatedBy() string {
+	if x != nil {
+		return x.AllocatedBy
+	}
+	return ""
+}
+
+func (x *SuspenseAllocation) GetAllocatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AllocatedAt
+	}
+	return nil
+}
+
+func (x *SuspenseAllocation) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type ReverseReceiptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Reversing a receipt that overflowed reverses its overflow_receipt too, overflow can't be reversed on its own
+	ReceiptId string         `protobuf:"bytes,1,opt,name=receipt_id,json=receiptId,proto3" json:"receipt_id,omitempty"`
+	Reason    ReversalReason `protobuf:"varint,2,opt,name=reason,proto3,enum=deposits.v1.ReversalReason" json:"reason,omitempty"`
+	// Client or bank supplied reference, a repeat of the reversal with the same key returns the original reversal
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+}
+
+func (x *ReverseReceiptRequest) Reset() {
+	*x = ReverseReceiptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReverseReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseReceiptRequest) ProtoMessage() {}
+
+func (x *ReverseReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseReceiptRequest.ProtoReflect.Descriptor instead.
+func (*ReverseReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ReverseReceiptRequest) GetReceiptId() string {
+	if x != nil {
+		return x.ReceiptId
+	}
+	return ""
+}
+
+func (x *ReverseReceiptRequest) GetReason() ReversalReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ReversalReason_REVERSAL_REASON_UNSPECIFIED
+}
+
+func (x *ReverseReceiptRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type ReverseReceiptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reversal *Reversal `protobuf:"bytes,1,opt,name=reversal,proto3" json:"reversal,omitempty"`
+}
+
+func (x *ReverseReceiptResponse) Reset() {
+	*x = ReverseReceiptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReverseReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseReceiptResponse) ProtoMessage() {}
+
+func (x *ReverseReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseReceiptResponse.ProtoReflect.Descriptor instead.
+func (*ReverseReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReverseReceiptResponse) GetReversal() *Reversal {
+	if x != nil {
+		return x.Reversal
+	}
+	return nil
+}
+
+type Reversal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReceiptId      string         `protobuf:"bytes,2,opt,name=receipt_id,json=receiptId,proto3" json:"receipt_id,omitempty"`
+	AccountId      string         `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount         *Money         `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason         ReversalReason `protobuf:"varint,5,opt,name=reason,proto3,enum=deposits.v1.ReversalReason" json:"reason,omitempty"`
+	IdempotencyKey string         `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+}
+
+func (x *Reversal) Reset() {
+	*x = Reversal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reversal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reversal) ProtoMessage() {}
+
+func (x *Reversal) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reversal.ProtoReflect.Descriptor instead.
+func (*Reversal) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Reversal) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Reversal) GetReceiptId() string {
+	if x != nil {
+		return x.ReceiptId
+	}
+	return ""
+}
+
+func (x *Reversal) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Reversal) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Reversal) GetReason() ReversalReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ReversalReason_REVERSAL_REASON_UNSPECIFIED
+}
+
+func (x *Reversal) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type Receipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AllocatedAmount *Money `protobuf:"bytes,2,opt,name=allocated_amount,json=allocatedAmount,proto3" json:"allocated_amount,omitempty"`
+	// Client or bank supplied payment reference, a receipt is only received once for each key
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	AccountId      string `protobuf:"bytes,4,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Set if the receipt was allocated from a deposit receipt
+	DepositReceiptId string `protobuf:"bytes,5,opt,name=deposit_receipt_id,json=depositReceiptId,proto3" json:"deposit_receipt_id,omitempty"`
+	// Set on the excess of a receipt that overflowed its account
+	OverflowOf string `protobuf:"bytes,6,opt,name=overflow_of,json=overflowOf,proto3" json:"overflow_of,omitempty"`
+	// Set, instead of account_id, on overflow held in the deposit's suspense balance
+	SuspenseDepositId string `protobuf:"bytes,7,opt,name=suspense_deposit_id,json=suspenseDepositId,proto3" json:"suspense_deposit_id,omitempty"`
+	// The reference an unallocated receipt arrived with
+	AccountReference string `protobuf:"bytes,8,opt,name=account_reference,json=accountReference,proto3" json:"account_reference,omitempty"`
+	// What's left of an unallocated receipt to allocate to accounts
+	UnallocatedAmount *Money `protobuf:"bytes,9,opt,name=unallocated_amount,json=unallocatedAmount,proto3" json:"unallocated_amount,omitempty"`
+	// Set on receipts allocated from an unallocated receipt
+	AllocatedFrom string `protobuf:"bytes,10,opt,name=allocated_from,json=allocatedFrom,proto3" json:"allocated_from,omitempty"`
+	// When the bank received the payment, which decides the tax year it uses ISA allowance in, defaults to when it's
+	// received here
+	ReceivedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+}
+
+func (x *Receipt) Reset() {
+	*x = Receipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Receipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Receipt) ProtoMessage() {}
+
+func (x *Receipt) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Receipt.ProtoReflect.Descriptor instead.
+func (*Receipt) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Receipt) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Receipt) GetAllocatedAmount() *Money {
+	if x != nil {
+		return x.AllocatedAmount
+	}
+	return nil
+}
+
+func (x *Receipt) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *Receipt) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Receipt) GetDepositReceiptId() string {
+	if x != nil {
+		return x.DepositReceiptId
+	}
+	return ""
+}
+
+func (x *Receipt) GetOverflowOf() string {
+	if x != nil {
+		return x.OverflowOf
+	}
+	return ""
+}
+
+func (x *Receipt) GetSuspenseDepositId() string {
+	if x != nil {
+		return x.SuspenseDepositId
+	}
+	return ""
+}
+
+func (x *Receipt) GetAccountReference() string {
+	if x != nil {
+		return x.AccountReference
+	}
+	return ""
+}
+
+func (x *Receipt) GetUnallocatedAmount() *Money {
+	if x != nil {
+		return x.UnallocatedAmount
+	}
+	return nil
+}
+
+func (x *Receipt) GetAllocatedFrom() string {
+	if x != nil {
+		return x.AllocatedFrom
+	}
+	return ""
+}
+
+func (x *Receipt) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+type GetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetRequest) Reset() {
+	*x = GetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRequest) ProtoMessage() {}
+
+func (x *GetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
+func (*GetRequest) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deposit *Deposit `protobuf:"bytes,1,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (x *GetResponse) Reset() {
+	*x = GetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResponse) ProtoMessage() {}
+
+func (x *GetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResponse.ProtoReflect.Descriptor instead.
+func (*GetResponse) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetResponse) GetDeposit() *Deposit {
+	if x != nil {
+		return x.Deposit
+	}
+	return nil
+}
+
+// UpdateDepositRequest applies the amendments in order, all or none of them are applied
+type UpdateDepositRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string              `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amendments []*DepositAmendment `protobuf:"bytes,2,rep,name=amendments,proto3" json:"amendments,omitempty"`
+}
+
+func (x *UpdateDepositRequest) Reset() {
+	*x = UpdateDepositRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateDepositRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDepositRequest) ProtoMessage() {}
+
+func (x *UpdateDepositRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDepositRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDepositRequest) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateDepositRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateDepositRequest) GetAmendments() []*DepositAmendment {
+	if x != nil {
+		return x.Amendments
+	}
+	return nil
+}
+
+type UpdateDepositResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deposit *Deposit `protobuf:"bytes,1,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (x *UpdateDepositResponse) Reset() {
+	*x = UpdateDepositResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateDepositResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDepositResponse) ProtoMessage() {}
+
+func (x *UpdateDepositResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDepositResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDepositResponse) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateDepositResponse) GetDeposit() *Deposit {
+	if x != nil {
+		return x.Deposit
+	}
+	return nil
+}
+
+type DepositAmendment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Amendment:
+	//	*DepositAmendment_AddPot_
+	//	*DepositAmendment_RenamePot_
+	//	*DepositAmendment_AddAccount_
+	//	*DepositAmendment_ChangeNominal_
+	//	*DepositAmendment_RemoveAccount_
+	Amendment isDepositAmendment_Amendment `protobuf_oneof:"amendment"`
+}
+
+func (x *DepositAmendment) Reset() {
+	*x = DepositAmendment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositAmendment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositAmendment) ProtoMessage() {}
+
+func (x *DepositAmendment) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositAmendment.ProtoReflect.Descriptor instead.
+func (*DepositAmendment) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{31}
+}
+
+func (m *DepositAmendment) GetAmendment() isDepositAmendment_Amendment {
+	if m != nil {
+		return m.Amendment
+	}
+	return nil
+}
+
+func (x *DepositAmendment) GetAddPot() *DepositAmendment_AddPot {
+	if x, ok := x.GetAmendment().(*DepositAmendment_AddPot_); ok {
+		return x.AddPot
+	}
+	return nil
+}
+
+func (x *DepositAmendment) GetRenamePot() *DepositAmendment_RenamePot {
+	if x, ok := x.GetAmendment().(*DepositAmendment_RenamePot_); ok {
+		return x.RenamePot
+	}
+	return nil
+}
+
+func (x *DepositAmendment) GetAddAccount() *DepositAmendment_AddAccount {
+	if x, ok := x.GetAmendment().(*DepositAmendment_AddAccount_); ok {
+		return x.AddAccount
+	}
+	return nil
+}
+
+func (x *DepositAmendment) GetChangeNominal() *DepositAmendment_ChangeNominal {
+	if x, ok := x.GetAmendment().(*DepositAmendment_ChangeNominal_); ok {
+		return x.ChangeNominal
+	}
+	return nil
+}
+
+func (x *DepositAmendment) GetRemoveAccount() *DepositAmendment_RemoveAccount {
+	if x, ok := x.GetAmendment().(*DepositAmendment_RemoveAccount_); ok {
+		return x.RemoveAccount
+	}
+	return nil
+}
+
+type isDepositAmendment_Amendment interface {
+	isDepositAmendment_Amendment()
+}
+
+type DepositAmendment_AddPot_ struct {
+	AddPot *DepositAmendment_AddPot `protobuf:"bytes,1,opt,name=add_pot,json=addPot,proto3,oneof"`
+}
+
+type DepositAmendment_RenamePot_ struct {
+	RenamePot *DepositAmendment_RenamePot `protobuf:"bytes,2,opt,name=rename_pot,json=renamePot,proto3,oneof"`
+}
+
+type DepositAmendment_AddAccount_ struct {
+	AddAccount *DepositAmendment_AddAccount `protobuf:"bytes,3,opt,name=add_account,json=addAccount,proto3,oneof"`
+}
+
+type DepositAmendment_ChangeNominal_ struct {
+	ChangeNominal *DepositAmendment_ChangeNominal `protobuf:"bytes,4,opt,name=change_nominal,json=changeNominal,proto3,oneof"`
+}
+
+type DepositAmendment_RemoveAccount_ struct {
+	RemoveAccount *DepositAmendment_RemoveAccount `protobuf:"bytes,5,opt,name=remove_account,json=removeAccount,proto3,oneof"`
+}
+
+func (*DepositAmendment_AddPot_) isDepositAmendment_Amendment() {}
+
+func (*DepositAmendment_RenamePot_) isDepositAmendment_Amendment() {}
+
+func (*DepositAmendment_AddAccount_) isDepositAmendment_Amendment() {}
+
+func (*DepositAmendment_ChangeNominal_) isDepositAmendment_Amendment() {}
+
+func (*DepositAmendment_RemoveAccount_) isDepositAmendment_Amendment() {}
+
+type CancelDepositRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CancelDepositRequest) Reset() {
+	*x = CancelDepositRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelDepositRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelDepositRequest) ProtoMessage() {}
+
+func (x *CancelDepositRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelDepositRequest.ProtoReflect.Descriptor instead.
+func (*CancelDepositRequest) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CancelDepositRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelDepositResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deposit *Deposit `protobuf:"bytes,1,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (x *CancelDepositResponse) Reset() {
+	*x = CancelDepositResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelDepositResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelDepositResponse) ProtoMessage() {}
+
+func (x *CancelDepositResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelDepositResponse.ProtoReflect.Descriptor instead.
+func (*CancelDepositResponse) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CancelDepositResponse) GetDeposit() *Deposit {
+	if x != nil {
+		return x.Deposit
+	}
+	return nil
+}
+
+type CloseDepositRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CloseDepositRequest) Reset() {
+	*x = CloseDepositRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseDepositRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseDepositRequest) ProtoMessage() {}
+
+func (x *CloseDepositRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseDepositRequest.ProtoReflect.Descriptor instead.
+func (*CloseDepositRequest) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CloseDepositRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CloseDepositResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deposit *Deposit `protobuf:"bytes,1,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (x *CloseDepositResponse) Reset() {
+	*x = CloseDepositResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseDepositResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseDepositResponse) ProtoMessage() {}
+
+func (x *CloseDepositResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseDepositResponse.ProtoReflect.Descriptor instead.
+func (*CloseDepositResponse) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CloseDepositResponse) GetDeposit() *Deposit {
+	if x != nil {
+		return x.Deposit
+	}
+	return nil
+}
+
+// ListDepositsRequest lists deposits newest first, a page at a time, blank filters list every deposit
+type ListDepositsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvestorId string          `protobuf:"bytes,1,opt,name=investor_id,json=investorId,proto3" json:"investor_id,omitempty"`
+	Statuses   []DepositStatus `protobuf:"varint,2,rep,packed,name=statuses,proto3,enum=deposits.v1.DepositStatus" json:"statuses,omitempty"`
+	// Deposits created from created_from, up to but not including created_to
+	CreatedFrom *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	// page_size defaults to 50, and is capped at 100
+	PageSize int32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token is the next_page_token from the previous page, blank for the first page
+	PageToken string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListDepositsRequest) Reset() {
+	*x = ListDepositsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDepositsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDepositsRequest) ProtoMessage() {}
+
+func (x *ListDepositsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDepositsRequest.ProtoReflect.Descriptor instead.
+func (*ListDepositsRequest) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListDepositsRequest) GetInvestorId() string {
+	if x != nil {
+		return x.InvestorId
+	}
+	return ""
+}
+
+func (x *ListDepositsRequest) GetStatuses() []DepositStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListDepositsRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *ListDepositsRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *ListDepositsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDepositsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListDepositsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deposits []*DepositSummary `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits,omitempty"`
+	// next_page_token is blank on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListDepositsResponse) Reset() {
+	*x = ListDepositsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDepositsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDepositsResponse) ProtoMessage() {}
+
+func (x *ListDepositsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDepositsResponse.ProtoReflect.Descriptor instead.
+func (*ListDepositsResponse) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListDepositsResponse) GetDeposits() []*DepositSummary {
+	if x != nil {
+		return x.Deposits
+	}
+	return nil
+}
+
+func (x *ListDepositsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// DepositSummary is a deposit with the totals of its accounts, instead of the accounts themselves
+type DepositSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	InvestorId string                 `protobuf:"bytes,2,opt,name=investor_id,json=investorId,proto3" json:"investor_id,omitempty"`
+	Status     DepositStatus          `protobuf:"varint,3,opt,name=status,proto3,enum=deposits.v1.DepositStatus" json:"status,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// One total for each currency the deposit's accounts are in
+	Totals []*DepositTotal `protobuf:"bytes,5,rep,name=totals,proto3" json:"totals,omitempty"`
+}
+
+func (x *DepositSummary) Reset() {
+	*x = DepositSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositSummary) ProtoMessage() {}
+
+func (x *DepositSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositSummary.ProtoReflect.Descriptor instead.
+func (*DepositSummary) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DepositSummary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DepositSummary) GetInvestorId() string {
+	if x != nil {
+		return x.InvestorId
+	}
+	return ""
+}
+
+func (x *DepositSummary) GetStatus() DepositStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DepositStatus_DEPOSIT_STATUS_UNSPECIFIED
+}
+
+func (x *DepositSummary) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DepositSummary) GetTotals() []*DepositTotal {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+type DepositTotal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NominalAmount   *Money `protobuf:"bytes,1,opt,name=nominal_amount,json=nominalAmount,proto3" json:"nominal_amount,omitempty"`
+	AllocatedAmount *Money `protobuf:"bytes,2,opt,name=allocated_amount,json=allocatedAmount,proto3" json:"allocated_amount,omitempty"`
+	// How much of the nominal is allocated, with any relief pending on it, from 0 to 100
+	FundedPercentage float64 `protobuf:"fixed64,3,opt,name=funded_percentage,json=fundedPercentage,proto3" json:"funded_percentage,omitempty"`
+}
+
+func (x *DepositTotal) Reset() {
+	*x = DepositTotal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositTotal) ProtoMessage() {}
+
+func (x *DepositTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositTotal.ProtoReflect.Descriptor instead.
+func (*DepositTotal) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DepositTotal) GetNominalAmount() *Money {
+	if x != nil {
+		return x.NominalAmount
+	}
+	return nil
+}
+
+func (x *DepositTotal) GetAllocatedAmount() *Money {
+	if x != nil {
+		return x.AllocatedAmount
+	}
+	return nil
+}
+
+func (x *DepositTotal) GetFundedPercentage() float64 {
+	if x != nil {
+		return x.FundedPercentage
+	}
+	return 0
+}
+
+type CreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvestorId string   `protobuf:"bytes,1,opt,name=investor_id,json=investorId,proto3" json:"investor_id,omitempty"`
+	Deposit    *Deposit `protobuf:"bytes,2,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (x *CreateRequest) Reset() {
+	*x = CreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRequest) ProtoMessage() {}
+
+func (x *CreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRequest.ProtoReflect.Descriptor instead.
+func (*CreateRequest) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateRequest) GetInvestorId() string {
+	if x != nil {
+		return x.InvestorId
+	}
+	return ""
+}
+
+func (x *CreateRequest) GetDeposit() *Deposit {
+	if x != nil {
+		return x.Deposit
+	}
+	return nil
+}
+
+type CreateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deposit *Deposit `protobuf:"bytes,1,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (x *CreateResponse) Reset() {
+	*x = CreateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateResponse) ProtoMessage() {}
+
+func (x *CreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateResponse.ProtoReflect.Descriptor instead.
+func (*CreateResponse) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CreateResponse) GetDeposit() *Deposit {
+	if x != nil {
+		return x.Deposit
+	}
+	return nil
+}
+
+type Deposit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pots       []*Pot        `protobuf:"bytes,2,rep,name=pots,proto3" json:"pots,omitempty"`
+	Status     DepositStatus `protobuf:"varint,3,opt,name=status,proto3,enum=deposits.v1.DepositStatus" json:"status,omitempty"`
+	InvestorId string        `protobuf:"bytes,4,opt,name=investor_id,json=investorId,proto3" json:"investor_id,omitempty"`
+	// Receipts held in the deposit's suspense balance, instead of on an account
+	SuspenseReceipts []*Receipt `protobuf:"bytes,5,rep,name=suspense_receipts,json=suspenseReceipts,proto3" json:"suspense_receipts,omitempty"`
+}
+
+func (x *Deposit) Reset() {
+	*x = Deposit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Deposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deposit) ProtoMessage() {}
+
+func (x *Deposit) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Deposit.ProtoReflect.Descriptor instead.
+func (*Deposit) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Deposit) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Deposit) GetPots() []*Pot {
+	if x != nil {
+		return x.Pots
+	}
+	return nil
+}
+
+func (x *Deposit) GetStatus() DepositStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DepositStatus_DEPOSIT_STATUS_UNSPECIFIED
+}
+
+func (x *Deposit) GetInvestorId() string {
+	if x != nil {
+		return x.InvestorId
+	}
+	return ""
+}
+
+func (x *Deposit) GetSuspenseReceipts() []*Receipt {
+	if x != nil {
+		return x.SuspenseReceipts
+	}
+	return nil
+}
+
+type Pot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Accounts []*Account `protobuf:"bytes,3,rep,name=accounts,proto3" json:"accounts,omitempty"`
+}
+
+func (x *Pot) Reset() {
+	*x = Pot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pot) ProtoMessage() {}
+
+func (x *Pot) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pot.ProtoReflect.Descriptor instead.
+func (*Pot) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Pot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Pot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Pot) GetAccounts() []*Account {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+type Account struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                   string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WrapperType          WrapperType `protobuf:"varint,2,opt,name=wrapper_type,json=wrapperType,proto3,enum=deposits.v1.WrapperType" json:"wrapper_type,omitempty"`
+	NominalAmount        *Money      `protobuf:"bytes,3,opt,name=nominal_amount,json=nominalAmount,proto3" json:"nominal_amount,omitempty"`
+	TotalAllocatedAmount *Money      `protobuf:"bytes,4,opt,name=total_allocated_amount,json=totalAllocatedAmount,proto3" json:"total_allocated_amount,omitempty"`
+	PendingReliefAmount  *Money      `protobuf:"bytes,5,opt,name=pending_relief_amount,json=pendingReliefAmount,proto3" json:"pending_relief_amount,omitempty"`
+	// Every receipt the account has received, including those since reversed
+	Receipts []*Receipt `protobuf:"bytes,6,rep,name=receipts,proto3" json:"receipts,omitempty"`
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Account) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Account) GetWrapperType() WrapperType {
+	if x != nil {
+		return x.WrapperType
+	}
+	return WrapperType_WRAPPER_TYPE_UNSPECIFIED
+}
+
+func (x *Account) GetNominalAmount() *Money {
+	if x != nil {
+		return x.NominalAmount
+	}
+	return nil
+}
+
+func (x *Account) GetTotalAllocatedAmount() *Money {
+	if x != nil {
+		return x.TotalAllocatedAmount
+	}
+	return nil
+}
+
+func (x *Account) GetPendingReliefAmount() *Money {
+	if x != nil {
+		return x.PendingReliefAmount
+	}
+	return nil
+}
+
+func (x *Account) GetReceipts() []*Receipt {
+	if x != nil {
+		return x.Receipts
+	}
+	return nil
+}
+
+// Money is an amount in the minor units of an ISO 4217 currency, e.g. pence for GBP
+type Money struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount   int64  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Money) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// AddPot adds a new pot, ids are generated for it and its accounts
+type DepositAmendment_AddPot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pot *Pot `protobuf:"bytes,1,opt,name=pot,proto3" json:"pot,omitempty"`
+}
+
+func (x *DepositAmendment_AddPot) Reset() {
+	*x = DepositAmendment_AddPot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositAmendment_AddPot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositAmendment_AddPot) ProtoMessage() {}
+
+func (x *DepositAmendment_AddPot) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositAmendment_AddPot.ProtoReflect.Descriptor instead.
+func (*DepositAmendment_AddPot) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{31, 0}
+}
+
+func (x *DepositAmendment_AddPot) GetPot() *Pot {
+	if x != nil {
+		return x.Pot
+	}
+	return nil
+}
+
+type DepositAmendment_RenamePot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PotId string `protobuf:"bytes,1,opt,name=pot_id,json=potId,proto3" json:"pot_id,omitempty"`
+	Name  string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DepositAmendment_RenamePot) Reset() {
+	*x = DepositAmendment_RenamePot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositAmendment_RenamePot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositAmendment_RenamePot) ProtoMessage() {}
+
+func (x *DepositAmendment_RenamePot) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositAmendment_RenamePot.ProtoReflect.Descriptor instead.
+func (*DepositAmendment_RenamePot) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{31, 1}
+}
+
+func (x *DepositAmendment_RenamePot) GetPotId() string {
+	if x != nil {
+		return x.PotId
+	}
+	return ""
+}
+
+func (x *DepositAmendment_RenamePot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// AddAccount adds a new account to an existing pot, an id is generated for it
+type DepositAmendment_AddAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PotId   string   `protobuf:"bytes,1,opt,name=pot_id,json=potId,proto3" json:"pot_id,omitempty"`
+	Account *Account `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *DepositAmendment_AddAccount) Reset() {
+	*x = DepositAmendment_AddAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositAmendment_AddAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositAmendment_AddAccount) ProtoMessage() {}
+
+func (x *DepositAmendment_AddAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositAmendment_AddAccount.ProtoReflect.Descriptor instead.
+func (*DepositAmendment_AddAccount) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{31, 2}
+}
+
+func (x *DepositAmendment_AddAccount) GetPotId() string {
+	if x != nil {
+		return x.PotId
+	}
+	return ""
+}
+
+func (x *DepositAmendment_AddAccount) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// ChangeNominal can't take an ISA or SIPP below what's allocated to it
+type DepositAmendment_ChangeNominal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId     string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	NominalAmount *Money `protobuf:"bytes,2,opt,name=nominal_amount,json=nominalAmount,proto3" json:"nominal_amount,omitempty"`
+}
+
+func (x *DepositAmendment_ChangeNominal) Reset() {
+	*x = DepositAmendment_ChangeNominal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositAmendment_ChangeNominal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositAmendment_ChangeNominal) ProtoMessage() {}
+
+func (x *DepositAmendment_ChangeNominal) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositAmendment_ChangeNominal.ProtoReflect.Descriptor instead.
+func (*DepositAmendment_ChangeNominal) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{31, 3}
+}
+
+func (x *DepositAmendment_ChangeNominal) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *DepositAmendment_ChangeNominal) GetNominalAmount() *Money {
+	if x != nil {
+		return x.NominalAmount
+	}
+	return nil
+}
+
+// RemoveAccount only removes accounts that haven't received anything
+type DepositAmendment_RemoveAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+}
+
+func (x *DepositAmendment_RemoveAccount) Reset() {
+	*x = DepositAmendment_RemoveAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deposits_v1_deposits_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositAmendment_RemoveAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositAmendment_RemoveAccount) ProtoMessage() {}
+
+func (x *DepositAmendment_RemoveAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_deposits_v1_deposits_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositAmendment_RemoveAccount.ProtoReflect.Descriptor instead.
+func (*DepositAmendment_RemoveAccount) Descriptor() ([]byte, []int) {
+	return file_deposits_v1_deposits_proto_rawDescGZIP(), []int{31, 4}
+}
+
+func (x *DepositAmendment_RemoveAccount) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+var File_deposits_v1_deposits_proto protoreflect.FileDescriptor
+
+var file_deposits_v1_deposits_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x33, 0x0a, 0x19, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x6c, 0x69, 0x65, 0x66, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22,
+	0x51, 0x0a, 0x1a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x6c, 0x69, 0x65, 0x66, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a,
+	0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x69, 0x65,
+	0x66, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x22, 0x3c, 0x0a, 0x1f, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x6c, 0x69, 0x65, 0x66,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x61, 0x69, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64,
+	0x22, 0x57, 0x0a, 0x20, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x6c, 0x69, 0x65, 0x66, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x61, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x6c, 0x69, 0x65, 0x66, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x22, 0xed, 0x01, 0x0a, 0x10, 0x52, 0x65,
+	0x6c, 0x69, 0x65, 0x66, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x3b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x69, 0x65, 0x66, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x6c, 0x69, 0x65, 0x66, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x06, 0x63,
+	0x6c, 0x61, 0x69, 0x6d, 0x73, 0x12, 0x42, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72,
+	0x65, 0x6c, 0x69, 0x65, 0x66, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x6c,
+	0x69, 0x65, 0x66, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x83, 0x03, 0x0a, 0x0b, 0x52, 0x65,
+	0x6c, 0x69, 0x65, 0x66, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x09, 0x6e, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x0c, 0x67,
+	0x72, 0x6f, 0x73, 0x73, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x73, 0x73, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x37, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x69, 0x65, 0x66, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0c, 0x72,
+	0x65, 0x6c, 0x69, 0x65, 0x66, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x69, 0x65, 0x66,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x6c, 0x69, 0x65, 0x66, 0x5f, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x72, 0x65, 0x6c, 0x69, 0x65, 0x66, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x64, 0x22,
+	0x57, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x74, 0x61, 0x78, 0x5f, 0x79, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x74, 0x61, 0x78, 0x59, 0x65, 0x61, 0x72, 0x22, 0x58, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41,
+	0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e,
+	0x63, 0x65, 0x22, 0xd1, 0x01, 0x0a, 0x0f, 0x41, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x41, 0x6c, 0x6c,
+	0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x78, 0x5f, 0x79,
+	0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x78, 0x59, 0x65,
+	0x61, 0x72, 0x12, 0x28, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x26, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x04,
+	0x75, 0x73, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09, 0x72, 0x65, 0x6d,
+	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x82, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x2e, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x22, 0x89, 0x01, 0x0a, 0x16,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x3f, 0x0a, 0x10, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c,
+	0x6f, 0x77, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x0f, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x22, 0xe2, 0x02, 0x0a, 0x1c, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x12, 0x41, 0x0a, 0x0f, 0x77, 0x61, 0x74, 0x65, 0x72, 0x66, 0x61, 0x6c, 0x6c,
+	0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x61, 0x70, 0x70,
+	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x77, 0x61, 0x74, 0x65, 0x72, 0x66, 0x61, 0x6c,
+	0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12,
+	0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x22, 0x65, 0x0a, 0x1d,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a,
+	0x0f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x52, 0x0e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x22, 0x96, 0x02, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69,
+	0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x30, 0x0a,
+	0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x12,
+	0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x22, 0x52, 0x0a, 0x20,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x55, 0x6e, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x22, 0x53, 0x0a, 0x21, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x55, 0x6e, 0x61, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x07, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x22, 0x3f, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x61,
+	0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x49, 0x64, 0x22, 0x53, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e,
+	0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x22, 0xca, 0x01, 0x0a, 0x21,
+	0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x2a, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69,
+	0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x4b, 0x65, 0x79, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x22, 0x65, 0x0a, 0x22, 0x41, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f,
+	0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x3f, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x41,
+	0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x64,
+	0x22, 0x64, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x65,
+	0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x41,
+	0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xcf, 0x02, 0x0a, 0x12, 0x53, 0x75, 0x73, 0x70, 0x65,
+	0x6e, 0x73, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a,
+	0x16, 0x75, 0x6e, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x75,
+	0x6e, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x2a, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79,
+	0x12, 0x3d, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x94, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49,
+	0x64, 0x12, 0x33, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22,
+	0x4b, 0x0a, 0x16, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x72, 0x65, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x6c, 0x52, 0x08, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x22, 0xe2, 0x01, 0x0a,
+	0x08, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d,
+	0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65,
+	0x79, 0x22, 0xf3, 0x03, 0x0a, 0x07, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3d, 0x0a,
+	0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0f, 0x61, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f,
+	0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x6f,
+	0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f,
+	0x77, 0x4f, 0x66, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x5f,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x41, 0x0a, 0x12, 0x75, 0x6e, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x11, 0x75, 0x6e, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x22, 0x1c, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3d, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x07, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x22, 0x65, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3d, 0x0a, 0x0a,
+	0x61, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x0a, 0x61, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x47, 0x0a, 0x15, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x07, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x22, 0xf9, 0x05, 0x0a, 0x10, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x5f, 0x70, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x6f, 0x74,
+	0x48, 0x00, 0x52, 0x06, 0x61, 0x64, 0x64, 0x50, 0x6f, 0x74, 0x12, 0x48, 0x0a, 0x0a, 0x72, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x50, 0x6f, 0x74, 0x48, 0x00, 0x52, 0x09, 0x72, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x50, 0x6f, 0x74, 0x12, 0x4b, 0x0a, 0x0b, 0x61, 0x64, 0x64, 0x5f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41,
+	0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x64, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x54, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6e, 0x6f, 0x6d, 0x69,
+	0x6e, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41,
+	0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4e,
+	0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x4e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x54, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2b, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0d,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x2c, 0x0a,
+	0x06, 0x41, 0x64, 0x64, 0x50, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x03, 0x70, 0x6f, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x74, 0x52, 0x03, 0x70, 0x6f, 0x74, 0x1a, 0x36, 0x0a, 0x09, 0x52,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x6f, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x70, 0x6f, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x6f, 0x74, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x1a, 0x53, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x15, 0x0a, 0x06, 0x70, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x70, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x69, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x4e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x6d, 0x69,
+	0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0d, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x1a, 0x2e, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x49, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x61, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74,
+	0x22, 0x26, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x47, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2e, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x22, 0x25, 0x0a, 0x13, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x46, 0x0a, 0x14, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2e, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x22, 0xa4, 0x02, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x08, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65,
+	0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x77, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x37, 0x0a, 0x08, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x08,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0xe3, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x06,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x6d, 0x69, 0x6e,
+	0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x0d, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x2b, 0x0a, 0x11, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x66, 0x75,
+	0x6e, 0x64, 0x65, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0x60,
+	0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x49, 0x64,
+	0x12, 0x2e, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x22, 0x40, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x22, 0xd7, 0x01, 0x0a, 0x07, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24,
+	0x0a, 0x04, 0x70, 0x6f, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x74, 0x52, 0x04,
+	0x70, 0x6f, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x11, 0x73, 0x75, 0x73,
+	0x70, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x10, 0x73, 0x75, 0x73, 0x70,
+	0x65, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x22, 0x5b, 0x0a, 0x03,
+	0x50, 0x6f, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0xd5, 0x02, 0x0a, 0x07, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x0c, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x39, 0x0a, 0x0e, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0d,
+	0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x48, 0x0a,
+	0x16, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x46, 0x0a, 0x15, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x72, 0x65, 0x6c, 0x69, 0x65, 0x66, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x13, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x6c, 0x69, 0x65, 0x66, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x30, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x73, 0x22, 0x3b, 0x0a, 0x05, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2a, 0x90,
+	0x01, 0x0a, 0x16, 0x52, 0x65, 0x6c, 0x69, 0x65, 0x66, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x25, 0x52, 0x45, 0x4c,
+	0x49, 0x45, 0x46, 0x5f, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x27, 0x0a, 0x23, 0x52, 0x45, 0x4c, 0x49, 0x45, 0x46, 0x5f, 0x43,
+	0x4c, 0x41, 0x49, 0x4d, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x54, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x22, 0x0a,
+	0x1e, 0x52, 0x45, 0x4c, 0x49, 0x45, 0x46, 0x5f, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x5f, 0x42, 0x41,
+	0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x41, 0x49, 0x44, 0x10,
+	0x02, 0x2a, 0xbb, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x6c, 0x69, 0x65, 0x66, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x1f, 0x52, 0x45, 0x4c, 0x49, 0x45,
+	0x46, 0x5f, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b,
+	0x52, 0x45, 0x4c, 0x49, 0x45, 0x46, 0x5f, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1f, 0x0a,
+	0x1b, 0x52, 0x45, 0x4c, 0x49, 0x45, 0x46, 0x5f, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1c,
+	0x0a, 0x18, 0x52, 0x45, 0x4c, 0x49, 0x45, 0x46, 0x5f, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x41, 0x49, 0x44, 0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d,
+	0x52, 0x45, 0x4c, 0x49, 0x45, 0x46, 0x5f, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x2a,
+	0x82, 0x01, 0x0a, 0x12, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x23, 0x0a, 0x1f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x41,
+	0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45,
+	0x47, 0x59, 0x5f, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x52, 0x54, 0x49, 0x4f, 0x4e, 0x41, 0x4c, 0x10,
+	0x01, 0x12, 0x21, 0x0a, 0x1d, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x57, 0x41, 0x54, 0x45, 0x52, 0x46, 0x41,
+	0x4c, 0x4c, 0x10, 0x02, 0x2a, 0x6c, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x56, 0x45, 0x52, 0x53,
+	0x41, 0x4c, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x56, 0x45, 0x52,
+	0x53, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x42, 0x4f, 0x55, 0x4e, 0x43,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x45, 0x56, 0x45, 0x52, 0x53, 0x41, 0x4c,
+	0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x43, 0x41, 0x4c, 0x4c, 0x45, 0x44,
+	0x10, 0x02, 0x2a, 0xdb, 0x01, 0x0a, 0x0d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x52, 0x41, 0x46, 0x54, 0x10, 0x01, 0x12, 0x17,
+	0x0a, 0x13, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x44, 0x45, 0x50, 0x4f, 0x53,
+	0x49, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41,
+	0x4c, 0x4c, 0x59, 0x5f, 0x46, 0x55, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15,
+	0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46,
+	0x55, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x45, 0x50, 0x4f, 0x53,
+	0x49, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44,
+	0x10, 0x05, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x06,
+	0x2a, 0xe3, 0x01, 0x0a, 0x0b, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1c, 0x0a, 0x18, 0x57, 0x52, 0x41, 0x50, 0x50, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14,
+	0x0a, 0x10, 0x57, 0x52, 0x41, 0x50, 0x50, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47,
+	0x49, 0x41, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x57, 0x52, 0x41, 0x50, 0x50, 0x45, 0x52, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x53, 0x41, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x57, 0x52,
+	0x41, 0x50, 0x50, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x49, 0x50, 0x50, 0x10,
+	0x03, 0x12, 0x1d, 0x0a, 0x19, 0x57, 0x52, 0x41, 0x50, 0x50, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x4c, 0x49, 0x46, 0x45, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x49, 0x53, 0x41, 0x10, 0x04,
+	0x12, 0x1b, 0x0a, 0x17, 0x57, 0x52, 0x41, 0x50, 0x50, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x4a, 0x55, 0x4e, 0x49, 0x4f, 0x52, 0x5f, 0x49, 0x53, 0x41, 0x10, 0x05, 0x12, 0x1c, 0x0a,
+	0x18, 0x57, 0x52, 0x41, 0x50, 0x50, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4a, 0x49,
+	0x53, 0x41, 0x5f, 0x54, 0x4f, 0x5f, 0x49, 0x53, 0x41, 0x10, 0x06, 0x12, 0x19, 0x0a, 0x15, 0x57,
+	0x52, 0x41, 0x50, 0x50, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x41, 0x53, 0x48,
+	0x5f, 0x49, 0x53, 0x41, 0x10, 0x07, 0x32, 0xbc, 0x0c, 0x0a, 0x0f, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a,
+	0x03, 0x47, 0x65, 0x74, 0x12, 0x17, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0d,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x21, 0x2e,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x21, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0c,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x20, 0x2e, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x59, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x12, 0x22, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0e,
+	0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x22,
+	0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x15, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x12, 0x29, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x19, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x55, 0x6e, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x12, 0x2d, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x55, 0x6e, 0x61, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x55, 0x6e, 0x61, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x61, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x12, 0x2b,
+	0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x55, 0x6e, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e,
+	0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x1a, 0x41, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x2e, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x55, 0x6e,
+	0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x55, 0x6e,
+	0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x41, 0x6c,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2c, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x26, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6e,
+	0x6e, 0x75, 0x61, 0x6c, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x6c, 0x69, 0x65, 0x66, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x12, 0x26, 0x2e, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x6c, 0x69, 0x65, 0x66, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x6c, 0x69, 0x65, 0x66, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x18,
+	0x4d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x6c, 0x69, 0x65, 0x66, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x50, 0x61, 0x69, 0x64, 0x12, 0x2c, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x6c, 0x69, 0x65,
+	0x66, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x61, 0x69, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x6c, 0x69, 0x65, 0x66, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x61, 0x69, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xb5, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0d, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x61, 0x69, 0x6e, 0x76, 0x6d, 0x2f, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73,
+	0x76, 0x31, 0xa2, 0x02, 0x03, 0x44, 0x58, 0x58, 0xaa, 0x02, 0x0b, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0b, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x17, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x5c,
+	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x0c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_deposits_v1_deposits_proto_rawDescOnce sync.Once
+	file_deposits_v1_deposits_proto_rawDescData = file_deposits_v1_deposits_proto_rawDesc
+)
+
+func file_deposits_v1_deposits_proto_rawDescGZIP() []byte {
+	file_deposits_v1_deposits_proto_rawDescOnce.Do(func() {
+		file_deposits_v1_deposits_proto_rawDescData = protoimpl.X.CompressGZIP(file_deposits_v1_deposits_proto_rawDescData)
+	})
+	return file_deposits_v1_deposits_proto_rawDescData
+}
+
+var file_deposits_v1_deposits_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_deposits_v1_deposits_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
+var file_deposits_v1_deposits_proto_goTypes = []any{
+	(ReliefClaimBatchStatus)(0),                // 0: deposits.v1.ReliefClaimBatchStatus
+	(ReliefClaimStatus)(0),                     // 1: deposits.v1.ReliefClaimStatus
+	(AllocationStrategy)(0),                    // 2: deposits.v1.AllocationStrategy
+	(ReversalReason)(0),                        // 3: deposits.v1.ReversalReason
+	(DepositStatus)(0),                         // 4: deposits.v1.DepositStatus
+	(WrapperType)(0),                           // 5: deposits.v1.WrapperType
+	(*ExportReliefClaimsRequest)(nil),          // 6: deposits.v1.ExportReliefClaimsRequest
+	(*ExportReliefClaimsResponse)(nil),         // 7: deposits.v1.ExportReliefClaimsResponse
+	(*MarkReliefClaimBatchPaidRequest)(nil),    // 8: deposits.v1.MarkReliefClaimBatchPaidRequest
+	(*MarkReliefClaimBatchPaidResponse)(nil),   // 9: deposits.v1.MarkReliefClaimBatchPaidResponse
+	(*ReliefClaimBatch)(nil),                   // 10: deposits.v1.ReliefClaimBatch
+	(*ReliefClaim)(nil),                        // 11: deposits.v1.ReliefClaim
+	(*GetAnnualAllowanceRequest)(nil),          // 12: deposits.v1.GetAnnualAllowanceRequest
+	(*GetAnnualAllowanceResponse)(nil),         // 13: deposits.v1.GetAnnualAllowanceResponse
+	(*AnnualAllowance)(nil),                    // 14: deposits.v1.AnnualAllowance
+	(*ReceiveReceiptRequest)(nil),              // 15: deposits.v1.ReceiveReceiptRequest
+	(*ReceiveReceiptResponse)(nil),             // 16: deposits.v1.ReceiveReceiptResponse
+	(*ReceiveDepositReceiptRequest)(nil),       // 17: deposits.v1.ReceiveDepositReceiptRequest
+	(*ReceiveDepositReceiptResponse)(nil),      // 18: deposits.v1.ReceiveDepositReceiptResponse
+	(*DepositReceipt)(nil),                     // 19: deposits.v1.DepositReceipt
+	(*ReceiveUnallocatedReceiptRequest)(nil),   // 20: deposits.v1.ReceiveUnallocatedReceiptRequest
+	(*ReceiveUnallocatedReceiptResponse)(nil),  // 21: deposits.v1.ReceiveUnallocatedReceiptResponse
+	(*ListUnallocatedReceiptsRequest)(nil),     // 22: deposits.v1.ListUnallocatedReceiptsRequest
+	(*ListUnallocatedReceiptsResponse)(nil),    // 23: deposits.v1.ListUnallocatedReceiptsResponse
+	(*AllocateUnallocatedReceiptRequest)(nil),  // 24: deposits.v1.AllocateUnallocatedReceiptRequest
+	(*AllocateUnallocatedReceiptResponse)(nil), // 25: deposits.v1.AllocateUnallocatedReceiptResponse
+	(*ListSuspenseAllocationsRequest)(nil),     // 26: deposits.v1.ListSuspenseAllocationsRequest
+	(*ListSuspenseAllocationsResponse)(nil),    // 27: deposits.v1.ListSuspenseAllocationsResponse
+	(*SuspenseAllocation)(nil),                 // 28: deposits.v1.SuspenseAllocation
+	(*ReverseReceiptRequest)(nil),              // 29: deposits.v1.ReverseReceiptRequest
+	(*ReverseReceiptResponse)(nil),             // 30: deposits.v1.ReverseReceiptResponse
+	(*Reversal)(nil),                           // 31: deposits.v1.Reversal
+	(*Receipt)(nil),                            // 32: deposits.v1.Receipt
+	(*GetRequest)(nil),                         // 33: deposits.v1.GetRequest
+	(*GetResponse)(nil),                        // 34: deposits.v1.GetResponse
+	(*UpdateDepositRequest)(nil),               // 35: deposits.v1.UpdateDepositRequest
+	(*UpdateDepositResponse)(nil),              // 36: deposits.v1.UpdateDepositResponse
+	(*DepositAmendment)(nil),                   // 37: deposits.v1.DepositAmendment
+	(*CancelDepositRequest)(nil),               // 38: deposits.v1.CancelDepositRequest
+	(*CancelDepositResponse)(nil),              // 39: deposits.v1.CancelDepositResponse
+	(*CloseDepositRequest)(nil),                // 40: deposits.v1.CloseDepositRequest
+	(*CloseDepositResponse)(nil),               // 41: deposits.v1.CloseDepositResponse
+	(*ListDepositsRequest)(nil),                // 42: deposits.v1.ListDepositsRequest
+	(*ListDepositsResponse)(nil),               // 43: deposits.v1.ListDepositsResponse
+	(*DepositSummary)(nil),                     // 44: deposits.v1.DepositSummary
+	(*DepositTotal)(nil),                       // 45: deposits.v1.DepositTotal
+	(*CreateRequest)(nil),                      // 46: deposits.v1.CreateRequest
+	(*CreateResponse)(nil),                     // 47: deposits.v1.CreateResponse
+	(*Deposit)(nil),                            // 48: deposits.v1.Deposit
+	(*Pot)(nil),                                // 49: deposits.v1.Pot
+	(*Account)(nil),                            // 50: deposits.v1.Account
+	(*Money)(nil),                              // 51: deposits.v1.Money
+	(*DepositAmendment_AddPot)(nil),            // 52: deposits.v1.DepositAmendment.AddPot
+	(*DepositAmendment_RenamePot)(nil),         // 53: deposits.v1.DepositAmendment.RenamePot
+	(*DepositAmendment_AddAccount)(nil),        // 54: deposits.v1.DepositAmendment.AddAccount
+	(*DepositAmendment_ChangeNominal)(nil),     // 55: deposits.v1.DepositAmendment.ChangeNominal
+	(*DepositAmendment_RemoveAccount)(nil),     // 56: deposits.v1.DepositAmendment.RemoveAccount
+	(*timestamppb.Timestamp)(nil),              // 57: google.protobuf.Timestamp
+}
+var file_deposits_v1_deposits_proto_depIdxs = []int32{
+	10, // 0: deposits.v1.ExportReliefClaimsResponse.batch:type_name -> deposits.v1.ReliefClaimBatch
+	10, // 1: deposits.v1.MarkReliefClaimBatchPaidResponse.batch:type_name -> deposits.v1.ReliefClaimBatch
+	0,  // 2: deposits.v1.ReliefClaimBatch.status:type_name -> deposits.v1.ReliefClaimBatchStatus
+	11, // 3: deposits.v1.ReliefClaimBatch.claims:type_name -> deposits.v1.ReliefClaim
+	51, // 4: deposits.v1.ReliefClaimBatch.total_relief_amount:type_name -> deposits.v1.Money
+	51, // 5: deposits.v1.ReliefClaim.net_amount:type_name -> deposits.v1.Money
+	51, // 6: deposits.v1.ReliefClaim.gross_amount:type_name -> deposits.v1.Money
+	51, // 7: deposits.v1.ReliefClaim.relief_amount:type_name -> deposits.v1.Money
+	1,  // 8: deposits.v1.ReliefClaim.status:type_name -> deposits.v1.ReliefClaimStatus
+	14, // 9: deposits.v1.GetAnnualAllowanceResponse.allowance:type_name -> deposits.v1.AnnualAllowance
+	51, // 10: deposits.v1.AnnualAllowance.limit:type_name -> deposits.v1.Money
+	51, // 11: deposits.v1.AnnualAllowance.used:type_name -> deposits.v1.Money
+	51, // 12: deposits.v1.AnnualAllowance.remaining:type_name -> deposits.v1.Money
+	32, // 13: deposits.v1.ReceiveReceiptRequest.receipt:type_name -> deposits.v1.Receipt
+	32, // 14: deposits.v1.ReceiveReceiptResponse.receipt:type_name -> deposits.v1.Receipt
+	32, // 15: deposits.v1.ReceiveReceiptResponse.overflow_receipt:type_name -> deposits.v1.Receipt
+	51, // 16: deposits.v1.ReceiveDepositReceiptRequest.allocated_amount:type_name -> deposits.v1.Money
+	2,  // 17: deposits.v1.ReceiveDepositReceiptRequest.strategy:type_name -> deposits.v1.AllocationStrategy
+	5,  // 18: deposits.v1.ReceiveDepositReceiptRequest.waterfall_order:type_name -> deposits.v1.WrapperType
+	57, // 19: deposits.v1.ReceiveDepositReceiptRequest.received_at:type_name -> google.protobuf.Timestamp
+	19, // 20: deposits.v1.ReceiveDepositReceiptResponse.deposit_receipt:type_name -> deposits.v1.DepositReceipt
+	51, // 21: deposits.v1.DepositReceipt.allocated_amount:type_name -> deposits.v1.Money
+	32, // 22: deposits.v1.DepositReceipt.receipts:type_name -> deposits.v1.Receipt
+	57, // 23: deposits.v1.DepositReceipt.received_at:type_name -> google.protobuf.Timestamp
+	32, // 24: deposits.v1.ReceiveUnallocatedReceiptRequest.receipt:type_name -> deposits.v1.Receipt
+	32, // 25: deposits.v1.ReceiveUnallocatedReceiptResponse.receipt:type_name -> deposits.v1.Receipt
+	32, // 26: deposits.v1.ListUnallocatedReceiptsResponse.receipts:type_name -> deposits.v1.Receipt
+	51, // 27: deposits.v1.AllocateUnallocatedReceiptRequest.amount:type_name -> deposits.v1.Money
+	28, // 28: deposits.v1.AllocateUnallocatedReceiptResponse.allocation:type_name -> deposits.v1.SuspenseAllocation
+	28, // 29: deposits.v1.ListSuspenseAllocationsResponse.allocations:type_name -> deposits.v1.SuspenseAllocation
+	51, // 30: deposits.v1.SuspenseAllocation.amount:type_name -> deposits.v1.Money
+	57, // 31: deposits.v1.SuspenseAllocation.allocated_at:type_name -> google.protobuf.Timestamp
+	3,  // 32: deposits.v1.ReverseReceiptRequest.reason:type_name -> deposits.v1.ReversalReason
+	31, // 33: deposits.v1.ReverseReceiptResponse.reversal:type_name -> deposits.v1.Reversal
+	51, // 34: deposits.v1.Reversal.amount:type_name -> deposits.v1.Money
+	3,  // 35: deposits.v1.Reversal.reason:type_name -> deposits.v1.ReversalReason
+	51, // 36: deposits.v1.Receipt.allocated_amount:type_name -> deposits.v1.Money
+	51, // 37: deposits.v1.Receipt.unallocated_amount:type_name -> deposits.v1.Money
+	57, // 38: deposits.v1.Receipt.received_at:type_name -> google.protobuf.Timestamp
+	48, // 39: deposits.v1.GetResponse.deposit:type_name -> deposits.v1.Deposit
+	37, // 40: deposits.v1.UpdateDepositRequest.amendments:type_name -> deposits.v1.DepositAmendment
+	48, // 41: deposits.v1.UpdateDepositResponse.deposit:type_name -> deposits.v1.Deposit
+	52, // 42: deposits.v1.DepositAmendment.add_pot:type_name -> deposits.v1.DepositAmendment.AddPot
+	53, // 43: deposits.v1.DepositAmendment.rename_pot:type_name -> deposits.v1.DepositAmendment.RenamePot
+	54, // 44: deposits.v1.DepositAmendment.add_account:type_name -> deposits.v1.DepositAmendment.AddAccount
+	55, // 45: deposits.v1.DepositAmendment.change_nominal:type_name -> deposits.v1.DepositAmendment.ChangeNominal
+	56, // 46: deposits.v1.DepositAmendment.remove_account:type_name -> deposits.v1.DepositAmendment.RemoveAccount
+	48, // 47: deposits.v1.CancelDepositResponse.deposit:type_name -> deposits.v1.Deposit
+	48, // 48: deposits.v1.CloseDepositResponse.deposit:type_name -> deposits.v1.Deposit
+	4,  // 49: deposits.v1.ListDepositsRequest.statuses:type_name -> deposits.v1.DepositStatus
+	57, // 50: deposits.v1.ListDepositsRequest.created_from:type_name -> google.protobuf.Timestamp
+	57, // 51: deposits.v1.ListDepositsRequest.created_to:type_name -> google.protobuf.Timestamp
+	44, // 52: deposits.v1.ListDepositsResponse.deposits:type_name -> deposits.v1.DepositSummary
+	4,  // 53: deposits.v1.DepositSummary.status:type_name -> deposits.v1.DepositStatus
+	57, // 54: deposits.v1.DepositSummary.created_at:type_name -> google.protobuf.Timestamp
+	45, // 55: deposits.v1.DepositSummary.totals:type_name -> deposits.v1.DepositTotal
+	51, // 56: deposits.v1.DepositTotal.nominal_amount:type_name -> deposits.v1.Money
+	51, // 57: deposits.v1.DepositTotal.allocated_amount:type_name -> deposits.v1.Money
+	48, // 58: deposits.v1.CreateRequest.deposit:type_name -> deposits.v1.Deposit
+	48, // 59: deposits.v1.CreateResponse.deposit:type_name -> deposits.v1.Deposit
+	49, // 60: deposits.v1.Deposit.pots:type_name -> deposits.v1.Pot
+	4,  // 61: deposits.v1.Deposit.status:type_name -> deposits.v1.DepositStatus
+	32, // 62: deposits.v1.Deposit.suspense_receipts:type_name -> deposits.v1.Receipt
+	50, // 63: deposits.v1.Pot.accounts:type_name -> deposits.v1.Account
+	5,  // 64: deposits.v1.Account.wrapper_type:type_name -> deposits.v1.WrapperType
+	51, // 65: deposits.v1.Account.nominal_amount:type_name -> deposits.v1.Money
+	51, // 66: deposits.v1.Account.total_allocated_amount:type_name -> deposits.v1.Money
+	51, // 67: deposits.v1.Account.pending_relief_amount:type_name -> deposits.v1.Money
+	32, // 68: deposits.v1.Account.receipts:type_name -> deposits.v1.Receipt
+	49, // 69: deposits.v1.DepositAmendment.AddPot.pot:type_name -> deposits.v1.Pot
+	50, // 70: deposits.v1.DepositAmendment.AddAccount.account:type_name -> deposits.v1.Account
+	51, // 71: deposits.v1.DepositAmendment.ChangeNominal.nominal_amount:type_name -> deposits.v1.Money
+	46, // 72: deposits.v1.DepositsService.Create:input_type -> deposits.v1.CreateRequest
+	33, // 73: deposits.v1.DepositsService.Get:input_type -> deposits.v1.GetRequest
+	42, // 74: deposits.v1.DepositsService.ListDeposits:input_type -> deposits.v1.ListDepositsRequest
+	35, // 75: deposits.v1.DepositsService.UpdateDeposit:input_type -> deposits.v1.UpdateDepositRequest
+	38, // 76: deposits.v1.DepositsService.CancelDeposit:input_type -> deposits.v1.CancelDepositRequest
+	40, // 77: deposits.v1.DepositsService.CloseDeposit:input_type -> deposits.v1.CloseDepositRequest
+	15, // 78: deposits.v1.DepositsService.ReceiveReceipt:input_type -> deposits.v1.ReceiveReceiptRequest
+	29, // 79: deposits.v1.DepositsService.ReverseReceipt:input_type -> deposits.v1.ReverseReceiptRequest
+	17, // 80: deposits.v1.DepositsService.ReceiveDepositReceipt:input_type -> deposits.v1.ReceiveDepositReceiptRequest
+	20, // 81: deposits.v1.DepositsService.ReceiveUnallocatedReceipt:input_type -> deposits.v1.ReceiveUnallocatedReceiptRequest
+	22, // 82: deposits.v1.DepositsService.ListUnallocatedReceipts:input_type -> deposits.v1.ListUnallocatedReceiptsRequest
+	24, // 83: deposits.v1.DepositsService.AllocateUnallocatedReceipt:input_type -> deposits.v1.AllocateUnallocatedReceiptRequest
+	26, // 84: deposits.v1.DepositsService.ListSuspenseAllocations:input_type -> deposits.v1.ListSuspenseAllocationsRequest
+	12, // 85: deposits.v1.DepositsService.GetAnnualAllowance:input_type -> deposits.v1.GetAnnualAllowanceRequest
+	6,  // 86: deposits.v1.DepositsService.ExportReliefClaims:input_type -> deposits.v1.ExportReliefClaimsRequest
+	8,  // 87: deposits.v1.DepositsService.MarkReliefClaimBatchPaid:input_type -> deposits.v1.MarkReliefClaimBatchPaidRequest
+	47, // 88: deposits.v1.DepositsService.Create:output_type -> deposits.v1.CreateResponse
+	34, // 89: deposits.v1.DepositsService.Get:output_type -> deposits.v1.GetResponse
+	43, // 90: deposits.v1.DepositsService.ListDeposits:output_type -> deposits.v1.ListDepositsResponse
+	36, // 91: deposits.v1.DepositsService.UpdateDeposit:output_type -> deposits.v1.UpdateDepositResponse
+	39, // 92: deposits.v1.DepositsService.CancelDeposit:output_type -> deposits.v1.CancelDepositResponse
+	41, // 93: deposits.v1.DepositsService.CloseDeposit:output_type -> deposits.v1.CloseDepositResponse
+	16, // 94: deposits.v1.DepositsService.ReceiveReceipt:output_type -> deposits.v1.ReceiveReceiptResponse
+	30, // 95: deposits.v1.DepositsService.ReverseReceipt:output_type -> deposits.v1.ReverseReceiptResponse
+	18, // 96: deposits.v1.DepositsService.ReceiveDepositReceipt:output_type -> deposits.v1.ReceiveDepositReceiptResponse
+	21, // 97: deposits.v1.DepositsService.ReceiveUnallocatedReceipt:output_type -> deposits.v1.ReceiveUnallocatedReceiptResponse
+	23, // 98: deposits.v1.DepositsService.ListUnallocatedReceipts:output_type -> deposits.v1.ListUnallocatedReceiptsResponse
+	25, // 99: deposits.v1.DepositsService.AllocateUnallocatedReceipt:output_type -> deposits.v1.AllocateUnallocatedReceiptResponse
+	27, // 100: deposits.v1.DepositsService.ListSuspenseAllocations:output_type -> deposits.v1.ListSuspenseAllocationsResponse
+	13, // 101: deposits.v1.DepositsService.GetAnnualAllowance:output_type -> deposits.v1.GetAnnualAllowanceResponse
+	7,  // 102: deposits.v1.DepositsService.ExportReliefClaims:output_type -> deposits.v1.ExportReliefClaimsResponse
+	9,  // 103: deposits.v1.DepositsService.MarkReliefClaimBatchPaid:output_type -> deposits.v1.MarkReliefClaimBatchPaidResponse
+	88, // [88:104] is the sub-list for method output_type
+	72, // [72:88] is the sub-list for method input_type
+	72, // [72:72] is the sub-list for extension type_name
+	72, // [72:72] is the sub-list for extension extendee
+	0,  // [0:72] is the sub-list for field type_name
+}
+
+func init() { file_deposits_v1_deposits_proto_init() }
+func file_deposits_v1_deposits_proto_init() {
+	if File_deposits_v1_deposits_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_deposits_v1_deposits_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportReliefClaimsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportReliefClaimsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*MarkReliefClaimBatchPaidRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*MarkReliefClaimBatchPaidResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*ReliefClaimBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*ReliefClaim); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAnnualAllowanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAnnualAllowanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*AnnualAllowance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*ReceiveReceiptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*ReceiveReceiptResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*ReceiveDepositReceiptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*ReceiveDepositReceiptResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*DepositReceipt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*ReceiveUnallocatedReceiptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*ReceiveUnallocatedReceiptResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*ListUnallocatedReceiptsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*ListUnallocatedReceiptsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*AllocateUnallocatedReceiptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*AllocateUnallocatedReceiptResponse); i {
+			case 0:
+				return &v.state
+			case 1:
 				return &v.sizeCache
 			case 2:
 				return &v.unknownFields
@@ -800,7 +4638,91 @@ func file_deposits_v1_deposits_proto_init() {
 				return nil
 			}
 		}
-		file_deposits_v1_deposits_proto_msgTypes[3].Exporter = func(v any, i int) any {
+		file_deposits_v1_deposits_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*ListSuspenseAllocationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*ListSuspenseAllocationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*SuspenseAllocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*ReverseReceiptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*ReverseReceiptResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*Reversal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*Receipt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[27].Exporter = func(v any, i int) any {
 			switch v := v.(*GetRequest); i {
 			case 0:
 				return &v.state
@@ -812,7 +4734,7 @@ func file_deposits_v1_deposits_proto_init() {
 				return nil
 			}
 		}
-		file_deposits_v1_deposits_proto_msgTypes[4].Exporter = func(v any, i int) any {
+		file_deposits_v1_deposits_proto_msgTypes[28].Exporter = func(v any, i int) any {
 			switch v := v.(*GetResponse); i {
 			case 0:
 				return &v.state
@@ -824,7 +4746,139 @@ func file_deposits_v1_deposits_proto_init() {
 				return nil
 			}
 		}
-		file_deposits_v1_deposits_proto_msgTypes[5].Exporter = func(v any, i int) any {
+		file_deposits_v1_deposits_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateDepositRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateDepositResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*DepositAmendment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelDepositRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelDepositResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*CloseDepositRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*CloseDepositResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*ListDepositsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*ListDepositsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*DepositSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*DepositTotal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[40].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateRequest); i {
 			case 0:
 				return &v.state
@@ -836,7 +4890,7 @@ func file_deposits_v1_deposits_proto_init() {
 				return nil
 			}
 		}
-		file_deposits_v1_deposits_proto_msgTypes[6].Exporter = func(v any, i int) any {
+		file_deposits_v1_deposits_proto_msgTypes[41].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateResponse); i {
 			case 0:
 				return &v.state
@@ -848,7 +4902,7 @@ func file_deposits_v1_deposits_proto_init() {
 				return nil
 			}
 		}
-		file_deposits_v1_deposits_proto_msgTypes[7].Exporter = func(v any, i int) any {
+		file_deposits_v1_deposits_proto_msgTypes[42].Exporter = func(v any, i int) any {
 			switch v := v.(*Deposit); i {
 			case 0:
 				return &v.state
@@ -860,7 +4914,7 @@ func file_deposits_v1_deposits_proto_init() {
 				return nil
 			}
 		}
-		file_deposits_v1_deposits_proto_msgTypes[8].Exporter = func(v any, i int) any {
+		file_deposits_v1_deposits_proto_msgTypes[43].Exporter = func(v any, i int) any {
 			switch v := v.(*Pot); i {
 			case 0:
 				return &v.state
@@ -872,7 +4926,7 @@ func file_deposits_v1_deposits_proto_init() {
 				return nil
 			}
 		}
-		file_deposits_v1_deposits_proto_msgTypes[9].Exporter = func(v any, i int) any {
+		file_deposits_v1_deposits_proto_msgTypes[44].Exporter = func(v any, i int) any {
 			switch v := v.(*Account); i {
 			case 0:
 				return &v.state
@@ -884,14 +4938,93 @@ func file_deposits_v1_deposits_proto_init() {
 				return nil
 			}
 		}
+		file_deposits_v1_deposits_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*Money); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*DepositAmendment_AddPot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*DepositAmendment_RenamePot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*DepositAmendment_AddAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*DepositAmendment_ChangeNominal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deposits_v1_deposits_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*DepositAmendment_RemoveAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_deposits_v1_deposits_proto_msgTypes[31].OneofWrappers = []any{
+		(*DepositAmendment_AddPot_)(nil),
+		(*DepositAmendment_RenamePot_)(nil),
+		(*DepositAmendment_AddAccount_)(nil),
+		(*DepositAmendment_ChangeNominal_)(nil),
+		(*DepositAmendment_RemoveAccount_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_deposits_v1_deposits_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   10,
+			NumEnums:      6,
+			NumMessages:   51,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
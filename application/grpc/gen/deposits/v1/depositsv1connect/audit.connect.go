@@ -0,0 +1,143 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: deposits/v1/audit.proto
+
+package depositsv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/iainvm/deposits/application/grpc/gen/deposits/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AuditServiceName is the fully-qualified name of the AuditService service.
+	AuditServiceName = "deposits.v1.AuditService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AuditServiceListAuditEntriesProcedure is the fully-qualified name of the AuditService's
+	// ListAuditEntries RPC.
+	AuditServiceListAuditEntriesProcedure = "/deposits.v1.AuditService/ListAuditEntries"
+	// AuditServiceVerifyAuditLogProcedure is the fully-qualified name of the AuditService's
+	// VerifyAuditLog RPC.
+	AuditServiceVerifyAuditLogProcedure = "/deposits.v1.AuditService/VerifyAuditLog"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	auditServiceServiceDescriptor                = v1.File_deposits_v1_audit_proto.Services().ByName("AuditService")
+	auditServiceListAuditEntriesMethodDescriptor = auditServiceServiceDescriptor.Methods().ByName("ListAuditEntries")
+	auditServiceVerifyAuditLogMethodDescriptor   = auditServiceServiceDescriptor.Methods().ByName("VerifyAuditLog")
+)
+
+// AuditServiceClient is a client for the deposits.v1.AuditService service.
+type AuditServiceClient interface {
+	ListAuditEntries(context.Context, *connect.Request[v1.ListAuditEntriesRequest]) (*connect.Response[v1.ListAuditEntriesResponse], error)
+	VerifyAuditLog(context.Context, *connect.Request[v1.VerifyAuditLogRequest]) (*connect.Response[v1.VerifyAuditLogResponse], error)
+}
+
+// NewAuditServiceClient constructs a client for the deposits.v1.AuditService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAuditServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AuditServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &auditServiceClient{
+		listAuditEntries: connect.NewClient[v1.ListAuditEntriesRequest, v1.ListAuditEntriesResponse](
+			httpClient,
+			baseURL+AuditServiceListAuditEntriesProcedure,
+			connect.WithSchema(auditServiceListAuditEntriesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		verifyAuditLog: connect.NewClient[v1.VerifyAuditLogRequest, v1.VerifyAuditLogResponse](
+			httpClient,
+			baseURL+AuditServiceVerifyAuditLogProcedure,
+			connect.WithSchema(auditServiceVerifyAuditLogMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// auditServiceClient implements AuditServiceClient.
+type auditServiceClient struct {
+	listAuditEntries *connect.Client[v1.ListAuditEntriesRequest, v1.ListAuditEntriesResponse]
+	verifyAuditLog   *connect.Client[v1.VerifyAuditLogRequest, v1.VerifyAuditLogResponse]
+}
+
+// ListAuditEntries calls deposits.v1.AuditService.ListAuditEntries.
+func (c *auditServiceClient) ListAuditEntries(ctx context.Context, req *connect.Request[v1.ListAuditEntriesRequest]) (*connect.Response[v1.ListAuditEntriesResponse], error) {
+	return c.listAuditEntries.CallUnary(ctx, req)
+}
+
+// VerifyAuditLog calls deposits.v1.AuditService.VerifyAuditLog.
+func (c *auditServiceClient) VerifyAuditLog(ctx context.Context, req *connect.Request[v1.VerifyAuditLogRequest]) (*connect.Response[v1.VerifyAuditLogResponse], error) {
+	return c.verifyAuditLog.CallUnary(ctx, req)
+}
+
+// AuditServiceHandler is an implementation of the deposits.v1.AuditService service.
+type AuditServiceHandler interface {
+	ListAuditEntries(context.Context, *connect.Request[v1.ListAuditEntriesRequest]) (*connect.Response[v1.ListAuditEntriesResponse], error)
+	VerifyAuditLog(context.Context, *connect.Request[v1.VerifyAuditLogRequest]) (*connect.Response[v1.VerifyAuditLogResponse], error)
+}
+
+// NewAuditServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAuditServiceHandler(svc AuditServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	auditServiceListAuditEntriesHandler := connect.NewUnaryHandler(
+		AuditServiceListAuditEntriesProcedure,
+		svc.ListAuditEntries,
+		connect.WithSchema(auditServiceListAuditEntriesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	auditServiceVerifyAuditLogHandler := connect.NewUnaryHandler(
+		AuditServiceVerifyAuditLogProcedure,
+		svc.VerifyAuditLog,
+		connect.WithSchema(auditServiceVerifyAuditLogMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/deposits.v1.AuditService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AuditServiceListAuditEntriesProcedure:
+			auditServiceListAuditEntriesHandler.ServeHTTP(w, r)
+		case AuditServiceVerifyAuditLogProcedure:
+			auditServiceVerifyAuditLogHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAuditServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAuditServiceHandler struct{}
+
+func (UnimplementedAuditServiceHandler) ListAuditEntries(context.Context, *connect.Request[v1.ListAuditEntriesRequest]) (*connect.Response[v1.ListAuditEntriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deposits.v1.AuditService.ListAuditEntries is not implemented"))
+}
+
+func (UnimplementedAuditServiceHandler) VerifyAuditLog(context.Context, *connect.Request[v1.VerifyAuditLogRequest]) (*connect.Response[v1.VerifyAuditLogResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deposits.v1.AuditService.VerifyAuditLog is not implemented"))
+}
@@ -37,24 +37,89 @@ const (
 	DepositsServiceCreateProcedure = "/deposits.v1.DepositsService/Create"
 	// DepositsServiceGetProcedure is the fully-qualified name of the DepositsService's Get RPC.
 	DepositsServiceGetProcedure = "/deposits.v1.DepositsService/Get"
+	// DepositsServiceListDepositsProcedure is the fully-qualified name of the DepositsService's
+	// ListDeposits RPC.
+	DepositsServiceListDepositsProcedure = "/deposits.v1.DepositsService/ListDeposits"
+	// DepositsServiceUpdateDepositProcedure is the fully-qualified name of the DepositsService's
+	// UpdateDeposit RPC.
+	DepositsServiceUpdateDepositProcedure = "/deposits.v1.DepositsService/UpdateDeposit"
+	// DepositsServiceCancelDepositProcedure is the fully-qualified name of the DepositsService's
+	// CancelDeposit RPC.
+	DepositsServiceCancelDepositProcedure = "/deposits.v1.DepositsService/CancelDeposit"
+	// DepositsServiceCloseDepositProcedure is the fully-qualified name of the DepositsService's
+	// CloseDeposit RPC.
+	DepositsServiceCloseDepositProcedure = "/deposits.v1.DepositsService/CloseDeposit"
 	// DepositsServiceReceiveReceiptProcedure is the fully-qualified name of the DepositsService's
 	// ReceiveReceipt RPC.
 	DepositsServiceReceiveReceiptProcedure = "/deposits.v1.DepositsService/ReceiveReceipt"
+	// DepositsServiceReverseReceiptProcedure is the fully-qualified name of the DepositsService's
+	// ReverseReceipt RPC.
+	DepositsServiceReverseReceiptProcedure = "/deposits.v1.DepositsService/ReverseReceipt"
+	// DepositsServiceReceiveDepositReceiptProcedure is the fully-qualified name of the
+	// DepositsService's ReceiveDepositReceipt RPC.
+	DepositsServiceReceiveDepositReceiptProcedure = "/deposits.v1.DepositsService/ReceiveDepositReceipt"
+	// DepositsServiceReceiveUnallocatedReceiptProcedure is the fully-qualified name of the
+	// DepositsService's ReceiveUnallocatedReceipt RPC.
+	DepositsServiceReceiveUnallocatedReceiptProcedure = "/deposits.v1.DepositsService/ReceiveUnallocatedReceipt"
+	// DepositsServiceListUnallocatedReceiptsProcedure is the fully-qualified name of the
+	// DepositsService's ListUnallocatedReceipts RPC.
+	DepositsServiceListUnallocatedReceiptsProcedure = "/deposits.v1.DepositsService/ListUnallocatedReceipts"
+	// DepositsServiceAllocateUnallocatedReceiptProcedure is the fully-qualified name of the
+	// DepositsService's AllocateUnallocatedReceipt RPC.
+	DepositsServiceAllocateUnallocatedReceiptProcedure = "/deposits.v1.DepositsService/AllocateUnallocatedReceipt"
+	// DepositsServiceListSuspenseAllocationsProcedure is the fully-qualified name of the
+	// DepositsService's ListSuspenseAllocations RPC.
+	DepositsServiceListSuspenseAllocationsProcedure = "/deposits.v1.DepositsService/ListSuspenseAllocations"
+	// DepositsServiceGetAnnualAllowanceProcedure is the fully-qualified name of the DepositsService's
+	// GetAnnualAllowance RPC.
+	DepositsServiceGetAnnualAllowanceProcedure = "/deposits.v1.DepositsService/GetAnnualAllowance"
+	// DepositsServiceExportReliefClaimsProcedure is the fully-qualified name of the DepositsService's
+	// ExportReliefClaims RPC.
+	DepositsServiceExportReliefClaimsProcedure = "/deposits.v1.DepositsService/ExportReliefClaims"
+	// DepositsServiceMarkReliefClaimBatchPaidProcedure is the fully-qualified name of the
+	// DepositsService's MarkReliefClaimBatchPaid RPC.
+	DepositsServiceMarkReliefClaimBatchPaidProcedure = "/deposits.v1.DepositsService/MarkReliefClaimBatchPaid"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	depositsServiceServiceDescriptor              = v1.File_deposits_v1_deposits_proto.Services().ByName("DepositsService")
-	depositsServiceCreateMethodDescriptor         = depositsServiceServiceDescriptor.Methods().ByName("Create")
-	depositsServiceGetMethodDescriptor            = depositsServiceServiceDescriptor.Methods().ByName("Get")
-	depositsServiceReceiveReceiptMethodDescriptor = depositsServiceServiceDescriptor.Methods().ByName("ReceiveReceipt")
+	depositsServiceServiceDescriptor                          = v1.File_deposits_v1_deposits_proto.Services().ByName("DepositsService")
+	depositsServiceCreateMethodDescriptor                     = depositsServiceServiceDescriptor.Methods().ByName("Create")
+	depositsServiceGetMethodDescriptor                        = depositsServiceServiceDescriptor.Methods().ByName("Get")
+	depositsServiceListDepositsMethodDescriptor               = depositsServiceServiceDescriptor.Methods().ByName("ListDeposits")
+	depositsServiceUpdateDepositMethodDescriptor              = depositsServiceServiceDescriptor.Methods().ByName("UpdateDeposit")
+	depositsServiceCancelDepositMethodDescriptor              = depositsServiceServiceDescriptor.Methods().ByName("CancelDeposit")
+	depositsServiceCloseDepositMethodDescriptor               = depositsServiceServiceDescriptor.Methods().ByName("CloseDeposit")
+	depositsServiceReceiveReceiptMethodDescriptor             = depositsServiceServiceDescriptor.Methods().ByName("ReceiveReceipt")
+	depositsServiceReverseReceiptMethodDescriptor             = depositsServiceServiceDescriptor.Methods().ByName("ReverseReceipt")
+	depositsServiceReceiveDepositReceiptMethodDescriptor      = depositsServiceServiceDescriptor.Methods().ByName("ReceiveDepositReceipt")
+	depositsServiceReceiveUnallocatedReceiptMethodDescriptor  = depositsServiceServiceDescriptor.Methods().ByName("ReceiveUnallocatedReceipt")
+	depositsServiceListUnallocatedReceiptsMethodDescriptor    = depositsServiceServiceDescriptor.Methods().ByName("ListUnallocatedReceipts")
+	depositsServiceAllocateUnallocatedReceiptMethodDescriptor = depositsServiceServiceDescriptor.Methods().ByName("AllocateUnallocatedReceipt")
+	depositsServiceListSuspenseAllocationsMethodDescriptor    = depositsServiceServiceDescriptor.Methods().ByName("ListSuspenseAllocations")
+	depositsServiceGetAnnualAllowanceMethodDescriptor         = depositsServiceServiceDescriptor.Methods().ByName("GetAnnualAllowance")
+	depositsServiceExportReliefClaimsMethodDescriptor         = depositsServiceServiceDescriptor.Methods().ByName("ExportReliefClaims")
+	depositsServiceMarkReliefClaimBatchPaidMethodDescriptor   = depositsServiceServiceDescriptor.Methods().ByName("MarkReliefClaimBatchPaid")
 )
 
 // DepositsServiceClient is a client for the deposits.v1.DepositsService service.
 type DepositsServiceClient interface {
 	Create(context.Context, *connect.Request[v1.CreateRequest]) (*connect.Response[v1.CreateResponse], error)
 	Get(context.Context, *connect.Request[v1.GetRequest]) (*connect.Response[v1.GetResponse], error)
+	ListDeposits(context.Context, *connect.Request[v1.ListDepositsRequest]) (*connect.Response[v1.ListDepositsResponse], error)
+	UpdateDeposit(context.Context, *connect.Request[v1.UpdateDepositRequest]) (*connect.Response[v1.UpdateDepositResponse], error)
+	CancelDeposit(context.Context, *connect.Request[v1.CancelDepositRequest]) (*connect.Response[v1.CancelDepositResponse], error)
+	CloseDeposit(context.Context, *connect.Request[v1.CloseDepositRequest]) (*connect.Response[v1.CloseDepositResponse], error)
 	ReceiveReceipt(context.Context, *connect.Request[v1.ReceiveReceiptRequest]) (*connect.Response[v1.ReceiveReceiptResponse], error)
+	ReverseReceipt(context.Context, *connect.Request[v1.ReverseReceiptRequest]) (*connect.Response[v1.ReverseReceiptResponse], error)
+	ReceiveDepositReceipt(context.Context, *connect.Request[v1.ReceiveDepositReceiptRequest]) (*connect.Response[v1.ReceiveDepositReceiptResponse], error)
+	ReceiveUnallocatedReceipt(context.Context, *connect.Request[v1.ReceiveUnallocatedReceiptRequest]) (*connect.Response[v1.ReceiveUnallocatedReceiptResponse], error)
+	ListUnallocatedReceipts(context.Context, *connect.Request[v1.ListUnallocatedReceiptsRequest]) (*connect.Response[v1.ListUnallocatedReceiptsResponse], error)
+	AllocateUnallocatedReceipt(context.Context, *connect.Request[v1.AllocateUnallocatedReceiptRequest]) (*connect.Response[v1.AllocateUnallocatedReceiptResponse], error)
+	ListSuspenseAllocations(context.Context, *connect.Request[v1.ListSuspenseAllocationsRequest]) (*connect.Response[v1.ListSuspenseAllocationsResponse], error)
+	GetAnnualAllowance(context.Context, *connect.Request[v1.GetAnnualAllowanceRequest]) (*connect.Response[v1.GetAnnualAllowanceResponse], error)
+	ExportReliefClaims(context.Context, *connect.Request[v1.ExportReliefClaimsRequest]) (*connect.Response[v1.ExportReliefClaimsResponse], error)
+	MarkReliefClaimBatchPaid(context.Context, *connect.Request[v1.MarkReliefClaimBatchPaidRequest]) (*connect.Response[v1.MarkReliefClaimBatchPaidResponse], error)
 }
 
 // NewDepositsServiceClient constructs a client for the deposits.v1.DepositsService service. By
@@ -79,20 +144,111 @@ func NewDepositsServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(depositsServiceGetMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		listDeposits: connect.NewClient[v1.ListDepositsRequest, v1.ListDepositsResponse](
+			httpClient,
+			baseURL+DepositsServiceListDepositsProcedure,
+			connect.WithSchema(depositsServiceListDepositsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateDeposit: connect.NewClient[v1.UpdateDepositRequest, v1.UpdateDepositResponse](
+			httpClient,
+			baseURL+DepositsServiceUpdateDepositProcedure,
+			connect.WithSchema(depositsServiceUpdateDepositMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		cancelDeposit: connect.NewClient[v1.CancelDepositRequest, v1.CancelDepositResponse](
+			httpClient,
+			baseURL+DepositsServiceCancelDepositProcedure,
+			connect.WithSchema(depositsServiceCancelDepositMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		closeDeposit: connect.NewClient[v1.CloseDepositRequest, v1.CloseDepositResponse](
+			httpClient,
+			baseURL+DepositsServiceCloseDepositProcedure,
+			connect.WithSchema(depositsServiceCloseDepositMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		receiveReceipt: connect.NewClient[v1.ReceiveReceiptRequest, v1.ReceiveReceiptResponse](
 			httpClient,
 			baseURL+DepositsServiceReceiveReceiptProcedure,
 			connect.WithSchema(depositsServiceReceiveReceiptMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		reverseReceipt: connect.NewClient[v1.ReverseReceiptRequest, v1.ReverseReceiptResponse](
+			httpClient,
+			baseURL+DepositsServiceReverseReceiptProcedure,
+			connect.WithSchema(depositsServiceReverseReceiptMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		receiveDepositReceipt: connect.NewClient[v1.ReceiveDepositReceiptRequest, v1.ReceiveDepositReceiptResponse](
+			httpClient,
+			baseURL+DepositsServiceReceiveDepositReceiptProcedure,
+			connect.WithSchema(depositsServiceReceiveDepositReceiptMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		receiveUnallocatedReceipt: connect.NewClient[v1.ReceiveUnallocatedReceiptRequest, v1.ReceiveUnallocatedReceiptResponse](
+			httpClient,
+			baseURL+DepositsServiceReceiveUnallocatedReceiptProcedure,
+			connect.WithSchema(depositsServiceReceiveUnallocatedReceiptMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listUnallocatedReceipts: connect.NewClient[v1.ListUnallocatedReceiptsRequest, v1.ListUnallocatedReceiptsResponse](
+			httpClient,
+			baseURL+DepositsServiceListUnallocatedReceiptsProcedure,
+			connect.WithSchema(depositsServiceListUnallocatedReceiptsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		allocateUnallocatedReceipt: connect.NewClient[v1.AllocateUnallocatedReceiptRequest, v1.AllocateUnallocatedReceiptResponse](
+			httpClient,
+			baseURL+DepositsServiceAllocateUnallocatedReceiptProcedure,
+			connect.WithSchema(depositsServiceAllocateUnallocatedReceiptMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listSuspenseAllocations: connect.NewClient[v1.ListSuspenseAllocationsRequest, v1.ListSuspenseAllocationsResponse](
+			httpClient,
+			baseURL+DepositsServiceListSuspenseAllocationsProcedure,
+			connect.WithSchema(depositsServiceListSuspenseAllocationsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getAnnualAllowance: connect.NewClient[v1.GetAnnualAllowanceRequest, v1.GetAnnualAllowanceResponse](
+			httpClient,
+			baseURL+DepositsServiceGetAnnualAllowanceProcedure,
+			connect.WithSchema(depositsServiceGetAnnualAllowanceMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		exportReliefClaims: connect.NewClient[v1.ExportReliefClaimsRequest, v1.ExportReliefClaimsResponse](
+			httpClient,
+			baseURL+DepositsServiceExportReliefClaimsProcedure,
+			connect.WithSchema(depositsServiceExportReliefClaimsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		markReliefClaimBatchPaid: connect.NewClient[v1.MarkReliefClaimBatchPaidRequest, v1.MarkReliefClaimBatchPaidResponse](
+			httpClient,
+			baseURL+DepositsServiceMarkReliefClaimBatchPaidProcedure,
+			connect.WithSchema(depositsServiceMarkReliefClaimBatchPaidMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // depositsServiceClient implements DepositsServiceClient.
 type depositsServiceClient struct {
-	create         *connect.Client[v1.CreateRequest, v1.CreateResponse]
-	get            *connect.Client[v1.GetRequest, v1.GetResponse]
-	receiveReceipt *connect.Client[v1.ReceiveReceiptRequest, v1.ReceiveReceiptResponse]
+	create                     *connect.Client[v1.CreateRequest, v1.CreateResponse]
+	get                        *connect.Client[v1.GetRequest, v1.GetResponse]
+	listDeposits               *connect.Client[v1.ListDepositsRequest, v1.ListDepositsResponse]
+	updateDeposit              *connect.Client[v1.UpdateDepositRequest, v1.UpdateDepositResponse]
+	cancelDeposit              *connect.Client[v1.CancelDepositRequest, v1.CancelDepositResponse]
+	closeDeposit               *connect.Client[v1.CloseDepositRequest, v1.CloseDepositResponse]
+	receiveReceipt             *connect.Client[v1.ReceiveReceiptRequest, v1.ReceiveReceiptResponse]
+	reverseReceipt             *connect.Client[v1.ReverseReceiptRequest, v1.ReverseReceiptResponse]
+	receiveDepositReceipt      *connect.Client[v1.ReceiveDepositReceiptRequest, v1.ReceiveDepositReceiptResponse]
+	receiveUnallocatedReceipt  *connect.Client[v1.ReceiveUnallocatedReceiptRequest, v1.ReceiveUnallocatedReceiptResponse]
+	listUnallocatedReceipts    *connect.Client[v1.ListUnallocatedReceiptsRequest, v1.ListUnallocatedReceiptsResponse]
+	allocateUnallocatedReceipt *connect.Client[v1.AllocateUnallocatedReceiptRequest, v1.AllocateUnallocatedReceiptResponse]
+	listSuspenseAllocations    *connect.Client[v1.ListSuspenseAllocationsRequest, v1.ListSuspenseAllocationsResponse]
+	getAnnualAllowance         *connect.Client[v1.GetAnnualAllowanceRequest, v1.GetAnnualAllowanceResponse]
+	exportReliefClaims         *connect.Client[v1.ExportReliefClaimsRequest, v1.ExportReliefClaimsResponse]
+	markReliefClaimBatchPaid   *connect.Client[v1.MarkReliefClaimBatchPaidRequest, v1.MarkReliefClaimBatchPaidResponse]
 }
 
 // Create calls deposits.v1.DepositsService.Create.
@@ -105,16 +261,94 @@ func (c *depositsServiceClient) Get(ctx context.Context, req *connect.Request[v1
 	return c.get.CallUnary(ctx, req)
 }
 
+// ListDeposits calls deposits.v1.DepositsService.ListDeposits.
+func (c *depositsServiceClient) ListDeposits(ctx context.Context, req *connect.Request[v1.ListDepositsRequest]) (*connect.Response[v1.ListDepositsResponse], error) {
+	return c.listDeposits.CallUnary(ctx, req)
+}
+
+// UpdateDeposit calls deposits.v1.DepositsService.UpdateDeposit.
+func (c *depositsServiceClient) UpdateDeposit(ctx context.Context, req *connect.Request[v1.UpdateDepositRequest]) (*connect.Response[v1.UpdateDepositResponse], error) {
+	return c.updateDeposit.CallUnary(ctx, req)
+}
+
+// CancelDeposit calls deposits.v1.DepositsService.CancelDeposit.
+func (c *depositsServiceClient) CancelDeposit(ctx context.Context, req *connect.Request[v1.CancelDepositRequest]) (*connect.Response[v1.CancelDepositResponse], error) {
+	return c.cancelDeposit.CallUnary(ctx, req)
+}
+
+// CloseDeposit calls deposits.v1.DepositsService.CloseDeposit.
+func (c *depositsServiceClient) CloseDeposit(ctx context.Context, req *connect.Request[v1.CloseDepositRequest]) (*connect.Response[v1.CloseDepositResponse], error) {
+	return c.closeDeposit.CallUnary(ctx, req)
+}
+
 // ReceiveReceipt calls deposits.v1.DepositsService.ReceiveReceipt.
 func (c *depositsServiceClient) ReceiveReceipt(ctx context.Context, req *connect.Request[v1.ReceiveReceiptRequest]) (*connect.Response[v1.ReceiveReceiptResponse], error) {
 	return c.receiveReceipt.CallUnary(ctx, req)
 }
 
+// ReverseReceipt calls deposits.v1.DepositsService.ReverseReceipt.
+func (c *depositsServiceClient) ReverseReceipt(ctx context.Context, req *connect.Request[v1.ReverseReceiptRequest]) (*connect.Response[v1.ReverseReceiptResponse], error) {
+	return c.reverseReceipt.CallUnary(ctx, req)
+}
+
+// ReceiveDepositReceipt calls deposits.v1.DepositsService.ReceiveDepositReceipt.
+func (c *depositsServiceClient) ReceiveDepositReceipt(ctx context.Context, req *connect.Request[v1.ReceiveDepositReceiptRequest]) (*connect.Response[v1.ReceiveDepositReceiptResponse], error) {
+	return c.receiveDepositReceipt.CallUnary(ctx, req)
+}
+
+// ReceiveUnallocatedReceipt calls deposits.v1.DepositsService.ReceiveUnallocatedReceipt.
+func (c *depositsServiceClient) ReceiveUnallocatedReceipt(ctx context.Context, req *connect.Request[v1.ReceiveUnallocatedReceiptRequest]) (*connect.Response[v1.ReceiveUnallocatedReceiptResponse], error) {
+	return c.receiveUnallocatedReceipt.CallUnary(ctx, req)
+}
+
+// ListUnallocatedReceipts calls deposits.v1.DepositsService.ListUnallocatedReceipts.
+func (c *depositsServiceClient) ListUnallocatedReceipts(ctx context.Context, req *connect.Request[v1.ListUnallocatedReceiptsRequest]) (*connect.Response[v1.ListUnallocatedReceiptsResponse], error) {
+	return c.listUnallocatedReceipts.CallUnary(ctx, req)
+}
+
+// AllocateUnallocatedReceipt calls deposits.v1.DepositsService.AllocateUnallocatedReceipt.
+func (c *depositsServiceClient) AllocateUnallocatedReceipt(ctx context.Context, req *connect.Request[v1.AllocateUnallocatedReceiptRequest]) (*connect.Response[v1.AllocateUnallocatedReceiptResponse], error) {
+	return c.allocateUnallocatedReceipt.CallUnary(ctx, req)
+}
+
+// ListSuspenseAllocations calls deposits.v1.DepositsService.ListSuspenseAllocations.
+func (c *depositsServiceClient) ListSuspenseAllocations(ctx context.Context, req *connect.Request[v1.ListSuspenseAllocationsRequest]) (*connect.Response[v1.ListSuspenseAllocationsResponse], error) {
+	return c.listSuspenseAllocations.CallUnary(ctx, req)
+}
+
+// GetAnnualAllowance calls deposits.v1.DepositsService.GetAnnualAllowance.
+func (c *depositsServiceClient) GetAnnualAllowance(ctx context.Context, req *connect.Request[v1.GetAnnualAllowanceRequest]) (*connect.Response[v1.GetAnnualAllowanceResponse], error) {
+	return c.getAnnualAllowance.CallUnary(ctx, req)
+}
+
+// ExportReliefClaims calls deposits.v1.DepositsService.ExportReliefClaims.
+func (c *depositsServiceClient) ExportReliefClaims(ctx context.Context, req *connect.Request[v1.ExportReliefClaimsRequest]) (*connect.Response[v1.ExportReliefClaimsResponse], error) {
+	return c.exportReliefClaims.CallUnary(ctx, req)
+}
+
+// MarkReliefClaimBatchPaid calls deposits.v1.DepositsService.MarkReliefClaimBatchPaid.
+func (c *depositsServiceClient) MarkReliefClaimBatchPaid(ctx context.Context, req *connect.Request[v1.MarkReliefClaimBatchPaidRequest]) (*connect.Response[v1.MarkReliefClaimBatchPaidResponse], error) {
+	return c.markReliefClaimBatchPaid.CallUnary(ctx, req)
+}
+
 // DepositsServiceHandler is an implementation of the deposits.v1.DepositsService service.
 type DepositsServiceHandler interface {
 	Create(context.Context, *connect.Request[v1.CreateRequest]) (*connect.Response[v1.CreateResponse], error)
 	Get(context.Context, *connect.Request[v1.GetRequest]) (*connect.Response[v1.GetResponse], error)
+	ListDeposits(context.Context, *connect.Request[v1.ListDepositsRequest]) (*connect.Response[v1.ListDepositsResponse], error)
+	UpdateDeposit(context.Context, *connect.Request[v1.UpdateDepositRequest]) (*connect.Response[v1.UpdateDepositResponse], error)
+	CancelDeposit(context.Context, *connect.Request[v1.CancelDepositRequest]) (*connect.Response[v1.CancelDepositResponse], error)
+	CloseDeposit(context.Context, *connect.Request[v1.CloseDepositRequest]) (*connect.Response[v1.CloseDepositResponse], error)
 	ReceiveReceipt(context.Context, *connect.Request[v1.ReceiveReceiptRequest]) (*connect.Response[v1.ReceiveReceiptResponse], error)
+	ReverseReceipt(context.Context, *connect.Request[v1.ReverseReceiptRequest]) (*connect.Response[v1.ReverseReceiptResponse], error)
+	ReceiveDepositReceipt(context.Context, *connect.Request[v1.ReceiveDepositReceiptRequest]) (*connect.Response[v1.ReceiveDepositReceiptResponse], error)
+	ReceiveUnallocatedReceipt(context.Context, *connect.Request[v1.ReceiveUnallocatedReceiptRequest]) (*connect.Response[v1.ReceiveUnallocatedReceiptResponse], error)
+	ListUnallocatedReceipts(context.Context, *connect.Request[v1.ListUnallocatedReceiptsRequest]) (*connect.Response[v1.ListUnallocatedReceiptsResponse], error)
+	AllocateUnallocatedReceipt(context.Context, *connect.Request[v1.AllocateUnallocatedReceiptRequest]) (*connect.Response[v1.AllocateUnallocatedReceiptResponse], error)
+	ListSuspenseAllocations(context.Context, *connect.Request[v1.ListSuspenseAllocationsRequest]) (*connect.Response[v1.ListSuspenseAllocationsResponse], error)
+	GetAnnualAllowance(context.Context, *connect.Request[v1.GetAnnualAllowanceRequest]) (*connect.Response[v1.GetAnnualAllowanceResponse], error)
+	ExportReliefClaims(context.Context, *connect.Request[v1.ExportReliefClaimsRequest]) (*connect.Response[v1.ExportReliefClaimsResponse], error)
+	MarkReliefClaimBatchPaid(context.Context, *connect.Request[v1.MarkReliefClaimBatchPaidRequest]) (*connect.Response[v1.MarkReliefClaimBatchPaidResponse], error)
 }
 
 // NewDepositsServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -135,20 +369,124 @@ func NewDepositsServiceHandler(svc DepositsServiceHandler, opts ...connect.Handl
 		connect.WithSchema(depositsServiceGetMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	depositsServiceListDepositsHandler := connect.NewUnaryHandler(
+		DepositsServiceListDepositsProcedure,
+		svc.ListDeposits,
+		connect.WithSchema(depositsServiceListDepositsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	depositsServiceUpdateDepositHandler := connect.NewUnaryHandler(
+		DepositsServiceUpdateDepositProcedure,
+		svc.UpdateDeposit,
+		connect.WithSchema(depositsServiceUpdateDepositMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	depositsServiceCancelDepositHandler := connect.NewUnaryHandler(
+		DepositsServiceCancelDepositProcedure,
+		svc.CancelDeposit,
+		connect.WithSchema(depositsServiceCancelDepositMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	depositsServiceCloseDepositHandler := connect.NewUnaryHandler(
+		DepositsServiceCloseDepositProcedure,
+		svc.CloseDeposit,
+		connect.WithSchema(depositsServiceCloseDepositMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	depositsServiceReceiveReceiptHandler := connect.NewUnaryHandler(
 		DepositsServiceReceiveReceiptProcedure,
 		svc.ReceiveReceipt,
 		connect.WithSchema(depositsServiceReceiveReceiptMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	depositsServiceReverseReceiptHandler := connect.NewUnaryHandler(
+		DepositsServiceReverseReceiptProcedure,
+		svc.ReverseReceipt,
+		connect.WithSchema(depositsServiceReverseReceiptMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	depositsServiceReceiveDepositReceiptHandler := connect.NewUnaryHandler(
+		DepositsServiceReceiveDepositReceiptProcedure,
+		svc.ReceiveDepositReceipt,
+		connect.WithSchema(depositsServiceReceiveDepositReceiptMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	depositsServiceReceiveUnallocatedReceiptHandler := connect.NewUnaryHandler(
+		DepositsServiceReceiveUnallocatedReceiptProcedure,
+		svc.ReceiveUnallocatedReceipt,
+		connect.WithSchema(depositsServiceReceiveUnallocatedReceiptMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	depositsServiceListUnallocatedReceiptsHandler := connect.NewUnaryHandler(
+		DepositsServiceListUnallocatedReceiptsProcedure,
+		svc.ListUnallocatedReceipts,
+		connect.WithSchema(depositsServiceListUnallocatedReceiptsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	depositsServiceAllocateUnallocatedReceiptHandler := connect.NewUnaryHandler(
+		DepositsServiceAllocateUnallocatedReceiptProcedure,
+		svc.AllocateUnallocatedReceipt,
+		connect.WithSchema(depositsServiceAllocateUnallocatedReceiptMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	depositsServiceListSuspenseAllocationsHandler := connect.NewUnaryHandler(
+		DepositsServiceListSuspenseAllocationsProcedure,
+		svc.ListSuspenseAllocations,
+		connect.WithSchema(depositsServiceListSuspenseAllocationsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	depositsServiceGetAnnualAllowanceHandler := connect.NewUnaryHandler(
+		DepositsServiceGetAnnualAllowanceProcedure,
+		svc.GetAnnualAllowance,
+		connect.WithSchema(depositsServiceGetAnnualAllowanceMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	depositsServiceExportReliefClaimsHandler := connect.NewUnaryHandler(
+		DepositsServiceExportReliefClaimsProcedure,
+		svc.ExportReliefClaims,
+		connect.WithSchema(depositsServiceExportReliefClaimsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	depositsServiceMarkReliefClaimBatchPaidHandler := connect.NewUnaryHandler(
+		DepositsServiceMarkReliefClaimBatchPaidProcedure,
+		svc.MarkReliefClaimBatchPaid,
+		connect.WithSchema(depositsServiceMarkReliefClaimBatchPaidMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/deposits.v1.DepositsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case DepositsServiceCreateProcedure:
 			depositsServiceCreateHandler.ServeHTTP(w, r)
 		case DepositsServiceGetProcedure:
 			depositsServiceGetHandler.ServeHTTP(w, r)
+		case DepositsServiceListDepositsProcedure:
+			depositsServiceListDepositsHandler.ServeHTTP(w, r)
+		case DepositsServiceUpdateDepositProcedure:
+			depositsServiceUpdateDepositHandler.ServeHTTP(w, r)
+		case DepositsServiceCancelDepositProcedure:
+			depositsServiceCancelDepositHandler.ServeHTTP(w, r)
+		case DepositsServiceCloseDepositProcedure:
+			depositsServiceCloseDepositHandler.ServeHTTP(w, r)
 		case DepositsServiceReceiveReceiptProcedure:
 			depositsServiceReceiveReceiptHandler.ServeHTTP(w, r)
+		case DepositsServiceReverseReceiptProcedure:
+			depositsServiceReverseReceiptHandler.ServeHTTP(w, r)
+		case DepositsServiceReceiveDepositReceiptProcedure:
+			depositsServiceReceiveDepositReceiptHandler.ServeHTTP(w, r)
+		case DepositsServiceReceiveUnallocatedReceiptProcedure:
+			depositsServiceReceiveUnallocatedReceiptHandler.ServeHTTP(w, r)
+		case DepositsServiceListUnallocatedReceiptsProcedure:
+			depositsServiceListUnallocatedReceiptsHandler.ServeHTTP(w, r)
+		case DepositsServiceAllocateUnallocatedReceiptProcedure:
+			depositsServiceAllocateUnallocatedReceiptHandler.ServeHTTP(w, r)
+		case DepositsServiceListSuspenseAllocationsProcedure:
+			depositsServiceListSuspenseAllocationsHandler.ServeHTTP(w, r)
+		case DepositsServiceGetAnnualAllowanceProcedure:
+			depositsServiceGetAnnualAllowanceHandler.ServeHTTP(w, r)
+		case DepositsServiceExportReliefClaimsProcedure:
+			depositsServiceExportReliefClaimsHandler.ServeHTTP(w, r)
+		case DepositsServiceMarkReliefClaimBatchPaidProcedure:
+			depositsServiceMarkReliefClaimBatchPaidHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -166,6 +504,58 @@ func (UnimplementedDepositsServiceHandler) Get(context.Context, *connect.Request
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deposits.v1.DepositsService.Get is not implemented"))
 }
 
+func (UnimplementedDepositsServiceHandler) ListDeposits(context.Context, *connect.Request[v1.ListDepositsRequest]) (*connect.Response[v1.ListDepositsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deposits.v1.DepositsService.ListDeposits is not implemented"))
+}
+
+func (UnimplementedDepositsServiceHandler) UpdateDeposit(context.Context, *connect.Request[v1.UpdateDepositRequest]) (*connect.Response[v1.UpdateDepositResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deposits.v1.DepositsService.UpdateDeposit is not implemented"))
+}
+
+func (UnimplementedDepositsServiceHandler) CancelDeposit(context.Context, *connect.Request[v1.CancelDepositRequest]) (*connect.Response[v1.CancelDepositResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deposits.v1.DepositsService.CancelDeposit is not implemented"))
+}
+
+func (UnimplementedDepositsServiceHandler) CloseDeposit(context.Context, *connect.Request[v1.CloseDepositRequest]) (*connect.Response[v1.CloseDepositResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deposits.v1.DepositsService.CloseDeposit is not implemented"))
+}
+
 func (UnimplementedDepositsServiceHandler) ReceiveReceipt(context.Context, *connect.Request[v1.ReceiveReceiptRequest]) (*connect.Response[v1.ReceiveReceiptResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deposits.v1.DepositsService.ReceiveReceipt is not implemented"))
 }
+
+func (UnimplementedDepositsServiceHandler) ReverseReceipt(context.Context, *connect.Request[v1.ReverseReceiptRequest]) (*connect.Response[v1.ReverseReceiptResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deposits.v1.DepositsService.ReverseReceipt is not implemented"))
+}
+
+func (UnimplementedDepositsServiceHandler) ReceiveDepositReceipt(context.Context, *connect.Request[v1.ReceiveDepositReceiptRequest]) (*connect.Response[v1.ReceiveDepositReceiptResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deposits.v1.DepositsService.ReceiveDepositReceipt is not implemented"))
+}
+
+func (UnimplementedDepositsServiceHandler) ReceiveUnallocatedReceipt(context.Context, *connect.Request[v1.ReceiveUnallocatedReceiptRequest]) (*connect.Response[v1.ReceiveUnallocatedReceiptResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deposits.v1.DepositsService.ReceiveUnallocatedReceipt is not implemented"))
+}
+
+func (UnimplementedDepositsServiceHandler) ListUnallocatedReceipts(context.Context, *connect.Request[v1.ListUnallocatedReceiptsRequest]) (*connect.Response[v1.ListUnallocatedReceiptsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deposits.v1.DepositsService.ListUnallocatedReceipts is not implemented"))
+}
+
+func (UnimplementedDepositsServiceHandler) AllocateUnallocatedReceipt(context.Context, *connect.Request[v1.AllocateUnallocatedReceiptRequest]) (*connect.Response[v1.AllocateUnallocatedReceiptResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deposits.v1.DepositsService.AllocateUnallocatedReceipt is not implemented"))
+}
+
+func (UnimplementedDepositsServiceHandler) ListSuspenseAllocations(context.Context, *connect.Request[v1.ListSuspenseAllocationsRequest]) (*connect.Response[v1.ListSuspenseAllocationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deposits.v1.DepositsService.ListSuspenseAllocations is not implemented"))
+}
+
+func (UnimplementedDepositsServiceHandler) GetAnnualAllowance(context.Context, *connect.Request[v1.GetAnnualAllowanceRequest]) (*connect.Response[v1.GetAnnualAllowanceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deposits.v1.DepositsService.GetAnnualAllowance is not implemented"))
+}
+
+func (UnimplementedDepositsServiceHandler) ExportReliefClaims(context.Context, *connect.Request[v1.ExportReliefClaimsRequest]) (*connect.Response[v1.ExportReliefClaimsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deposits.v1.DepositsService.ExportReliefClaims is not implemented"))
+}
+
+func (UnimplementedDepositsServiceHandler) MarkReliefClaimBatchPaid(context.Context, *connect.Request[v1.MarkReliefClaimBatchPaidRequest]) (*connect.Response[v1.MarkReliefClaimBatchPaidResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deposits.v1.DepositsService.MarkReliefClaimBatchPaid is not implemented"))
+}
@@ -36,17 +36,37 @@ const (
 	// InvestorsServiceOnboardProcedure is the fully-qualified name of the InvestorsService's Onboard
 	// RPC.
 	InvestorsServiceOnboardProcedure = "/deposits.v1.InvestorsService/Onboard"
+	// InvestorsServiceGetInvestorProcedure is the fully-qualified name of the InvestorsService's
+	// GetInvestor RPC.
+	InvestorsServiceGetInvestorProcedure = "/deposits.v1.InvestorsService/GetInvestor"
+	// InvestorsServiceUpdateInvestorProcedure is the fully-qualified name of the InvestorsService's
+	// UpdateInvestor RPC.
+	InvestorsServiceUpdateInvestorProcedure = "/deposits.v1.InvestorsService/UpdateInvestor"
+	// InvestorsServiceListInvestorsProcedure is the fully-qualified name of the InvestorsService's
+	// ListInvestors RPC.
+	InvestorsServiceListInvestorsProcedure = "/deposits.v1.InvestorsService/ListInvestors"
+	// InvestorsServiceDeleteInvestorProcedure is the fully-qualified name of the InvestorsService's
+	// DeleteInvestor RPC.
+	InvestorsServiceDeleteInvestorProcedure = "/deposits.v1.InvestorsService/DeleteInvestor"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	investorsServiceServiceDescriptor       = v1.File_deposits_v1_investors_proto.Services().ByName("InvestorsService")
-	investorsServiceOnboardMethodDescriptor = investorsServiceServiceDescriptor.Methods().ByName("Onboard")
+	investorsServiceServiceDescriptor              = v1.File_deposits_v1_investors_proto.Services().ByName("InvestorsService")
+	investorsServiceOnboardMethodDescriptor        = investorsServiceServiceDescriptor.Methods().ByName("Onboard")
+	investorsServiceGetInvestorMethodDescriptor    = investorsServiceServiceDescriptor.Methods().ByName("GetInvestor")
+	investorsServiceUpdateInvestorMethodDescriptor = investorsServiceServiceDescriptor.Methods().ByName("UpdateInvestor")
+	investorsServiceListInvestorsMethodDescriptor  = investorsServiceServiceDescriptor.Methods().ByName("ListInvestors")
+	investorsServiceDeleteInvestorMethodDescriptor = investorsServiceServiceDescriptor.Methods().ByName("DeleteInvestor")
 )
 
 // InvestorsServiceClient is a client for the deposits.v1.InvestorsService service.
 type InvestorsServiceClient interface {
 	Onboard(context.Context, *connect.Request[v1.OnboardRequest]) (*connect.Response[v1.OnboardResponse], error)
+	GetInvestor(context.Context, *connect.Request[v1.GetInvestorRequest]) (*connect.Response[v1.GetInvestorResponse], error)
+	UpdateInvestor(context.Context, *connect.Request[v1.UpdateInvestorRequest]) (*connect.Response[v1.UpdateInvestorResponse], error)
+	ListInvestors(context.Context, *connect.Request[v1.ListInvestorsRequest]) (*connect.Response[v1.ListInvestorsResponse], error)
+	DeleteInvestor(context.Context, *connect.Request[v1.DeleteInvestorRequest]) (*connect.Response[v1.DeleteInvestorResponse], error)
 }
 
 // NewInvestorsServiceClient constructs a client for the deposits.v1.InvestorsService service. By
@@ -65,12 +85,40 @@ func NewInvestorsServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(investorsServiceOnboardMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getInvestor: connect.NewClient[v1.GetInvestorRequest, v1.GetInvestorResponse](
+			httpClient,
+			baseURL+InvestorsServiceGetInvestorProcedure,
+			connect.WithSchema(investorsServiceGetInvestorMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateInvestor: connect.NewClient[v1.UpdateInvestorRequest, v1.UpdateInvestorResponse](
+			httpClient,
+			baseURL+InvestorsServiceUpdateInvestorProcedure,
+			connect.WithSchema(investorsServiceUpdateInvestorMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listInvestors: connect.NewClient[v1.ListInvestorsRequest, v1.ListInvestorsResponse](
+			httpClient,
+			baseURL+InvestorsServiceListInvestorsProcedure,
+			connect.WithSchema(investorsServiceListInvestorsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteInvestor: connect.NewClient[v1.DeleteInvestorRequest, v1.DeleteInvestorResponse](
+			httpClient,
+			baseURL+InvestorsServiceDeleteInvestorProcedure,
+			connect.WithSchema(investorsServiceDeleteInvestorMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // investorsServiceClient implements InvestorsServiceClient.
 type investorsServiceClient struct {
-	onboard *connect.Client[v1.OnboardRequest, v1.OnboardResponse]
+	onboard        *connect.Client[v1.OnboardRequest, v1.OnboardResponse]
+	getInvestor    *connect.Client[v1.GetInvestorRequest, v1.GetInvestorResponse]
+	updateInvestor *connect.Client[v1.UpdateInvestorRequest, v1.UpdateInvestorResponse]
+	listInvestors  *connect.Client[v1.ListInvestorsRequest, v1.ListInvestorsResponse]
+	deleteInvestor *connect.Client[v1.DeleteInvestorRequest, v1.DeleteInvestorResponse]
 }
 
 // Onboard calls deposits.v1.InvestorsService.Onboard.
@@ -78,9 +126,33 @@ func (c *investorsServiceClient) Onboard(ctx context.Context, req *connect.Reque
 	return c.onboard.CallUnary(ctx, req)
 }
 
+// GetInvestor calls deposits.v1.InvestorsService.GetInvestor.
+func (c *investorsServiceClient) GetInvestor(ctx context.Context, req *connect.Request[v1.GetInvestorRequest]) (*connect.Response[v1.GetInvestorResponse], error) {
+	return c.getInvestor.CallUnary(ctx, req)
+}
+
+// UpdateInvestor calls deposits.v1.InvestorsService.UpdateInvestor.
+func (c *investorsServiceClient) UpdateInvestor(ctx context.Context, req *connect.Request[v1.UpdateInvestorRequest]) (*connect.Response[v1.UpdateInvestorResponse], error) {
+	return c.updateInvestor.CallUnary(ctx, req)
+}
+
+// ListInvestors calls deposits.v1.InvestorsService.ListInvestors.
+func (c *investorsServiceClient) ListInvestors(ctx context.Context, req *connect.Request[v1.ListInvestorsRequest]) (*connect.Response[v1.ListInvestorsResponse], error) {
+	return c.listInvestors.CallUnary(ctx, req)
+}
+
+// DeleteInvestor calls deposits.v1.InvestorsService.DeleteInvestor.
+func (c *investorsServiceClient) DeleteInvestor(ctx context.Context, req *connect.Request[v1.DeleteInvestorRequest]) (*connect.Response[v1.DeleteInvestorResponse], error) {
+	return c.deleteInvestor.CallUnary(ctx, req)
+}
+
 // InvestorsServiceHandler is an implementation of the deposits.v1.InvestorsService service.
 type InvestorsServiceHandler interface {
 	Onboard(context.Context, *connect.Request[v1.OnboardRequest]) (*connect.Response[v1.OnboardResponse], error)
+	GetInvestor(context.Context, *connect.Request[v1.GetInvestorRequest]) (*connect.Response[v1.GetInvestorResponse], error)
+	UpdateInvestor(context.Context, *connect.Request[v1.UpdateInvestorRequest]) (*connect.Response[v1.UpdateInvestorResponse], error)
+	ListInvestors(context.Context, *connect.Request[v1.ListInvestorsRequest]) (*connect.Response[v1.ListInvestorsResponse], error)
+	DeleteInvestor(context.Context, *connect.Request[v1.DeleteInvestorRequest]) (*connect.Response[v1.DeleteInvestorResponse], error)
 }
 
 // NewInvestorsServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -95,10 +167,42 @@ func NewInvestorsServiceHandler(svc InvestorsServiceHandler, opts ...connect.Han
 		connect.WithSchema(investorsServiceOnboardMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	investorsServiceGetInvestorHandler := connect.NewUnaryHandler(
+		InvestorsServiceGetInvestorProcedure,
+		svc.GetInvestor,
+		connect.WithSchema(investorsServiceGetInvestorMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	investorsServiceUpdateInvestorHandler := connect.NewUnaryHandler(
+		InvestorsServiceUpdateInvestorProcedure,
+		svc.UpdateInvestor,
+		connect.WithSchema(investorsServiceUpdateInvestorMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	investorsServiceListInvestorsHandler := connect.NewUnaryHandler(
+		InvestorsServiceListInvestorsProcedure,
+		svc.ListInvestors,
+		connect.WithSchema(investorsServiceListInvestorsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	investorsServiceDeleteInvestorHandler := connect.NewUnaryHandler(
+		InvestorsServiceDeleteInvestorProcedure,
+		svc.DeleteInvestor,
+		connect.WithSchema(investorsServiceDeleteInvestorMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/deposits.v1.InvestorsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case InvestorsServiceOnboardProcedure:
 			investorsServiceOnboardHandler.ServeHTTP(w, r)
+		case InvestorsServiceGetInvestorProcedure:
+			investorsServiceGetInvestorHandler.ServeHTTP(w, r)
+		case InvestorsServiceUpdateInvestorProcedure:
+			investorsServiceUpdateInvestorHandler.ServeHTTP(w, r)
+		case InvestorsServiceListInvestorsProcedure:
+			investorsServiceListInvestorsHandler.ServeHTTP(w, r)
+		case InvestorsServiceDeleteInvestorProcedure:
+			investorsServiceDeleteInvestorHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -111,3 +215,19 @@ type UnimplementedInvestorsServiceHandler struct{}
 func (UnimplementedInvestorsServiceHandler) Onboard(context.Context, *connect.Request[v1.OnboardRequest]) (*connect.Response[v1.OnboardResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deposits.v1.InvestorsService.Onboard is not implemented"))
 }
+
+func (UnimplementedInvestorsServiceHandler) GetInvestor(context.Context, *connect.Request[v1.GetInvestorRequest]) (*connect.Response[v1.GetInvestorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deposits.v1.InvestorsService.GetInvestor is not implemented"))
+}
+
+func (UnimplementedInvestorsServiceHandler) UpdateInvestor(context.Context, *connect.Request[v1.UpdateInvestorRequest]) (*connect.Response[v1.UpdateInvestorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deposits.v1.InvestorsService.UpdateInvestor is not implemented"))
+}
+
+func (UnimplementedInvestorsServiceHandler) ListInvestors(context.Context, *connect.Request[v1.ListInvestorsRequest]) (*connect.Response[v1.ListInvestorsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deposits.v1.InvestorsService.ListInvestors is not implemented"))
+}
+
+func (UnimplementedInvestorsServiceHandler) DeleteInvestor(context.Context, *connect.Request[v1.DeleteInvestorRequest]) (*connect.Response[v1.DeleteInvestorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deposits.v1.InvestorsService.DeleteInvestor is not implemented"))
+}
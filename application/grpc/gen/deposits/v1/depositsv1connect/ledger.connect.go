@@ -0,0 +1,113 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: deposits/v1/ledger.proto
+
+package depositsv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/iainvm/deposits/application/grpc/gen/deposits/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// LedgerServiceName is the fully-qualified name of the LedgerService service.
+	LedgerServiceName = "deposits.v1.LedgerService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// LedgerServiceGetTrialBalanceProcedure is the fully-qualified name of the LedgerService's
+	// GetTrialBalance RPC.
+	LedgerServiceGetTrialBalanceProcedure = "/deposits.v1.LedgerService/GetTrialBalance"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	ledgerServiceServiceDescriptor               = v1.File_deposits_v1_ledger_proto.Services().ByName("LedgerService")
+	ledgerServiceGetTrialBalanceMethodDescriptor = ledgerServiceServiceDescriptor.Methods().ByName("GetTrialBalance")
+)
+
+// LedgerServiceClient is a client for the deposits.v1.LedgerService service.
+type LedgerServiceClient interface {
+	GetTrialBalance(context.Context, *connect.Request[v1.GetTrialBalanceRequest]) (*connect.Response[v1.GetTrialBalanceResponse], error)
+}
+
+// NewLedgerServiceClient constructs a client for the deposits.v1.LedgerService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewLedgerServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) LedgerServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &ledgerServiceClient{
+		getTrialBalance: connect.NewClient[v1.GetTrialBalanceRequest, v1.GetTrialBalanceResponse](
+			httpClient,
+			baseURL+LedgerServiceGetTrialBalanceProcedure,
+			connect.WithSchema(ledgerServiceGetTrialBalanceMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// ledgerServiceClient implements LedgerServiceClient.
+type ledgerServiceClient struct {
+	getTrialBalance *connect.Client[v1.GetTrialBalanceRequest, v1.GetTrialBalanceResponse]
+}
+
+// GetTrialBalance calls deposits.v1.LedgerService.GetTrialBalance.
+func (c *ledgerServiceClient) GetTrialBalance(ctx context.Context, req *connect.Request[v1.GetTrialBalanceRequest]) (*connect.Response[v1.GetTrialBalanceResponse], error) {
+	return c.getTrialBalance.CallUnary(ctx, req)
+}
+
+// LedgerServiceHandler is an implementation of the deposits.v1.LedgerService service.
+type LedgerServiceHandler interface {
+	GetTrialBalance(context.Context, *connect.Request[v1.GetTrialBalanceRequest]) (*connect.Response[v1.GetTrialBalanceResponse], error)
+}
+
+// NewLedgerServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewLedgerServiceHandler(svc LedgerServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	ledgerServiceGetTrialBalanceHandler := connect.NewUnaryHandler(
+		LedgerServiceGetTrialBalanceProcedure,
+		svc.GetTrialBalance,
+		connect.WithSchema(ledgerServiceGetTrialBalanceMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/deposits.v1.LedgerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case LedgerServiceGetTrialBalanceProcedure:
+			ledgerServiceGetTrialBalanceHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedLedgerServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedLedgerServiceHandler struct{}
+
+func (UnimplementedLedgerServiceHandler) GetTrialBalance(context.Context, *connect.Request[v1.GetTrialBalanceRequest]) (*connect.Response[v1.GetTrialBalanceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deposits.v1.LedgerService.GetTrialBalance is not implemented"))
+}
@@ -0,0 +1,233 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: deposits/v1/webhooks.proto
+
+package depositsv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/iainvm/deposits/application/grpc/gen/deposits/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// WebhooksServiceName is the fully-qualified name of the WebhooksService service.
+	WebhooksServiceName = "deposits.v1.WebhooksService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// WebhooksServiceCreateWebhookSubscriptionProcedure is the fully-qualified name of the
+	// WebhooksService's CreateWebhookSubscription RPC.
+	WebhooksServiceCreateWebhookSubscriptionProcedure = "/deposits.v1.WebhooksService/CreateWebhookSubscription"
+	// WebhooksServiceListWebhookSubscriptionsProcedure is the fully-qualified name of the
+	// WebhooksService's ListWebhookSubscriptions RPC.
+	WebhooksServiceListWebhookSubscriptionsProcedure = "/deposits.v1.WebhooksService/ListWebhookSubscriptions"
+	// WebhooksServiceDeleteWebhookSubscriptionProcedure is the fully-qualified name of the
+	// WebhooksService's DeleteWebhookSubscription RPC.
+	WebhooksServiceDeleteWebhookSubscriptionProcedure = "/deposits.v1.WebhooksService/DeleteWebhookSubscription"
+	// WebhooksServiceListWebhookDeadLettersProcedure is the fully-qualified name of the
+	// WebhooksService's ListWebhookDeadLetters RPC.
+	WebhooksServiceListWebhookDeadLettersProcedure = "/deposits.v1.WebhooksService/ListWebhookDeadLetters"
+	// WebhooksServiceRedeliverWebhookProcedure is the fully-qualified name of the WebhooksService's
+	// RedeliverWebhook RPC.
+	WebhooksServiceRedeliverWebhookProcedure = "/deposits.v1.WebhooksService/RedeliverWebhook"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	webhooksServiceServiceDescriptor                         = v1.File_deposits_v1_webhooks_proto.Services().ByName("WebhooksService")
+	webhooksServiceCreateWebhookSubscriptionMethodDescriptor = webhooksServiceServiceDescriptor.Methods().ByName("CreateWebhookSubscription")
+	webhooksServiceListWebhookSubscriptionsMethodDescriptor  = webhooksServiceServiceDescriptor.Methods().ByName("ListWebhookSubscriptions")
+	webhooksServiceDeleteWebhookSubscriptionMethodDescriptor = webhooksServiceServiceDescriptor.Methods().ByName("DeleteWebhookSubscription")
+	webhooksServiceListWebhookDeadLettersMethodDescriptor    = webhooksServiceServiceDescriptor.Methods().ByName("ListWebhookDeadLetters")
+	webhooksServiceRedeliverWebhookMethodDescriptor          = webhooksServiceServiceDescriptor.Methods().ByName("RedeliverWebhook")
+)
+
+// WebhooksServiceClient is a client for the deposits.v1.WebhooksService service.
+type WebhooksServiceClient interface {
+	CreateWebhookSubscription(context.Context, *connect.Request[v1.CreateWebhookSubscriptionRequest]) (*connect.Response[v1.CreateWebhookSubscriptionResponse], error)
+	ListWebhookSubscriptions(context.Context, *connect.Request[v1.ListWebhookSubscriptionsRequest]) (*connect.Response[v1.ListWebhookSubscriptionsResponse], error)
+	DeleteWebhookSubscription(context.Context, *connect.Request[v1.DeleteWebhookSubscriptionRequest]) (*connect.Response[v1.DeleteWebhookSubscriptionResponse], error)
+	ListWebhookDeadLetters(context.Context, *connect.Request[v1.ListWebhookDeadLettersRequest]) (*connect.Response[v1.ListWebhookDeadLettersResponse], error)
+	RedeliverWebhook(context.Context, *connect.Request[v1.RedeliverWebhookRequest]) (*connect.Response[v1.RedeliverWebhookResponse], error)
+}
+
+// NewWebhooksServiceClient constructs a client for the deposits.v1.WebhooksService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewWebhooksServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) WebhooksServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &webhooksServiceClient{
+		createWebhookSubscription: connect.NewClient[v1.CreateWebhookSubscriptionRequest, v1.CreateWebhookSubscriptionResponse](
+			httpClient,
+			baseURL+WebhooksServiceCreateWebhookSubscriptionProcedure,
+			connect.WithSchema(webhooksServiceCreateWebhookSubscriptionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhookSubscriptions: connect.NewClient[v1.ListWebhookSubscriptionsRequest, v1.ListWebhookSubscriptionsResponse](
+			httpClient,
+			baseURL+WebhooksServiceListWebhookSubscriptionsProcedure,
+			connect.WithSchema(webhooksServiceListWebhookSubscriptionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteWebhookSubscription: connect.NewClient[v1.DeleteWebhookSubscriptionRequest, v1.DeleteWebhookSubscriptionResponse](
+			httpClient,
+			baseURL+WebhooksServiceDeleteWebhookSubscriptionProcedure,
+			connect.WithSchema(webhooksServiceDeleteWebhookSubscriptionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhookDeadLetters: connect.NewClient[v1.ListWebhookDeadLettersRequest, v1.ListWebhookDeadLettersResponse](
+			httpClient,
+			baseURL+WebhooksServiceListWebhookDeadLettersProcedure,
+			connect.WithSchema(webhooksServiceListWebhookDeadLettersMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		redeliverWebhook: connect.NewClient[v1.RedeliverWebhookRequest, v1.RedeliverWebhookResponse](
+			httpClient,
+			baseURL+WebhooksServiceRedeliverWebhookProcedure,
+			connect.WithSchema(webhooksServiceRedeliverWebhookMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// webhooksServiceClient implements WebhooksServiceClient.
+type webhooksServiceClient struct {
+	createWebhookSubscription *connect.Client[v1.CreateWebhookSubscriptionRequest, v1.CreateWebhookSubscriptionResponse]
+	listWebhookSubscriptions  *connect.Client[v1.ListWebhookSubscriptionsRequest, v1.ListWebhookSubscriptionsResponse]
+	deleteWebhookSubscription *connect.Client[v1.DeleteWebhookSubscriptionRequest, v1.DeleteWebhookSubscriptionResponse]
+	listWebhookDeadLetters    *connect.Client[v1.ListWebhookDeadLettersRequest, v1.ListWebhookDeadLettersResponse]
+	redeliverWebhook          *connect.Client[v1.RedeliverWebhookRequest, v1.RedeliverWebhookResponse]
+}
+
+// CreateWebhookSubscription calls deposits.v1.WebhooksService.CreateWebhookSubscription.
+func (c *webhooksServiceClient) CreateWebhookSubscription(ctx context.Context, req *connect.Request[v1.CreateWebhookSubscriptionRequest]) (*connect.Response[v1.CreateWebhookSubscriptionResponse], error) {
+	return c.createWebhookSubscription.CallUnary(ctx, req)
+}
+
+// ListWebhookSubscriptions calls deposits.v1.WebhooksService.ListWebhookSubscriptions.
+func (c *webhooksServiceClient) ListWebhookSubscriptions(ctx context.Context, req *connect.Request[v1.ListWebhookSubscriptionsRequest]) (*connect.Response[v1.ListWebhookSubscriptionsResponse], error) {
+	return c.listWebhookSubscriptions.CallUnary(ctx, req)
+}
+
+// DeleteWebhookSubscription calls deposits.v1.WebhooksService.DeleteWebhookSubscription.
+func (c *webhooksServiceClient) DeleteWebhookSubscription(ctx context.Context, req *connect.Request[v1.DeleteWebhookSubscriptionRequest]) (*connect.Response[v1.DeleteWebhookSubscriptionResponse], error) {
+	return c.deleteWebhookSubscription.CallUnary(ctx, req)
+}
+
+// ListWebhookDeadLetters calls deposits.v1.WebhooksService.ListWebhookDeadLetters.
+func (c *webhooksServiceClient) ListWebhookDeadLetters(ctx context.Context, req *connect.Request[v1.ListWebhookDeadLettersRequest]) (*connect.Response[v1.ListWebhookDeadLettersResponse], error) {
+	return c.listWebhookDeadLetters.CallUnary(ctx, req)
+}
+
+// RedeliverWebhook calls deposits.v1.WebhooksService.RedeliverWebhook.
+func (c *webhooksServiceClient) RedeliverWebhook(ctx context.Context, req *connect.Request[v1.RedeliverWebhookRequest]) (*connect.Response[v1.RedeliverWebhookResponse], error) {
+	return c.redeliverWebhook.CallUnary(ctx, req)
+}
+
+// WebhooksServiceHandler is an implementation of the deposits.v1.WebhooksService service.
+type WebhooksServiceHandler interface {
+	CreateWebhookSubscription(context.Context, *connect.Request[v1.CreateWebhookSubscriptionRequest]) (*connect.Response[v1.CreateWebhookSubscriptionResponse], error)
+	ListWebhookSubscriptions(context.Context, *connect.Request[v1.ListWebhookSubscriptionsRequest]) (*connect.Response[v1.ListWebhookSubscriptionsResponse], error)
+	DeleteWebhookSubscription(context.Context, *connect.Request[v1.DeleteWebhookSubscriptionRequest]) (*connect.Response[v1.DeleteWebhookSubscriptionResponse], error)
+	ListWebhookDeadLetters(context.Context, *connect.Request[v1.ListWebhookDeadLettersRequest]) (*connect.Response[v1.ListWebhookDeadLettersResponse], error)
+	RedeliverWebhook(context.Context, *connect.Request[v1.RedeliverWebhookRequest]) (*connect.Response[v1.RedeliverWebhookResponse], error)
+}
+
+// NewWebhooksServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewWebhooksServiceHandler(svc WebhooksServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	webhooksServiceCreateWebhookSubscriptionHandler := connect.NewUnaryHandler(
+		WebhooksServiceCreateWebhookSubscriptionProcedure,
+		svc.CreateWebhookSubscription,
+		connect.WithSchema(webhooksServiceCreateWebhookSubscriptionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhooksServiceListWebhookSubscriptionsHandler := connect.NewUnaryHandler(
+		WebhooksServiceListWebhookSubscriptionsProcedure,
+		svc.ListWebhookSubscriptions,
+		connect.WithSchema(webhooksServiceListWebhookSubscriptionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhooksServiceDeleteWebhookSubscriptionHandler := connect.NewUnaryHandler(
+		WebhooksServiceDeleteWebhookSubscriptionProcedure,
+		svc.DeleteWebhookSubscription,
+		connect.WithSchema(webhooksServiceDeleteWebhookSubscriptionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhooksServiceListWebhookDeadLettersHandler := connect.NewUnaryHandler(
+		WebhooksServiceListWebhookDeadLettersProcedure,
+		svc.ListWebhookDeadLetters,
+		connect.WithSchema(webhooksServiceListWebhookDeadLettersMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhooksServiceRedeliverWebhookHandler := connect.NewUnaryHandler(
+		WebhooksServiceRedeliverWebhookProcedure,
+		svc.RedeliverWebhook,
+		connect.WithSchema(webhooksServiceRedeliverWebhookMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/deposits.v1.WebhooksService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case WebhooksServiceCreateWebhookSubscriptionProcedure:
+			webhooksServiceCreateWebhookSubscriptionHandler.ServeHTTP(w, r)
+		case WebhooksServiceListWebhookSubscriptionsProcedure:
+			webhooksServiceListWebhookSubscriptionsHandler.ServeHTTP(w, r)
+		case WebhooksServiceDeleteWebhookSubscriptionProcedure:
+			webhooksServiceDeleteWebhookSubscriptionHandler.ServeHTTP(w, r)
+		case WebhooksServiceListWebhookDeadLettersProcedure:
+			webhooksServiceListWebhookDeadLettersHandler.ServeHTTP(w, r)
+		case WebhooksServiceRedeliverWebhookProcedure:
+			webhooksServiceRedeliverWebhookHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedWebhooksServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedWebhooksServiceHandler struct{}
+
+func (UnimplementedWebhooksServiceHandler) CreateWebhookSubscription(context.Context, *connect.Request[v1.CreateWebhookSubscriptionRequest]) (*connect.Response[v1.CreateWebhookSubscriptionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deposits.v1.WebhooksService.CreateWebhookSubscription is not implemented"))
+}
+
+func (UnimplementedWebhooksServiceHandler) ListWebhookSubscriptions(context.Context, *connect.Request[v1.ListWebhookSubscriptionsRequest]) (*connect.Response[v1.ListWebhookSubscriptionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deposits.v1.WebhooksService.ListWebhookSubscriptions is not implemented"))
+}
+
+func (UnimplementedWebhooksServiceHandler) DeleteWebhookSubscription(context.Context, *connect.Request[v1.DeleteWebhookSubscriptionRequest]) (*connect.Response[v1.DeleteWebhookSubscriptionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deposits.v1.WebhooksService.DeleteWebhookSubscription is not implemented"))
+}
+
+func (UnimplementedWebhooksServiceHandler) ListWebhookDeadLetters(context.Context, *connect.Request[v1.ListWebhookDeadLettersRequest]) (*connect.Response[v1.ListWebhookDeadLettersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deposits.v1.WebhooksService.ListWebhookDeadLetters is not implemented"))
+}
+
+func (UnimplementedWebhooksServiceHandler) RedeliverWebhook(context.Context, *connect.Request[v1.RedeliverWebhookRequest]) (*connect.Response[v1.RedeliverWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("deposits.v1.WebhooksService.RedeliverWebhook is not implemented"))
+}
@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	depositsv1 "github.com/iainvm/deposits/application/grpc/gen/deposits/v1"
+	"github.com/iainvm/deposits/internal/audit"
+)
+
+// Auditor records calls to the RPCs that change deposits, receipts and investors in the audit log
+type Auditor interface {
+	Record(ctx context.Context, entry *audit.Entry) error
+}
+
+type AuditService interface {
+	Auditor
+	ListEntries(ctx context.Context, entityId string, limit int) ([]*audit.Entry, error)
+	Verify(ctx context.Context) (int64, error)
+}
+
+type AuditHandler struct {
+	log          *slog.Logger
+	auditService AuditService
+}
+
+func NewAuditHandler(log *slog.Logger, service AuditService) *AuditHandler {
+	return &AuditHandler{
+		log:          log,
+		auditService: service,
+	}
+}
+
+func (h *AuditHandler) ListAuditEntries(ctx context.Context, req *connect.Request[depositsv1.ListAuditEntriesRequest]) (*connect.Response[depositsv1.ListAuditEntriesResponse], error) {
+	h.log.With("header", loggedHeader(req.Header())).With("request", req.Msg).Info("List Audit Entries Called")
+
+	entries, err := h.auditService.ListEntries(ctx, req.Msg.EntityId, int(req.Msg.Limit))
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	// Create response
+	response := &depositsv1.ListAuditEntriesResponse{
+		Entries: []*depositsv1.AuditEntry{},
+	}
+	for _, entry := range entries {
+		response.Entries = append(response.Entries, createResponseAuditEntry(*entry))
+	}
+	res := connect.NewResponse(response)
+	res.Header().Set("Audit-Version", "v1")
+	return res, nil
+}
+
+func (h *AuditHandler) VerifyAuditLog(ctx context.Context, req *connect.Request[depositsv1.VerifyAuditLogRequest]) (*connect.Response[depositsv1.VerifyAuditLogResponse], error) {
+	h.log.With("header", loggedHeader(req.Header())).With("request", req.Msg).Info("Verify Audit Log Called")
+
+	verified, err := h.auditService.Verify(ctx)
+
+	// A broken chain is the answer, not a failure
+	response := &depositsv1.VerifyAuditLogResponse{
+		Valid:           true,
+		EntriesVerified: verified,
+	}
+	var chainErr *audit.ChainError
+	switch {
+	case errors.As(err, &chainErr):
+		h.log.With("error", err).Error("audit log chain is broken")
+		response.Valid = false
+		response.BrokenSequence = chainErr.Sequence
+		response.Reason = chainErr.Err.Error()
+	case err != nil:
+		return nil, connectError(err)
+	}
+
+	// Create response
+	res := connect.NewResponse(response)
+	res.Header().Set("Audit-Version", "v1")
+	return res, nil
+}
+
+// recordAudit records a successful call to a mutating RPC, made by the authenticated actor, with snapshots of the
+// entities it changed before and after, before is empty for entities the call created
+//
+// The change has already been made, so the call doesn't fail if it can't be recorded, as a client retrying it would
+// make the change again. Everything that would have been recorded is logged instead, so the change can still be traced
+func recordAudit(ctx context.Context, log *slog.Logger, auditor Auditor, header http.Header, rpc string, entityIds []string, before []proto.Message, after []proto.Message) {
+	log = log.
+		With("request_id", header.Get(HeaderRequestId)).
+		With("actor", actorFrom(ctx)).
+		With("rpc", rpc).
+		With("entity_ids", entityIds)
+
+	beforeSnapshot, err := auditSnapshot(before)
+	if err != nil {
+		auditNotRecorded(log, err)
+		return
+	}
+	afterSnapshot, err := auditSnapshot(after)
+	if err != nil {
+		auditNotRecorded(log, err)
+		return
+	}
+	log = log.With("before", string(beforeSnapshot)).With("after", string(afterSnapshot))
+
+	entry, err := audit.NewEntry(
+		header.Get(HeaderRequestId),
+		actorFrom(ctx),
+		rpc,
+		entityIds,
+		beforeSnapshot,
+		afterSnapshot,
+		time.Now(),
+	)
+	if err != nil {
+		auditNotRecorded(log, err)
+		return
+	}
+
+	err = auditor.Record(ctx, entry)
+	if err != nil {
+		auditNotRecorded(log, err)
+	}
+}
+
+// auditNotRecorded logs everything that would have been recorded, so the change can still be traced
+func auditNotRecorded(log *slog.Logger, err error) {
+	log.With("error", err).Error("failed to record audit entry")
+}
+
+// auditSnapshot is the JSON array of the messages, nil if there aren't any
+func auditSnapshot(messages []proto.Message) ([]byte, error) {
+	if len(messages) == 0 {
+		return nil, nil
+	}
+
+	snapshots := []json.RawMessage{}
+	for _, message := range messages {
+		snapshot, err := protojson.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+
+	return json.Marshal(snapshots)
+}
+
+func createResponseAuditEntry(entry audit.Entry) *depositsv1.AuditEntry {
+	return &depositsv1.AuditEntry{
+		Sequence:     entry.Sequence,
+		RequestId:    entry.RequestId,
+		Actor:        entry.Actor,
+		Rpc:          entry.Rpc,
+		EntityIds:    entry.EntityIds,
+		Before:       string(entry.Before),
+		After:        string(entry.After),
+		RecordedAt:   timestamppb.New(entry.RecordedAt),
+		PreviousHash: entry.PreviousHash,
+		Hash:         entry.Hash,
+	}
+}
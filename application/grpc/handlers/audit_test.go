@@ -0,0 +1,238 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	depositsv1 "github.com/iainvm/deposits/application/grpc/gen/deposits/v1"
+	"github.com/iainvm/deposits/application/grpc/gen/deposits/v1/depositsv1connect"
+	"github.com/iainvm/deposits/application/grpc/handlers"
+	"github.com/iainvm/deposits/internal/audit"
+	"github.com/iainvm/deposits/internal/deposits"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryAuditor chains the entries it records in memory, failing every record with its error
+type memoryAuditor struct {
+	handlers.AuditService
+	entries []*audit.Entry
+	err     error
+}
+
+func (auditor *memoryAuditor) Record(ctx context.Context, entry *audit.Entry) error {
+	if auditor.err != nil {
+		return auditor.err
+	}
+
+	var previous *audit.Entry
+	if len(auditor.entries) > 0 {
+		previous = auditor.entries[len(auditor.entries)-1]
+	}
+	entry.Chain(previous)
+	auditor.entries = append(auditor.entries, entry)
+	return nil
+}
+
+func (auditor *memoryAuditor) Verify(ctx context.Context) (int64, error) {
+	for i, entry := range auditor.entries {
+		var previous *audit.Entry
+		if i > 0 {
+			previous = auditor.entries[i-1]
+		}
+
+		err := audit.VerifyChain(previous, []*audit.Entry{entry})
+		if err != nil {
+			return int64(i), err
+		}
+	}
+
+	return int64(len(auditor.entries)), nil
+}
+
+func onboardRequest() *connect.Request[depositsv1.OnboardRequest] {
+	return connect.NewRequest(&depositsv1.OnboardRequest{
+		Investor: &depositsv1.Investor{
+			Name:         "Jane",
+			DateOfBirth:  "1990-01-31",
+			NiNumber:     "AB123456C",
+			TaxResidency: "GB",
+			Address:      &depositsv1.Address{Lines: []string{"10 Downing Street", "London"}, Postcode: "SW1A 2AA", Country: "GB"},
+			Email:        "jane@example.com",
+		},
+	})
+}
+
+// authenticated calls the handler's Onboard through the auth interceptor, as the server does
+func authenticated(handler *handlers.InvestorsHandler) connect.UnaryFunc {
+	interceptor := handlers.NewAuthInterceptor(map[string]string{"ops@example.com": "ops-token"})
+	return interceptor.WrapUnary(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		return handler.Onboard(ctx, req.(*connect.Request[depositsv1.OnboardRequest]))
+	})
+}
+
+func TestOnboardAudit(t *testing.T) {
+	t.Run("records the call by the authenticated actor", func(t *testing.T) {
+		auditor := &memoryAuditor{}
+		handler := handlers.NewInvestorsHandler(slog.Default(), stubInvestorsService{}, auditor)
+
+		req := onboardRequest()
+		req.Header().Set(handlers.HeaderAuthorization, "Bearer ops-token")
+		req.Header().Set(handlers.HeaderRequestId, "request-1")
+		anyRes, err := authenticated(handler)(context.Background(), req)
+		require.NoError(t, err)
+		res := anyRes.(*connect.Response[depositsv1.OnboardResponse])
+
+		require.Len(t, auditor.entries, 1)
+		entry := auditor.entries[0]
+		require.Equal(t, int64(1), entry.Sequence)
+		require.Equal(t, "ops@example.com", entry.Actor)
+		require.Equal(t, "request-1", entry.RequestId)
+		require.Equal(t, depositsv1connect.InvestorsServiceOnboardProcedure, entry.Rpc)
+		require.Equal(t, []string{res.Msg.Investor.Id}, entry.EntityIds)
+		require.Empty(t, entry.Before)
+		require.Contains(t, string(entry.After), res.Msg.Investor.Id)
+	})
+
+	t.Run("unauthenticated calls aren't made", func(t *testing.T) {
+		auditor := &memoryAuditor{}
+		handler := handlers.NewInvestorsHandler(slog.Default(), stubInvestorsService{}, auditor)
+
+		for _, authorization := range []string{"", "ops-token", "Bearer ", "Bearer other-token"} {
+			req := onboardRequest()
+			req.Header().Set(handlers.HeaderAuthorization, authorization)
+			_, err := authenticated(handler)(context.Background(), req)
+			require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err), authorization)
+		}
+		require.Empty(t, auditor.entries)
+	})
+
+	t.Run("calls without an actor are anonymous", func(t *testing.T) {
+		auditor := &memoryAuditor{}
+		handler := handlers.NewInvestorsHandler(slog.Default(), stubInvestorsService{}, auditor)
+
+		_, err := handler.Onboard(context.Background(), onboardRequest())
+		require.NoError(t, err)
+		require.Equal(t, audit.AnonymousActor, auditor.entries[0].Actor)
+	})
+
+	t.Run("failed calls aren't recorded", func(t *testing.T) {
+		auditor := &memoryAuditor{}
+		handler := handlers.NewInvestorsHandler(slog.Default(), stubInvestorsService{}, auditor)
+
+		_, err := handler.Onboard(context.Background(), connect.NewRequest(&depositsv1.OnboardRequest{}))
+		require.Error(t, err)
+		require.Empty(t, auditor.entries)
+	})
+
+	t.Run("failing to record logs the entry without failing the call", func(t *testing.T) {
+		logs := &bytes.Buffer{}
+		auditor := &memoryAuditor{err: errors.New("connection refused")}
+		handler := handlers.NewInvestorsHandler(slog.New(slog.NewJSONHandler(logs, nil)), stubInvestorsService{}, auditor)
+
+		// The change was made, so a retry would make it again
+		res, err := handler.Onboard(context.Background(), onboardRequest())
+		require.NoError(t, err)
+		require.Empty(t, auditor.entries)
+
+		require.Contains(t, logs.String(), "failed to record audit entry")
+		require.Contains(t, logs.String(), "connection refused")
+		require.Contains(t, logs.String(), res.Msg.Investor.Id)
+	})
+}
+
+// stubClaimsService exports and pays the same batch of one claim
+type stubClaimsService struct {
+	handlers.DepositsService
+	batch deposits.ClaimBatch
+}
+
+func (service stubClaimsService) ExportReliefClaims(ctx context.Context, period deposits.ClaimPeriod) (*deposits.ClaimBatch, error) {
+	return &service.batch, nil
+}
+
+func (service stubClaimsService) MarkClaimBatchPaid(ctx context.Context, batchId deposits.ClaimBatchId) (*deposits.ClaimBatch, *deposits.ClaimBatch, error) {
+	paid := service.batch
+	err := paid.MarkPaid()
+	return &service.batch, &paid, err
+}
+
+func TestReliefClaimsAudit(t *testing.T) {
+	period, err := deposits.ParseClaimPeriod("2024-05")
+	require.NoError(t, err)
+	batch, err := deposits.NewClaimBatch(period, time.Now())
+	require.NoError(t, err)
+	claim := &deposits.ReliefClaim{
+		Id:           deposits.ReliefClaimId(uuid.NewString()),
+		AccountId:    deposits.AccountId(uuid.NewString()),
+		NetAmount:    deposits.AllocatedAmount{Money: deposits.Money{Amount: 80_00, Currency: deposits.CurrencyGBP}},
+		ReliefAmount: deposits.AllocatedAmount{Money: deposits.Money{Amount: 20_00, Currency: deposits.CurrencyGBP}},
+		Status:       deposits.ReliefClaimStatusPending,
+	}
+	require.NoError(t, batch.AddClaim(claim))
+
+	auditor := &memoryAuditor{}
+	handler := handlers.NewDepositsHandler(slog.Default(), stubClaimsService{batch: *batch}, auditor)
+
+	_, err = handler.ExportReliefClaims(context.Background(), connect.NewRequest(&depositsv1.ExportReliefClaimsRequest{Period: "2024-05"}))
+	require.NoError(t, err)
+	_, err = handler.MarkReliefClaimBatchPaid(context.Background(), connect.NewRequest(&depositsv1.MarkReliefClaimBatchPaidRequest{BatchId: batch.Id.String()}))
+	require.NoError(t, err)
+
+	require.Len(t, auditor.entries, 2)
+	exported, paid := auditor.entries[0], auditor.entries[1]
+	require.Equal(t, depositsv1connect.DepositsServiceExportReliefClaimsProcedure, exported.Rpc)
+	require.ElementsMatch(t, []string{batch.Id.String(), claim.Id.String()}, exported.EntityIds)
+	require.Empty(t, exported.Before)
+	require.Equal(t, depositsv1connect.DepositsServiceMarkReliefClaimBatchPaidProcedure, paid.Rpc)
+	require.Contains(t, paid.EntityIds, claim.AccountId.String())
+	require.Contains(t, string(paid.Before), "RELIEF_CLAIM_BATCH_STATUS_SUBMITTED")
+	require.Contains(t, string(paid.After), "RELIEF_CLAIM_BATCH_STATUS_PAID")
+}
+
+func TestBearerTokenNotLogged(t *testing.T) {
+	logs := &bytes.Buffer{}
+	handler := handlers.NewInvestorsHandler(slog.New(slog.NewJSONHandler(logs, nil)), stubInvestorsService{}, &memoryAuditor{})
+
+	req := onboardRequest()
+	req.Header().Set(handlers.HeaderAuthorization, "Bearer ops-token")
+	req.Header().Set(handlers.HeaderRequestId, "request-1")
+	_, err := authenticated(handler)(context.Background(), req)
+	require.NoError(t, err)
+
+	// The rest of the header is still logged
+	require.Contains(t, logs.String(), "request-1")
+	require.NotContains(t, logs.String(), "ops-token")
+
+	// Without changing the call's header
+	require.Equal(t, "Bearer ops-token", req.Header().Get(handlers.HeaderAuthorization))
+}
+
+func TestVerifyAuditLog(t *testing.T) {
+	auditor := &memoryAuditor{}
+	for range 3 {
+		entry, err := audit.NewEntry("request-1", "ops@example.com", "/deposits.v1.InvestorsService/Onboard", []string{"investor-id"}, nil, []byte(`{}`), time.Now())
+		require.NoError(t, err)
+		require.NoError(t, auditor.Record(context.Background(), entry))
+	}
+	handler := handlers.NewAuditHandler(slog.Default(), auditor)
+
+	res, err := handler.VerifyAuditLog(context.Background(), connect.NewRequest(&depositsv1.VerifyAuditLogRequest{}))
+	require.NoError(t, err)
+	require.True(t, res.Msg.Valid)
+	require.Equal(t, int64(3), res.Msg.EntriesVerified)
+
+	// Tampering is the answer, not an error
+	auditor.entries[1].Actor = "someone-else"
+	res, err = handler.VerifyAuditLog(context.Background(), connect.NewRequest(&depositsv1.VerifyAuditLogRequest{}))
+	require.NoError(t, err)
+	require.False(t, res.Msg.Valid)
+	require.Equal(t, int64(1), res.Msg.EntriesVerified)
+	require.Equal(t, int64(2), res.Msg.BrokenSequence)
+	require.Equal(t, audit.ErrEntryModified.Error(), res.Msg.Reason)
+}
@@ -2,69 +2,494 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"strings"
+	"time"
 
 	"connectrpc.com/connect"
 	depositsv1 "github.com/iainvm/deposits/application/grpc/gen/deposits/v1"
+	"github.com/iainvm/deposits/application/grpc/gen/deposits/v1/depositsv1connect"
 	"github.com/iainvm/deposits/internal/deposits"
 	"github.com/iainvm/deposits/internal/investors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrAmendmentMissing = errors.New("deposit amendment has nothing set")
 )
 
 type DepositsService interface {
-	ReceiveReceipt(ctx context.Context, accountId deposits.AccountId, receipt *deposits.Receipt) error
+	ReceiveReceipt(ctx context.Context, accountId deposits.AccountId, receipt *deposits.Receipt) (*deposits.Receipt, []deposits.AccountChange, error)
+	ReceiveReceiptWithOverflow(ctx context.Context, accountId deposits.AccountId, receipt *deposits.Receipt) (*deposits.Receipt, *deposits.Receipt, []deposits.AccountChange, error)
+	ReverseReceipt(ctx context.Context, receiptId deposits.ReceiptId, reason deposits.ReversalReason, key deposits.IdempotencyKey) (*deposits.Reversal, error)
+	ReceiveDepositReceipt(ctx context.Context, depositReceipt *deposits.DepositReceipt, rule deposits.AllocationRule) (*deposits.DepositReceipt, error)
+	ReceiveUnallocatedReceipt(ctx context.Context, receipt *deposits.Receipt) (*deposits.Receipt, error)
+	ListUnallocatedReceipts(ctx context.Context, depositId deposits.DepositId) ([]*deposits.Receipt, error)
+	AllocateUnallocatedReceipt(ctx context.Context, receiptId deposits.ReceiptId, accountId deposits.AccountId, amount deposits.Money, allocatedBy string, key deposits.IdempotencyKey) (*deposits.SuspenseAllocation, error)
+	ListSuspenseAllocations(ctx context.Context, receiptId deposits.ReceiptId) ([]*deposits.SuspenseAllocation, error)
 	Get(ctx context.Context, id deposits.DepositId) (*deposits.Deposit, error)
+	GetAccount(ctx context.Context, id deposits.AccountId) (*deposits.Account, error)
+	ListDeposits(ctx context.Context, filter deposits.DepositFilter) (*deposits.DepositPage, error)
+	UpdateDeposit(ctx context.Context, id deposits.DepositId, amendments []deposits.Amendment) (*deposits.Deposit, *deposits.Deposit, error)
+	CancelDeposit(ctx context.Context, id deposits.DepositId) (*deposits.Deposit, *deposits.Deposit, error)
+	CloseDeposit(ctx context.Context, id deposits.DepositId) (*deposits.Deposit, *deposits.Deposit, error)
 	Create(ctx context.Context, investorId investors.InvestorId, deposit *deposits.Deposit) error
+	GetISAAllowance(ctx context.Context, investorId investors.InvestorId, taxYear deposits.TaxYear) (*deposits.ISAAllowance, error)
+	ExportReliefClaims(ctx context.Context, period deposits.ClaimPeriod) (*deposits.ClaimBatch, error)
+	MarkClaimBatchPaid(ctx context.Context, batchId deposits.ClaimBatchId) (*deposits.ClaimBatch, *deposits.ClaimBatch, error)
 }
 
 type DepositsHandler struct {
 	log              *slog.Logger
 	depostitsService DepositsService
+	auditor          Auditor
 }
 
-func NewDepositsHandler(log *slog.Logger, depositsService DepositsService) *DepositsHandler {
+func NewDepositsHandler(log *slog.Logger, depositsService DepositsService, auditor Auditor) *DepositsHandler {
 	return &DepositsHandler{
 		log:              log,
 		depostitsService: depositsService,
+		auditor:          auditor,
 	}
 }
 
 func (h *DepositsHandler) ReceiveReceipt(ctx context.Context, req *connect.Request[depositsv1.ReceiveReceiptRequest]) (*connect.Response[depositsv1.ReceiveReceiptResponse], error) {
-	h.log.With("header", req.Header()).With("request", req.Msg).Info("Receive Receipt Called")
+	h.log.With("header", loggedHeader(req.Header())).With("request", req.Msg).Info("Receive Receipt Called")
 
 	accountId, err := deposits.ParseAccountId(req.Msg.AccountId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, invalidArgument(err)
 	}
 
-	receipt, err := deposits.NewReceipt(req.Msg.Receipt.AllocatedAmount)
+	amount, err := createDomainMoney(req.Msg.Receipt.GetAllocatedAmount())
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, invalidArgument(err)
 	}
 
-	err = h.depostitsService.ReceiveReceipt(ctx, accountId, receipt)
+	receipt, err := deposits.NewReceipt(amount)
 	if err != nil {
-		return nil, err
+		return nil, invalidArgument(err)
+	}
+
+	// Payments with a key are only received once
+	if req.Msg.Receipt.GetIdempotencyKey() != "" {
+		receipt.IdempotencyKey, err = deposits.NewIdempotencyKey(req.Msg.Receipt.GetIdempotencyKey())
+		if err != nil {
+			return nil, invalidArgument(err)
+		}
+	}
+
+	// The bank's date decides the tax year the receipt's in, otherwise it's received now
+	if req.Msg.Receipt.GetReceivedAt() != nil {
+		err = req.Msg.Receipt.GetReceivedAt().CheckValid()
+		if err != nil {
+			return nil, invalidArgument(err)
+		}
+		receipt.ReceivedAt = req.Msg.Receipt.GetReceivedAt().AsTime()
+	}
+
+	// Overflow is opt in, otherwise receipts too big for the account fail
+	var overflow *deposits.Receipt
+	var changes []deposits.AccountChange
+	if req.Msg.Overflow {
+		receipt, overflow, changes, err = h.depostitsService.ReceiveReceiptWithOverflow(ctx, accountId, receipt)
+	} else {
+		receipt, changes, err = h.depostitsService.ReceiveReceipt(ctx, accountId, receipt)
+	}
+	if err != nil {
+		return nil, connectError(err)
 	}
 
 	// Create response
-	res := connect.NewResponse(&depositsv1.ReceiveReceiptResponse{
+	response := &depositsv1.ReceiveReceiptResponse{
 		Receipt: createResponseReceipt(*receipt),
+	}
+	if overflow != nil {
+		response.OverflowReceipt = createResponseReceipt(*overflow)
+	}
+
+	// Audit the accounts as the receipt changed them, a repeat of a payment already received doesn't change any
+	entityIds := []string{accountId.String(), receipt.Id.String()}
+	if overflow != nil {
+		entityIds = append(entityIds, overflow.Id.String())
+	}
+	before, after := []proto.Message{}, []proto.Message{}
+	for _, change := range changes {
+		entityIds = append(entityIds, change.After.Id.String())
+		before = append(before, createResponseAccount(change.Before))
+		after = append(after, createResponseAccount(change.After))
+	}
+	recordAudit(ctx, h.log, h.auditor, req.Header(), depositsv1connect.DepositsServiceReceiveReceiptProcedure, entityIds, before, after)
+
+	res := connect.NewResponse(response)
+	res.Header().Set("Deposit-Version", "v1")
+	return res, nil
+}
+
+func (h *DepositsHandler) ReceiveDepositReceipt(ctx context.Context, req *connect.Request[depositsv1.ReceiveDepositReceiptRequest]) (*connect.Response[depositsv1.ReceiveDepositReceiptResponse], error) {
+	h.log.With("header", loggedHeader(req.Header())).With("request", req.Msg).Info("Receive Deposit Receipt Called")
+
+	depositId, err := deposits.ParseDepositId(req.Msg.DepositId)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	amount, err := createDomainMoney(req.Msg.GetAllocatedAmount())
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	rule, err := createDomainAllocationRule(req.Msg.Strategy, req.Msg.WaterfallOrder)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	depositReceipt, err := deposits.NewDepositReceipt(depositId, amount)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	// Payments with a key are only received once
+	if req.Msg.IdempotencyKey != "" {
+		depositReceipt.IdempotencyKey, err = deposits.NewIdempotencyKey(req.Msg.IdempotencyKey)
+		if err != nil {
+			return nil, invalidArgument(err)
+		}
+	}
+
+	// The bank's date decides the tax year the receipt's in, otherwise it's received now
+	if req.Msg.GetReceivedAt() != nil {
+		err = req.Msg.GetReceivedAt().CheckValid()
+		if err != nil {
+			return nil, invalidArgument(err)
+		}
+		depositReceipt.ReceivedAt = req.Msg.GetReceivedAt().AsTime()
+	}
+
+	depositReceipt, err = h.depostitsService.ReceiveDepositReceipt(ctx, depositReceipt, rule)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	// Audit
+	entityIds := []string{depositId.String(), depositReceipt.Id.String()}
+	for _, receipt := range depositReceipt.Receipts {
+		entityIds = append(entityIds, receipt.Id.String(), receipt.AccountId.String())
+	}
+	recordAudit(ctx, h.log, h.auditor, req.Header(), depositsv1connect.DepositsServiceReceiveDepositReceiptProcedure, entityIds, nil, []proto.Message{createResponseDepositReceipt(*depositReceipt)})
+
+	// Create response
+	res := connect.NewResponse(&depositsv1.ReceiveDepositReceiptResponse{
+		DepositReceipt: createResponseDepositReceipt(*depositReceipt),
+	})
+	res.Header().Set("Deposit-Version", "v1")
+	return res, nil
+}
+
+func (h *DepositsHandler) ReceiveUnallocatedReceipt(ctx context.Context, req *connect.Request[depositsv1.ReceiveUnallocatedReceiptRequest]) (*connect.Response[depositsv1.ReceiveUnallocatedReceiptResponse], error) {
+	h.log.With("header", loggedHeader(req.Header())).With("request", req.Msg).Info("Receive Unallocated Receipt Called")
+
+	amount, err := createDomainMoney(req.Msg.Receipt.GetAllocatedAmount())
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	receipt, err := deposits.NewUnallocatedReceipt(amount, req.Msg.Receipt.GetAccountReference())
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	// Payments with a key are only received once
+	if req.Msg.Receipt.GetIdempotencyKey() != "" {
+		receipt.IdempotencyKey, err = deposits.NewIdempotencyKey(req.Msg.Receipt.GetIdempotencyKey())
+		if err != nil {
+			return nil, invalidArgument(err)
+		}
+	}
+
+	// The bank's date decides the tax year the receipt's in, otherwise it's received now
+	if req.Msg.Receipt.GetReceivedAt() != nil {
+		err = req.Msg.Receipt.GetReceivedAt().CheckValid()
+		if err != nil {
+			return nil, invalidArgument(err)
+		}
+		receipt.ReceivedAt = req.Msg.Receipt.GetReceivedAt().AsTime()
+	}
+
+	receipt, err = h.depostitsService.ReceiveUnallocatedReceipt(ctx, receipt)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	// Audit
+	entityIds := []string{receipt.Id.String()}
+	if receipt.SuspenseDepositId != "" {
+		entityIds = append(entityIds, receipt.SuspenseDepositId.String())
+	}
+	recordAudit(ctx, h.log, h.auditor, req.Header(), depositsv1connect.DepositsServiceReceiveUnallocatedReceiptProcedure, entityIds, nil, []proto.Message{createResponseReceipt(*receipt)})
+
+	// Create response
+	res := connect.NewResponse(&depositsv1.ReceiveUnallocatedReceiptResponse{
+		Receipt: createResponseReceipt(*receipt),
+	})
+	res.Header().Set("Deposit-Version", "v1")
+	return res, nil
+}
+
+func (h *DepositsHandler) ListUnallocatedReceipts(ctx context.Context, req *connect.Request[depositsv1.ListUnallocatedReceiptsRequest]) (*connect.Response[depositsv1.ListUnallocatedReceiptsResponse], error) {
+	h.log.With("header", loggedHeader(req.Header())).With("request", req.Msg).Info("List Unallocated Receipts Called")
+
+	// Deposit is optional
+	var depositId deposits.DepositId
+	var err error
+	if req.Msg.DepositId != "" {
+		depositId, err = deposits.ParseDepositId(req.Msg.DepositId)
+		if err != nil {
+			return nil, invalidArgument(err)
+		}
+	}
+
+	receipts, err := h.depostitsService.ListUnallocatedReceipts(ctx, depositId)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	// Create response
+	response := &depositsv1.ListUnallocatedReceiptsResponse{}
+	for _, receipt := range receipts {
+		response.Receipts = append(response.Receipts, createResponseReceipt(*receipt))
+	}
+	res := connect.NewResponse(response)
+	res.Header().Set("Deposit-Version", "v1")
+	return res, nil
+}
+
+func (h *DepositsHandler) AllocateUnallocatedReceipt(ctx context.Context, req *connect.Request[depositsv1.AllocateUnallocatedReceiptRequest]) (*connect.Response[depositsv1.AllocateUnallocatedReceiptResponse], error) {
+	h.log.With("header", loggedHeader(req.Header())).With("request", req.Msg).Info("Allocate Unallocated Receipt Called")
+
+	receiptId, err := deposits.ParseReceiptId(req.Msg.ReceiptId)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	accountId, err := deposits.ParseAccountId(req.Msg.AccountId)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	amount, err := createDomainMoney(req.Msg.GetAmount())
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	// Allocations with a key are only made once
+	var key deposits.IdempotencyKey
+	if req.Msg.IdempotencyKey != "" {
+		key, err = deposits.NewIdempotencyKey(req.Msg.IdempotencyKey)
+		if err != nil {
+			return nil, invalidArgument(err)
+		}
+	}
+
+	// The cash is allocated by whoever's authenticated, so the audit trail can't be given someone else
+	allocation, err := h.depostitsService.AllocateUnallocatedReceipt(ctx, receiptId, accountId, amount, actorFrom(ctx), key)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	// Audit
+	entityIds := []string{receiptId.String(), accountId.String(), allocation.Id.String(), allocation.ReceiptId.String()}
+	recordAudit(ctx, h.log, h.auditor, req.Header(), depositsv1connect.DepositsServiceAllocateUnallocatedReceiptProcedure, entityIds, nil, []proto.Message{createResponseSuspenseAllocation(*allocation)})
+
+	// Create response
+	res := connect.NewResponse(&depositsv1.AllocateUnallocatedReceiptResponse{
+		Allocation: createResponseSuspenseAllocation(*allocation),
+	})
+	res.Header().Set("Deposit-Version", "v1")
+	return res, nil
+}
+
+func (h *DepositsHandler) ListSuspenseAllocations(ctx context.Context, req *connect.Request[depositsv1.ListSuspenseAllocationsRequest]) (*connect.Response[depositsv1.ListSuspenseAllocationsResponse], error) {
+	h.log.With("header", loggedHeader(req.Header())).With("request", req.Msg).Info("List Suspense Allocations Called")
+
+	receiptId, err := deposits.ParseReceiptId(req.Msg.ReceiptId)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	allocations, err := h.depostitsService.ListSuspenseAllocations(ctx, receiptId)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	// Create response
+	response := &depositsv1.ListSuspenseAllocationsResponse{}
+	for _, allocation := range allocations {
+		response.Allocations = append(response.Allocations, createResponseSuspenseAllocation(*allocation))
+	}
+	res := connect.NewResponse(response)
+	res.Header().Set("Deposit-Version", "v1")
+	return res, nil
+}
+
+func (h *DepositsHandler) ReverseReceipt(ctx context.Context, req *connect.Request[depositsv1.ReverseReceiptRequest]) (*connect.Response[depositsv1.ReverseReceiptResponse], error) {
+	h.log.With("header", loggedHeader(req.Header())).With("request", req.Msg).Info("Reverse Receipt Called")
+
+	receiptId, err := deposits.ParseReceiptId(req.Msg.ReceiptId)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	reason, err := deposits.ParseReversalReason(strings.TrimPrefix(req.Msg.Reason.String(), reversalReasonPrefix))
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	// Reversals with a key are only made once
+	var key deposits.IdempotencyKey
+	if req.Msg.IdempotencyKey != "" {
+		key, err = deposits.NewIdempotencyKey(req.Msg.IdempotencyKey)
+		if err != nil {
+			return nil, invalidArgument(err)
+		}
+	}
+
+	reversal, err := h.depostitsService.ReverseReceipt(ctx, receiptId, reason, key)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	// Audit
+	entityIds := []string{receiptId.String(), reversal.AccountId.String(), reversal.Id.String()}
+	recordAudit(ctx, h.log, h.auditor, req.Header(), depositsv1connect.DepositsServiceReverseReceiptProcedure, entityIds, nil, []proto.Message{createResponseReversal(*reversal)})
+
+	// Create response
+	res := connect.NewResponse(&depositsv1.ReverseReceiptResponse{
+		Reversal: createResponseReversal(*reversal),
+	})
+	res.Header().Set("Deposit-Version", "v1")
+	return res, nil
+}
+
+func (h *DepositsHandler) GetAnnualAllowance(ctx context.Context, req *connect.Request[depositsv1.GetAnnualAllowanceRequest]) (*connect.Response[depositsv1.GetAnnualAllowanceResponse], error) {
+	h.log.With("header", loggedHeader(req.Header())).With("request", req.Msg).Info("Get Annual Allowance Called")
+
+	investorId, err := investors.ParseInvestorId(req.Msg.InvestorId)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	// Default to the current tax year
+	taxYear := deposits.TaxYearOf(time.Now())
+	if req.Msg.TaxYear != "" {
+		taxYear, err = deposits.ParseTaxYear(req.Msg.TaxYear)
+		if err != nil {
+			return nil, invalidArgument(err)
+		}
+	}
+
+	allowance, err := h.depostitsService.GetISAAllowance(ctx, investorId, taxYear)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	// Create response
+	res := connect.NewResponse(&depositsv1.GetAnnualAllowanceResponse{
+		Allowance: &depositsv1.AnnualAllowance{
+			InvestorId: allowance.InvestorId.String(),
+			TaxYear:    allowance.TaxYear.String(),
+			Limit:      createResponseMoney(allowance.Limit),
+			Used:       createResponseMoney(allowance.Used),
+			Remaining:  createResponseMoney(allowance.Remaining()),
+		},
+	})
+	res.Header().Set("Deposit-Version", "v1")
+	return res, nil
+}
+
+func (h *DepositsHandler) ExportReliefClaims(ctx context.Context, req *connect.Request[depositsv1.ExportReliefClaimsRequest]) (*connect.Response[depositsv1.ExportReliefClaimsResponse], error) {
+	h.log.With("header", loggedHeader(req.Header())).With("request", req.Msg).Info("Export Relief Claims Called")
+
+	period, err := deposits.ParseClaimPeriod(req.Msg.Period)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	batch, err := h.depostitsService.ExportReliefClaims(ctx, period)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	responseBatch, err := createResponseClaimBatch(*batch)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	// Audit
+	entityIds := []string{batch.Id.String()}
+	for _, claim := range batch.Claims {
+		entityIds = append(entityIds, claim.Id.String())
+	}
+	recordAudit(ctx, h.log, h.auditor, req.Header(), depositsv1connect.DepositsServiceExportReliefClaimsProcedure, entityIds, nil, []proto.Message{responseBatch})
+
+	// Create response
+	res := connect.NewResponse(&depositsv1.ExportReliefClaimsResponse{
+		Batch: responseBatch,
+	})
+	res.Header().Set("Deposit-Version", "v1")
+	return res, nil
+}
+
+func (h *DepositsHandler) MarkReliefClaimBatchPaid(ctx context.Context, req *connect.Request[depositsv1.MarkReliefClaimBatchPaidRequest]) (*connect.Response[depositsv1.MarkReliefClaimBatchPaidResponse], error) {
+	h.log.With("header", loggedHeader(req.Header())).With("request", req.Msg).Info("Mark Relief Claim Batch Paid Called")
+
+	batchId, err := deposits.ParseClaimBatchId(req.Msg.BatchId)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	before, batch, err := h.depostitsService.MarkClaimBatchPaid(ctx, batchId)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	responseBefore, err := createResponseClaimBatch(*before)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	responseBatch, err := createResponseClaimBatch(*batch)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	// Audit
+	entityIds := []string{batch.Id.String()}
+	for _, claim := range batch.Claims {
+		entityIds = append(entityIds, claim.Id.String(), claim.AccountId.String(), claim.ReliefReceiptId.String())
+	}
+	recordAudit(ctx, h.log, h.auditor, req.Header(), depositsv1connect.DepositsServiceMarkReliefClaimBatchPaidProcedure, entityIds, []proto.Message{responseBefore}, []proto.Message{responseBatch})
+
+	// Create response
+	res := connect.NewResponse(&depositsv1.MarkReliefClaimBatchPaidResponse{
+		Batch: responseBatch,
 	})
 	res.Header().Set("Deposit-Version", "v1")
 	return res, nil
 }
 
 func (h *DepositsHandler) Get(ctx context.Context, req *connect.Request[depositsv1.GetRequest]) (*connect.Response[depositsv1.GetResponse], error) {
-	h.log.With("header", req.Header()).With("request", req.Msg).Info("Get Called")
+	h.log.With("header", loggedHeader(req.Header())).With("request", req.Msg).Info("Get Called")
 
 	depositId, err := deposits.ParseDepositId(req.Msg.Id)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, invalidArgument(err)
 	}
 
 	deposit, err := h.depostitsService.Get(ctx, depositId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, connectError(err)
 	}
 
 	// Create response
@@ -75,26 +500,145 @@ func (h *DepositsHandler) Get(ctx context.Context, req *connect.Request[deposits
 	return res, nil
 }
 
-func (h *DepositsHandler) Create(ctx context.Context, req *connect.Request[depositsv1.CreateRequest]) (*connect.Response[depositsv1.CreateResponse], error) {
-	h.log.With("header", req.Header()).With("request", req.Msg).Info("Create Called")
+func (h *DepositsHandler) ListDeposits(ctx context.Context, req *connect.Request[depositsv1.ListDepositsRequest]) (*connect.Response[depositsv1.ListDepositsResponse], error) {
+	h.log.With("header", loggedHeader(req.Header())).With("request", req.Msg).Info("List Deposits Called")
+
+	filter, err := createDomainDepositFilter(req.Msg)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	page, err := h.depostitsService.ListDeposits(ctx, filter)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	// Create response
+	response := &depositsv1.ListDepositsResponse{
+		Deposits: []*depositsv1.DepositSummary{},
+	}
+	for _, summary := range page.Deposits {
+		response.Deposits = append(response.Deposits, createResponseDepositSummary(*summary))
+	}
+	if page.Next != nil {
+		response.NextPageToken = page.Next.String()
+	}
+	res := connect.NewResponse(response)
+	res.Header().Set("Deposit-Version", "v1")
+	return res, nil
+}
+
+func (h *DepositsHandler) UpdateDeposit(ctx context.Context, req *connect.Request[depositsv1.UpdateDepositRequest]) (*connect.Response[depositsv1.UpdateDepositResponse], error) {
+	h.log.With("header", loggedHeader(req.Header())).With("request", req.Msg).Info("Update Deposit Called")
+
+	depositId, err := deposits.ParseDepositId(req.Msg.Id)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	// Create Domain Models, collecting every problem with the request
+	violations := &fieldViolations{}
+	amendments := []deposits.Amendment{}
+	for i, reqAmendment := range req.Msg.Amendments {
+		amendment := createDomainAmendment(reqAmendment, indexPath("amendments", i), violations)
+		amendments = append(amendments, amendment)
+	}
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	before, deposit, err := h.depostitsService.UpdateDeposit(ctx, depositId, amendments)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	// Audit
+	recordAudit(ctx, h.log, h.auditor, req.Header(), depositsv1connect.DepositsServiceUpdateDepositProcedure, depositEntityIds(*deposit), []proto.Message{createResponseDeposit(*before)}, []proto.Message{createResponseDeposit(*deposit)})
+
+	// Create response
+	res := connect.NewResponse(&depositsv1.UpdateDepositResponse{
+		Deposit: createResponseDeposit(*deposit),
+	})
+	res.Header().Set("Deposit-Version", "v1")
+	return res, nil
+}
+
+func (h *DepositsHandler) CancelDeposit(ctx context.Context, req *connect.Request[depositsv1.CancelDepositRequest]) (*connect.Response[depositsv1.CancelDepositResponse], error) {
+	h.log.With("header", loggedHeader(req.Header())).With("request", req.Msg).Info("Cancel Deposit Called")
 
-	// Create Domain Model
-	deposit, err := createDomainDeposit(req.Msg.Deposit)
+	depositId, err := deposits.ParseDepositId(req.Msg.Id)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, invalidArgument(err)
 	}
 
+	before, deposit, err := h.depostitsService.CancelDeposit(ctx, depositId)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	// Audit
+	recordAudit(ctx, h.log, h.auditor, req.Header(), depositsv1connect.DepositsServiceCancelDepositProcedure, depositEntityIds(*deposit), []proto.Message{createResponseDeposit(*before)}, []proto.Message{createResponseDeposit(*deposit)})
+
+	// Create response
+	res := connect.NewResponse(&depositsv1.CancelDepositResponse{
+		Deposit: createResponseDeposit(*deposit),
+	})
+	res.Header().Set("Deposit-Version", "v1")
+	return res, nil
+}
+
+func (h *DepositsHandler) CloseDeposit(ctx context.Context, req *connect.Request[depositsv1.CloseDepositRequest]) (*connect.Response[depositsv1.CloseDepositResponse], error) {
+	h.log.With("header", loggedHeader(req.Header())).With("request", req.Msg).Info("Close Deposit Called")
+
+	depositId, err := deposits.ParseDepositId(req.Msg.Id)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	before, deposit, err := h.depostitsService.CloseDeposit(ctx, depositId)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	// Audit
+	recordAudit(ctx, h.log, h.auditor, req.Header(), depositsv1connect.DepositsServiceCloseDepositProcedure, depositEntityIds(*deposit), []proto.Message{createResponseDeposit(*before)}, []proto.Message{createResponseDeposit(*deposit)})
+
+	// Create response
+	res := connect.NewResponse(&depositsv1.CloseDepositResponse{
+		Deposit: createResponseDeposit(*deposit),
+	})
+	res.Header().Set("Deposit-Version", "v1")
+	return res, nil
+}
+
+func (h *DepositsHandler) Create(ctx context.Context, req *connect.Request[depositsv1.CreateRequest]) (*connect.Response[depositsv1.CreateResponse], error) {
+	h.log.With("header", loggedHeader(req.Header())).With("request", req.Msg).Info("Create Called")
+
+	// Create Domain Model, collecting every problem with the request
+	violations := &fieldViolations{}
 	investorId, err := investors.ParseInvestorId(req.Msg.InvestorId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		violations.add("investor_id", err)
+	}
+
+	deposit := createDomainDeposit(req.Msg.Deposit, "deposit", violations)
+	if err := violations.err(); err != nil {
+		return nil, err
 	}
 
 	// Onboard
 	err = h.depostitsService.Create(ctx, investorId, deposit)
+	var eligibilityErr *deposits.EligibilityError
+	if errors.As(err, &eligibilityErr) {
+		return nil, eligibilityViolations(*deposit, eligibilityErr)
+	}
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, connectError(err)
 	}
 
+	// Audit
+	recordAudit(ctx, h.log, h.auditor, req.Header(), depositsv1connect.DepositsServiceCreateProcedure, depositEntityIds(*deposit), nil, []proto.Message{createResponseDeposit(*deposit)})
+
 	// Create response
 	res := connect.NewResponse(&depositsv1.CreateResponse{
 		Deposit: createResponseDeposit(*deposit),
@@ -103,43 +647,212 @@ func (h *DepositsHandler) Create(ctx context.Context, req *connect.Request[depos
 	return res, nil
 }
 
-func createDomainDeposit(reqDeposit *depositsv1.Deposit) (*deposits.Deposit, error) {
+// eligibilityViolations returns each rule the investor fails against the field it's about, the wrapper type of the
+// account or the investor for the whole deposit
+//
+// The deposit was created from a request without violations, so its pots and accounts are in the request's order
+func eligibilityViolations(deposit deposits.Deposit, eligibilityErr *deposits.EligibilityError) *connect.Error {
+	accountFields := map[deposits.AccountId]string{}
+	for i, pot := range deposit.Pots {
+		for j, account := range pot.Accounts {
+			potField := indexPath("deposit.pots", i)
+			accountFields[account.Id] = fieldPath(indexPath(fieldPath(potField, "accounts"), j), "wrapper_type")
+		}
+	}
+
+	violations := &fieldViolations{}
+	for _, failure := range eligibilityErr.Failures {
+		field, ok := accountFields[failure.AccountId]
+		if !ok {
+			field = "investor_id"
+		}
+		violations.add(field, failure.Err)
+	}
+
+	return violations.errWithCode(connect.CodeFailedPrecondition, eligibilityErr)
+}
+
+// createDomainDeposit creates the domain deposit, adding any problems with the request's fields to the violations
+//
+// Every pot and account is checked, so the deposit is only usable if there were no violations
+func createDomainDeposit(reqDeposit *depositsv1.Deposit, field string, violations *fieldViolations) *deposits.Deposit {
+	if reqDeposit == nil {
+		violations.add(field, ErrFieldRequired)
+		return nil
+	}
+
 	deposit, err := deposits.NewDeposit()
 	if err != nil {
-		return nil, err
+		violations.add(field, err)
+		return nil
 	}
 
 	// Add Pots
-	for _, reqPot := range reqDeposit.Pots {
-		pot, err := deposits.NewPot(reqPot.Name)
+	for i, reqPot := range reqDeposit.GetPots() {
+		pot := createDomainPot(reqPot, indexPath(fieldPath(field, "pots"), i), violations)
+		if pot == nil {
+			continue
+		}
+
+		deposit.AddPot(pot)
+	}
+
+	return deposit
+}
+
+func createDomainPot(reqPot *depositsv1.Pot, field string, violations *fieldViolations) *deposits.Pot {
+	if reqPot == nil {
+		violations.add(field, ErrFieldRequired)
+		return nil
+	}
+
+	// A pot that can't be created still has its accounts checked against a placeholder, so every problem is reported
+	valid := true
+	pot, err := deposits.NewPot(reqPot.GetName())
+	if err != nil {
+		violations.add(fieldPath(field, "name"), err)
+		pot = &deposits.Pot{}
+		valid = false
+	}
+
+	// Add Accounts
+	for i, reqAccount := range reqPot.GetAccounts() {
+		accountField := indexPath(fieldPath(field, "accounts"), i)
+		account := createDomainAccount(reqAccount, accountField, violations)
+		if account == nil {
+			continue
+		}
+
+		// Duplicate and ineligible wrappers are a problem with the account's wrapper type
+		err = pot.AddAccount(account)
 		if err != nil {
-			return nil, err
+			violations.add(fieldPath(accountField, "wrapper_type"), err)
 		}
+	}
 
-		// Add Accounts
-		for _, reqAccount := range reqPot.Accounts {
-			// Get Wrapper Type
-			wrapperType := deposits.WrapperType(reqAccount.WrapperType)
-			account, err := deposits.NewAccount(wrapperType, reqAccount.NominalAmount)
-			if err != nil {
-				return nil, err
-			}
+	if !valid {
+		return nil
+	}
+
+	return pot
+}
 
-			pot.AddAccount(account)
+func createDomainAccount(reqAccount *depositsv1.Account, field string, violations *fieldViolations) *deposits.Account {
+	if reqAccount == nil {
+		violations.add(field, ErrFieldRequired)
+		return nil
+	}
+
+	valid := true
+
+	// Get Wrapper Type
+	wrapperType, err := createDomainWrapperType(reqAccount.GetWrapperType())
+	if err != nil {
+		violations.add(fieldPath(field, "wrapper_type"), err)
+		valid = false
+	}
+
+	nominalAmount, ok := createDomainNominalAmount(reqAccount.GetNominalAmount(), fieldPath(field, "nominal_amount"), violations)
+	if !ok {
+		valid = false
+	}
+
+	if !valid {
+		return nil
+	}
+
+	account, err := deposits.NewAccount(wrapperType, nominalAmount)
+	if err != nil {
+		violations.add(field, err)
+		return nil
+	}
+
+	return account
+}
+
+// createDomainNominalAmount creates the money for a nominal amount, false if it had violations
+func createDomainNominalAmount(reqMoney *depositsv1.Money, field string, violations *fieldViolations) (deposits.Money, bool) {
+	nominalAmount, err := createDomainMoney(reqMoney)
+	if err != nil {
+		violations.add(fieldPath(field, "currency"), err)
+		return deposits.Money{}, false
+	}
+
+	_, err = deposits.NewNominalAmount(nominalAmount)
+	if err != nil {
+		violations.add(fieldPath(field, "amount"), err)
+		return deposits.Money{}, false
+	}
+
+	return nominalAmount, true
+}
+
+// createDomainAmendment creates the domain amendment for whichever of the amendments is set, adding any problems with
+// its fields to the violations
+func createDomainAmendment(reqAmendment *depositsv1.DepositAmendment, field string, violations *fieldViolations) deposits.Amendment {
+	switch amendment := reqAmendment.GetAmendment().(type) {
+	case *depositsv1.DepositAmendment_AddPot_:
+		pot := createDomainPot(amendment.AddPot.GetPot(), fieldPath(field, "add_pot.pot"), violations)
+		return deposits.AddPotAmendment{Pot: pot}
+
+	case *depositsv1.DepositAmendment_RenamePot_:
+		potId, err := deposits.ParsePotId(amendment.RenamePot.GetPotId())
+		if err != nil {
+			violations.add(fieldPath(field, "rename_pot.pot_id"), err)
 		}
+		return deposits.RenamePotAmendment{PotId: potId, Name: amendment.RenamePot.GetName()}
 
-		deposit.AddPot(pot)
+	case *depositsv1.DepositAmendment_AddAccount_:
+		potId, err := deposits.ParsePotId(amendment.AddAccount.GetPotId())
+		if err != nil {
+			violations.add(fieldPath(field, "add_account.pot_id"), err)
+		}
+		account := createDomainAccount(amendment.AddAccount.GetAccount(), fieldPath(field, "add_account.account"), violations)
+		return deposits.AddAccountAmendment{PotId: potId, Account: account}
+
+	case *depositsv1.DepositAmendment_ChangeNominal_:
+		accountId, err := deposits.ParseAccountId(amendment.ChangeNominal.GetAccountId())
+		if err != nil {
+			violations.add(fieldPath(field, "change_nominal.account_id"), err)
+		}
+		nominalAmount, _ := createDomainNominalAmount(amendment.ChangeNominal.GetNominalAmount(), fieldPath(field, "change_nominal.nominal_amount"), violations)
+		return deposits.ChangeNominalAmendment{AccountId: accountId, NominalAmount: nominalAmount}
+
+	case *depositsv1.DepositAmendment_RemoveAccount_:
+		accountId, err := deposits.ParseAccountId(amendment.RemoveAccount.GetAccountId())
+		if err != nil {
+			violations.add(fieldPath(field, "remove_account.account_id"), err)
+		}
+		return deposits.RemoveAccountAmendment{AccountId: accountId}
 	}
 
-	return deposit, nil
+	violations.add(field, ErrAmendmentMissing)
+	return nil
+}
+
+// depositEntityIds are the ids the deposit's audit entries are found by, its investor's, its own, and its pots' and
+// accounts'
+func depositEntityIds(deposit deposits.Deposit) []string {
+	entityIds := []string{deposit.InvestorId.String(), deposit.Id.String()}
+	for _, pot := range deposit.Pots {
+		entityIds = append(entityIds, pot.Id.String())
+		for _, account := range pot.Accounts {
+			entityIds = append(entityIds, account.Id.String())
+		}
+	}
+
+	return entityIds
 }
 
 func createResponseDeposit(deposit deposits.Deposit) *depositsv1.Deposit {
 
 	// Create deposit
 	response := &depositsv1.Deposit{
-		Id:   deposit.Id.String(),
-		Pots: []*depositsv1.Pot{},
+		Id:               deposit.Id.String(),
+		InvestorId:       deposit.InvestorId.String(),
+		Pots:             []*depositsv1.Pot{},
+		Status:           depositsv1.DepositStatus(depositsv1.DepositStatus_value[depositStatusPrefix+deposit.Status.String()]),
+		SuspenseReceipts: []*depositsv1.Receipt{},
 	}
 
 	// Attach pots
@@ -152,27 +865,238 @@ func createResponseDeposit(deposit deposits.Deposit) *depositsv1.Deposit {
 
 		// Attach Accounts
 		for _, account := range pot.Accounts {
-			responseAccount := &depositsv1.Account{
-				Id:                   account.Id.String(),
-				WrapperType:          depositsv1.WrapperType(account.WrapperType),
-				NominalAmount:        account.NominalAmount.Int64(),
-				TotalAllocatedAmount: account.TotalAllocatedAmount.Int64(),
-			}
-
-			responsePot.Accounts = append(responsePot.Accounts, responseAccount)
+			responsePot.Accounts = append(responsePot.Accounts, createResponseAccount(*account))
 		}
 
 		response.Pots = append(response.Pots, responsePot)
 	}
 
+	// Attach receipts held in suspense
+	for _, receipt := range deposit.SuspenseReceipts {
+		response.SuspenseReceipts = append(response.SuspenseReceipts, createResponseReceipt(*receipt))
+	}
+
+	return response
+}
+
+func createResponseAccount(account deposits.Account) *depositsv1.Account {
+	response := &depositsv1.Account{
+		Id:                   account.Id.String(),
+		WrapperType:          createResponseWrapperType(account.WrapperType),
+		NominalAmount:        createResponseMoney(account.NominalAmount.Money),
+		TotalAllocatedAmount: createResponseMoney(account.TotalAllocatedAmount.Money),
+		PendingReliefAmount:  createResponseMoney(account.PendingReliefAmount.Money),
+		Receipts:             []*depositsv1.Receipt{},
+	}
+
+	// Attach Receipts
+	for _, receipt := range account.Receipts {
+		response.Receipts = append(response.Receipts, createResponseReceipt(*receipt))
+	}
+
 	return response
 }
 
 func createResponseReceipt(receipt deposits.Receipt) *depositsv1.Receipt {
 	res := &depositsv1.Receipt{
-		Id:              receipt.Id.String(),
-		AllocatedAmount: receipt.AllocatedAmount.Int64(),
+		Id:                receipt.Id.String(),
+		AllocatedAmount:   createResponseMoney(receipt.AllocatedAmount.Money),
+		IdempotencyKey:    receipt.IdempotencyKey.String(),
+		AccountId:         receipt.AccountId.String(),
+		DepositReceiptId:  receipt.DepositReceiptId.String(),
+		OverflowOf:        receipt.OverflowOf.String(),
+		SuspenseDepositId: receipt.SuspenseDepositId.String(),
+		AccountReference:  receipt.AccountReference,
+		AllocatedFrom:     receipt.AllocatedFrom.String(),
+		ReceivedAt:        timestamppb.New(receipt.ReceivedAt),
+	}
+
+	// Only receipts without an account have cash to allocate
+	if receipt.IsUnallocated() {
+		res.UnallocatedAmount = createResponseMoney(receipt.UnallocatedAmount.Money)
 	}
 
 	return res
 }
+
+func createResponseSuspenseAllocation(allocation deposits.SuspenseAllocation) *depositsv1.SuspenseAllocation {
+	return &depositsv1.SuspenseAllocation{
+		Id:                   allocation.Id.String(),
+		UnallocatedReceiptId: allocation.UnallocatedReceiptId.String(),
+		ReceiptId:            allocation.ReceiptId.String(),
+		AccountId:            allocation.AccountId.String(),
+		Amount:               createResponseMoney(allocation.Amount.Money),
+		AllocatedBy:          allocation.AllocatedBy,
+		AllocatedAt:          timestamppb.New(allocation.AllocatedAt),
+		IdempotencyKey:       allocation.IdempotencyKey.String(),
+	}
+}
+
+func createResponseDepositReceipt(depositReceipt deposits.DepositReceipt) *depositsv1.DepositReceipt {
+	response := &depositsv1.DepositReceipt{
+		Id:              depositReceipt.Id.String(),
+		DepositId:       depositReceipt.DepositId.String(),
+		AllocatedAmount: createResponseMoney(depositReceipt.AllocatedAmount.Money),
+		IdempotencyKey:  depositReceipt.IdempotencyKey.String(),
+		Receipts:        []*depositsv1.Receipt{},
+		ReceivedAt:      timestamppb.New(depositReceipt.ReceivedAt),
+	}
+
+	for _, receipt := range depositReceipt.Receipts {
+		response.Receipts = append(response.Receipts, createResponseReceipt(*receipt))
+	}
+
+	return response
+}
+
+// allocationStrategyPrefix is prepended to an allocation strategy to give its proto enum name
+const allocationStrategyPrefix = "ALLOCATION_STRATEGY_"
+
+func createDomainAllocationRule(strategy depositsv1.AllocationStrategy, waterfallOrder []depositsv1.WrapperType) (deposits.AllocationRule, error) {
+	allocationStrategy, err := deposits.ParseAllocationStrategy(strings.TrimPrefix(strategy.String(), allocationStrategyPrefix))
+	if err != nil {
+		return nil, err
+	}
+
+	order := []deposits.WrapperType{}
+	for _, reqWrapperType := range waterfallOrder {
+		wrapperType, err := createDomainWrapperType(reqWrapperType)
+		if err != nil {
+			return nil, err
+		}
+		order = append(order, wrapperType)
+	}
+
+	return deposits.NewAllocationRule(allocationStrategy, order)
+}
+
+func createDomainDepositFilter(req *depositsv1.ListDepositsRequest) (deposits.DepositFilter, error) {
+	// Blank investors list every investor's deposits
+	investorId := investors.InvestorId("")
+	if req.InvestorId != "" {
+		var err error
+		investorId, err = investors.ParseInvestorId(req.InvestorId)
+		if err != nil {
+			return deposits.DepositFilter{}, err
+		}
+	}
+
+	statuses := []deposits.DepositStatus{}
+	for _, reqStatus := range req.Statuses {
+		status, err := deposits.ParseDepositStatus(strings.TrimPrefix(reqStatus.String(), depositStatusPrefix))
+		if err != nil {
+			return deposits.DepositFilter{}, err
+		}
+		statuses = append(statuses, status)
+	}
+
+	createdFrom, createdTo := time.Time{}, time.Time{}
+	if req.CreatedFrom != nil {
+		createdFrom = req.CreatedFrom.AsTime()
+	}
+	if req.CreatedTo != nil {
+		createdTo = req.CreatedTo.AsTime()
+	}
+
+	return deposits.NewDepositFilter(investorId, statuses, createdFrom, createdTo, int(req.PageSize), req.PageToken)
+}
+
+func createResponseDepositSummary(summary deposits.DepositSummary) *depositsv1.DepositSummary {
+	response := &depositsv1.DepositSummary{
+		Id:         summary.Id.String(),
+		InvestorId: summary.InvestorId.String(),
+		Status:     depositsv1.DepositStatus(depositsv1.DepositStatus_value[depositStatusPrefix+summary.Status.String()]),
+		CreatedAt:  timestamppb.New(summary.CreatedAt),
+		Totals:     []*depositsv1.DepositTotal{},
+	}
+	for _, total := range summary.Totals {
+		response.Totals = append(response.Totals, &depositsv1.DepositTotal{
+			NominalAmount:    createResponseMoney(total.NominalAmount),
+			AllocatedAmount:  createResponseMoney(total.AllocatedAmount),
+			FundedPercentage: total.FundedPercentage,
+		})
+	}
+
+	return response
+}
+
+// depositStatusPrefix is prepended to a deposit status to give its proto enum name
+const depositStatusPrefix = "DEPOSIT_STATUS_"
+
+// reversalReasonPrefix is prepended to a reversal reason to give its proto enum name
+const reversalReasonPrefix = "REVERSAL_REASON_"
+
+func createResponseReversal(reversal deposits.Reversal) *depositsv1.Reversal {
+	return &depositsv1.Reversal{
+		Id:             reversal.Id.String(),
+		ReceiptId:      reversal.ReceiptId.String(),
+		AccountId:      reversal.AccountId.String(),
+		Amount:         createResponseMoney(reversal.Amount.Money),
+		Reason:         depositsv1.ReversalReason(depositsv1.ReversalReason_value[reversalReasonPrefix+reversal.Reason.String()]),
+		IdempotencyKey: reversal.IdempotencyKey.String(),
+	}
+}
+
+func createResponseClaimBatch(batch deposits.ClaimBatch) (*depositsv1.ReliefClaimBatch, error) {
+	totalRelief, err := batch.TotalRelief()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &depositsv1.ReliefClaimBatch{
+		Id:                batch.Id.String(),
+		Period:            batch.Period.String(),
+		Status:            depositsv1.ReliefClaimBatchStatus(depositsv1.ReliefClaimBatchStatus_value["RELIEF_CLAIM_BATCH_STATUS_"+batch.Status.String()]),
+		Claims:            []*depositsv1.ReliefClaim{},
+		TotalReliefAmount: createResponseMoney(totalRelief.Money),
+	}
+
+	for _, claim := range batch.Claims {
+		grossAmount, err := claim.GrossAmount()
+		if err != nil {
+			return nil, err
+		}
+
+		response.Claims = append(response.Claims, &depositsv1.ReliefClaim{
+			Id:              claim.Id.String(),
+			InvestorId:      claim.InvestorId.String(),
+			AccountId:       claim.AccountId.String(),
+			ReceiptId:       claim.ReceiptId.String(),
+			NetAmount:       createResponseMoney(claim.NetAmount.Money),
+			GrossAmount:     createResponseMoney(grossAmount.Money),
+			ReliefAmount:    createResponseMoney(claim.ReliefAmount.Money),
+			Status:          depositsv1.ReliefClaimStatus(depositsv1.ReliefClaimStatus_value["RELIEF_CLAIM_STATUS_"+claim.Status.String()]),
+			ReliefReceiptId: claim.ReliefReceiptId.String(),
+		})
+	}
+
+	return response, nil
+}
+
+func createDomainMoney(money *depositsv1.Money) (deposits.Money, error) {
+	return deposits.NewMoney(money.GetAmount(), money.GetCurrency())
+}
+
+func createResponseMoney(money deposits.Money) *depositsv1.Money {
+	return &depositsv1.Money{
+		Amount:   money.Amount,
+		Currency: money.Currency.String(),
+	}
+}
+
+// wrapperTypePrefix is prepended to a wrapper's registered code to give its proto enum name
+const wrapperTypePrefix = "WRAPPER_TYPE_"
+
+func createDomainWrapperType(wrapperType depositsv1.WrapperType) (deposits.WrapperType, error) {
+	code := strings.TrimPrefix(wrapperType.String(), wrapperTypePrefix)
+	return deposits.ParseWrapperTypeCode(code)
+}
+
+func createResponseWrapperType(wrapperType deposits.WrapperType) depositsv1.WrapperType {
+	value, ok := depositsv1.WrapperType_value[wrapperTypePrefix+wrapperType.String()]
+	if !ok {
+		return depositsv1.WrapperType_WRAPPER_TYPE_UNSPECIFIED
+	}
+
+	return depositsv1.WrapperType(value)
+}
@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"errors"
+
+	"connectrpc.com/connect"
+	depositsv1 "github.com/iainvm/deposits/application/grpc/gen/deposits/v1"
+	"github.com/iainvm/deposits/common/pagination"
+	"github.com/iainvm/deposits/internal/audit"
+	"github.com/iainvm/deposits/internal/deposits"
+	"github.com/iainvm/deposits/internal/investors"
+	"github.com/iainvm/deposits/internal/webhooks"
+)
+
+// errorTranslation is how a domain error is returned to clients
+type errorTranslation struct {
+	err    error
+	code   connect.Code
+	reason string
+}
+
+// errorTranslations are checked in order, so errors joined with a more general one must come before it
+var errorTranslations = []errorTranslation{
+	// Not Found
+	{deposits.ErrDepositNotFound, connect.CodeNotFound, "DEPOSIT_NOT_FOUND"},
+	{deposits.ErrPotNotFound, connect.CodeNotFound, "POT_NOT_FOUND"},
+	{deposits.ErrAccountNotFound, connect.CodeNotFound, "ACCOUNT_NOT_FOUND"},
+	{deposits.ErrAccountNotInPot, connect.CodeNotFound, "ACCOUNT_NOT_IN_DEPOSIT"},
+	{deposits.ErrReceiptNotFound, connect.CodeNotFound, "RECEIPT_NOT_FOUND"},
+	{deposits.ErrDepositReceiptNotFound, connect.CodeNotFound, "DEPOSIT_RECEIPT_NOT_FOUND"},
+	{deposits.ErrReversalNotFound, connect.CodeNotFound, "REVERSAL_NOT_FOUND"},
+	{deposits.ErrReliefClaimNotFound, connect.CodeNotFound, "RELIEF_CLAIM_NOT_FOUND"},
+	{deposits.ErrClaimBatchNotFound, connect.CodeNotFound, "RELIEF_CLAIM_BATCH_NOT_FOUND"},
+	{investors.ErrInvestorNotFound, connect.CodeNotFound, "INVESTOR_NOT_FOUND"},
+	{webhooks.ErrSubscriptionNotFound, connect.CodeNotFound, "WEBHOOK_SUBSCRIPTION_NOT_FOUND"},
+	{webhooks.ErrDeliveryNotFound, connect.CodeNotFound, "WEBHOOK_DELIVERY_NOT_FOUND"},
+
+	// Conflicts
+	{deposits.ErrIdempotencyKeyReused, connect.CodeAlreadyExists, "IDEMPOTENCY_KEY_REUSED"},
+	{deposits.ErrConcurrentModification, connect.CodeAborted, "CONCURRENT_MODIFICATION"},
+
+	// Business rules
+	{deposits.ErrNominalBelowAllocated, connect.CodeFailedPrecondition, "NOMINAL_BELOW_ALLOCATED"},
+	{deposits.ErrNominalExceeded, connect.CodeFailedPrecondition, "NOMINAL_EXCEEDED"},
+	{deposits.ErrWrapperTypeExistsInPot, connect.CodeFailedPrecondition, "WRAPPER_TYPE_EXISTS_IN_POT"},
+	{deposits.ErrWrapperIneligible, connect.CodeFailedPrecondition, "WRAPPER_INELIGIBLE"},
+	{deposits.ErrAccountHasReceipts, connect.CodeFailedPrecondition, "ACCOUNT_HAS_RECEIPTS"},
+	{deposits.ErrReceiptNotOnAccount, connect.CodeFailedPrecondition, "RECEIPT_NOT_ON_ACCOUNT"},
+	{deposits.ErrDepositClosed, connect.CodeFailedPrecondition, "DEPOSIT_CLOSED"},
+	{deposits.ErrInvalidDepositTransition, connect.CodeFailedPrecondition, "INVALID_DEPOSIT_TRANSITION"},
+	{deposits.ErrAnnualAllowanceExceeded, connect.CodeFailedPrecondition, "ANNUAL_ALLOWANCE_EXCEEDED"},
+	{deposits.ErrAllocationExceedsNominal, connect.CodeFailedPrecondition, "ALLOCATION_EXCEEDS_NOMINAL"},
+	{deposits.ErrNoAccountsToAllocate, connect.CodeFailedPrecondition, "NO_ACCOUNTS_TO_ALLOCATE"},
+	{deposits.ErrReceiptAlreadyAllocated, connect.CodeFailedPrecondition, "RECEIPT_ALREADY_ALLOCATED"},
+	{deposits.ErrAllocationExceedsUnallocated, connect.CodeFailedPrecondition, "ALLOCATION_EXCEEDS_UNALLOCATED"},
+	{deposits.ErrReceiptAlreadyReversed, connect.CodeFailedPrecondition, "RECEIPT_ALREADY_REVERSED"},
+	{deposits.ErrReversingOverflow, connect.CodeFailedPrecondition, "REVERSING_OVERFLOW"},
+	{deposits.ErrUnallocatedReceiptAllocated, connect.CodeFailedPrecondition, "UNALLOCATED_RECEIPT_ALLOCATED"},
+	{deposits.ErrReliefAlreadyClaimed, connect.CodeFailedPrecondition, "RELIEF_ALREADY_CLAIMED"},
+	{deposits.ErrReliefClaimBatchPaid, connect.CodeFailedPrecondition, "RELIEF_CLAIM_BATCH_PAID"},
+	{deposits.ErrNoPendingReliefClaims, connect.CodeFailedPrecondition, "NO_PENDING_RELIEF_CLAIMS"},
+	{investors.ErrInvestorHasDeposits, connect.CodeFailedPrecondition, "INVESTOR_HAS_DEPOSITS"},
+	{investors.ErrKYCIncomplete, connect.CodeFailedPrecondition, "KYC_INCOMPLETE"},
+	{deposits.ErrInvestorTooYoung, connect.CodeFailedPrecondition, "INVESTOR_TOO_YOUNG"},
+	{deposits.ErrInvestorTooOld, connect.CodeFailedPrecondition, "INVESTOR_TOO_OLD"},
+	{deposits.ErrInvestorNotUKResident, connect.CodeFailedPrecondition, "INVESTOR_NOT_UK_RESIDENT"},
+	{deposits.ErrWrapperAlreadyHeld, connect.CodeFailedPrecondition, "WRAPPER_ALREADY_HELD"},
+	{deposits.ErrInvestorIneligible, connect.CodeFailedPrecondition, "INVESTOR_INELIGIBLE"},
+	{webhooks.ErrDeliveryPending, connect.CodeFailedPrecondition, "WEBHOOK_DELIVERY_PENDING"},
+
+	// Invalid requests
+	{deposits.ErrCurrencyMismatch, connect.CodeInvalidArgument, "CURRENCY_MISMATCH"},
+	{deposits.ErrInvalidCurrency, connect.CodeInvalidArgument, "INVALID_CURRENCY"},
+	{deposits.ErrNominalAmountNegative, connect.CodeInvalidArgument, "NOMINAL_AMOUNT_NEGATIVE"},
+	{deposits.ErrAllocatedAmountNegative, connect.CodeInvalidArgument, "ALLOCATED_AMOUNT_NEGATIVE"},
+	{deposits.ErrInvalidWrapperType, connect.CodeInvalidArgument, "INVALID_WRAPPER_TYPE"},
+	{deposits.ErrInvalidPotName, connect.CodeInvalidArgument, "INVALID_POT_NAME"},
+	{deposits.ErrInvalidIdempotencyKey, connect.CodeInvalidArgument, "INVALID_IDEMPOTENCY_KEY"},
+	{deposits.ErrInvalidAccountReference, connect.CodeInvalidArgument, "INVALID_ACCOUNT_REFERENCE"},
+	{deposits.ErrInvalidAllocatedBy, connect.CodeInvalidArgument, "INVALID_ALLOCATED_BY"},
+	{deposits.ErrSuspenseAllocationAmountEmpty, connect.CodeInvalidArgument, "SUSPENSE_ALLOCATION_AMOUNT_EMPTY"},
+	{deposits.ErrInvalidAllocationStrategy, connect.CodeInvalidArgument, "INVALID_ALLOCATION_STRATEGY"},
+	{deposits.ErrDuplicateWaterfallWrapper, connect.CodeInvalidArgument, "DUPLICATE_WATERFALL_WRAPPER_TYPE"},
+	{deposits.ErrInvalidReversalReason, connect.CodeInvalidArgument, "INVALID_REVERSAL_REASON"},
+	{deposits.ErrInvalidTaxYear, connect.CodeInvalidArgument, "INVALID_TAX_YEAR"},
+	{deposits.ErrInvalidClaimPeriod, connect.CodeInvalidArgument, "INVALID_CLAIM_PERIOD"},
+	{deposits.ErrInvalidDepositStatus, connect.CodeInvalidArgument, "INVALID_DEPOSIT_STATUS"},
+	{deposits.ErrInvalidDateRange, connect.CodeInvalidArgument, "INVALID_DATE_RANGE"},
+	{investors.ErrInvalidInvestor, connect.CodeInvalidArgument, "INVALID_INVESTOR"},
+	{investors.ErrInvalidDateOfBirth, connect.CodeInvalidArgument, "INVALID_DATE_OF_BIRTH"},
+	{investors.ErrInvalidNINumber, connect.CodeInvalidArgument, "INVALID_NI_NUMBER"},
+	{investors.ErrInvalidTaxResidency, connect.CodeInvalidArgument, "INVALID_TAX_RESIDENCY"},
+	{investors.ErrInvalidAddress, connect.CodeInvalidArgument, "INVALID_ADDRESS"},
+	{investors.ErrInvalidEmail, connect.CodeInvalidArgument, "INVALID_EMAIL"},
+	{pagination.ErrInvalidPageToken, connect.CodeInvalidArgument, "INVALID_PAGE_TOKEN"},
+	{pagination.ErrInvalidPageSize, connect.CodeInvalidArgument, "INVALID_PAGE_SIZE"},
+	{webhooks.ErrPrivateDestination, connect.CodeInvalidArgument, "WEBHOOK_URL_NOT_PUBLIC"},
+	{webhooks.ErrInvalidWebhookUrl, connect.CodeInvalidArgument, "INVALID_WEBHOOK_URL"},
+	{webhooks.ErrEventTypesRequired, connect.CodeInvalidArgument, "WEBHOOK_EVENT_TYPES_REQUIRED"},
+	{webhooks.ErrInvalidEventType, connect.CodeInvalidArgument, "INVALID_WEBHOOK_EVENT_TYPE"},
+	{webhooks.ErrInvalidWebhookSecret, connect.CodeInvalidArgument, "INVALID_WEBHOOK_SECRET"},
+	{webhooks.ErrInvalidSubscriptionId, connect.CodeInvalidArgument, "INVALID_WEBHOOK_SUBSCRIPTION_ID"},
+	{webhooks.ErrInvalidDeliveryId, connect.CodeInvalidArgument, "INVALID_WEBHOOK_DELIVERY_ID"},
+	{audit.ErrEntityIdRequired, connect.CodeInvalidArgument, "AUDIT_ENTITY_ID_REQUIRED"},
+	{ErrAmendmentMissing, connect.CodeInvalidArgument, "AMENDMENT_MISSING"},
+	{ErrFieldRequired, connect.CodeInvalidArgument, "FIELD_REQUIRED"},
+}
+
+// connectError translates an error from a service into a connect error, with an ErrorDetail giving the reason for the
+// domain errors clients can handle
+//
+// Anything else, like the database being unavailable, is an internal error
+func connectError(err error) *connect.Error {
+	translation, ok := translateError(err)
+	if !ok {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+
+	connectErr := connect.NewError(translation.code, err)
+	detail, detailErr := connect.NewErrorDetail(&depositsv1.ErrorDetail{
+		Reason: translation.reason,
+	})
+	if detailErr == nil {
+		connectErr.AddDetail(detail)
+	}
+	return connectErr
+}
+
+// translateError finds the translation for the error, false if it's not a domain error
+func translateError(err error) (errorTranslation, bool) {
+	for _, translation := range errorTranslations {
+		if errors.Is(err, translation.err) {
+			return translation, true
+		}
+	}
+
+	return errorTranslation{}, false
+}
+
+// invalidArgument is for errors parsing a request, which are invalid arguments even when they're not domain errors
+func invalidArgument(err error) *connect.Error {
+	connectErr := connectError(err)
+	if connectErr.Code() != connect.CodeInternal {
+		return connectErr
+	}
+
+	return connect.NewError(connect.CodeInvalidArgument, err)
+}
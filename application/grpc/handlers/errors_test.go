@@ -0,0 +1,105 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	depositsv1 "github.com/iainvm/deposits/application/grpc/gen/deposits/v1"
+	"github.com/iainvm/deposits/application/grpc/handlers"
+	"github.com/iainvm/deposits/internal/deposits"
+	"github.com/stretchr/testify/require"
+)
+
+// stubDepositsService fails every Get with its error
+type stubDepositsService struct {
+	handlers.DepositsService
+	err error
+}
+
+func (service stubDepositsService) Get(ctx context.Context, id deposits.DepositId) (*deposits.Deposit, error) {
+	return nil, service.err
+}
+
+func TestConnectErrors(t *testing.T) {
+	errDatabase := errors.New("connection refused")
+
+	testCases := []struct {
+		description string
+		id          string
+		err         error
+		code        connect.Code
+		reason      string
+	}{
+		{
+			description: "not found",
+			id:          uuid.NewString(),
+			err:         deposits.ErrDepositNotFound,
+			code:        connect.CodeNotFound,
+			reason:      "DEPOSIT_NOT_FOUND",
+		},
+		{
+			description: "not found from a foreign key",
+			id:          uuid.NewString(),
+			err:         errors.Join(deposits.ErrAccountNotFound, errDatabase),
+			code:        connect.CodeNotFound,
+			reason:      "ACCOUNT_NOT_FOUND",
+		},
+		{
+			description: "business rule",
+			id:          uuid.NewString(),
+			err:         deposits.ErrWrapperTypeExistsInPot,
+			code:        connect.CodeFailedPrecondition,
+			reason:      "WRAPPER_TYPE_EXISTS_IN_POT",
+		},
+		{
+			description: "most specific reason",
+			id:          uuid.NewString(),
+			err:         errors.Join(deposits.ErrNominalBelowAllocated, deposits.ErrNominalExceeded),
+			code:        connect.CodeFailedPrecondition,
+			reason:      "NOMINAL_BELOW_ALLOCATED",
+		},
+		{
+			description: "conflict",
+			id:          uuid.NewString(),
+			err:         errors.Join(deposits.ErrConcurrentModification, errDatabase),
+			code:        connect.CodeAborted,
+			reason:      "CONCURRENT_MODIFICATION",
+		},
+		{
+			description: "internal",
+			id:          uuid.NewString(),
+			err:         errDatabase,
+			code:        connect.CodeInternal,
+		},
+		{
+			description: "invalid request",
+			id:          "not a uuid",
+			code:        connect.CodeInvalidArgument,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			handler := handlers.NewDepositsHandler(slog.Default(), stubDepositsService{err: testCase.err}, &memoryAuditor{})
+
+			_, err := handler.Get(context.Background(), connect.NewRequest(&depositsv1.GetRequest{Id: testCase.id}))
+			var connectErr *connect.Error
+			require.ErrorAs(t, err, &connectErr)
+			require.Equal(t, testCase.code, connectErr.Code())
+
+			// Only domain errors have a reason
+			if testCase.reason == "" {
+				require.Empty(t, connectErr.Details())
+				return
+			}
+			require.Len(t, connectErr.Details(), 1)
+			detail, err := connectErr.Details()[0].Value()
+			require.NoError(t, err)
+			require.Equal(t, testCase.reason, detail.(*depositsv1.ErrorDetail).Reason)
+		})
+	}
+}
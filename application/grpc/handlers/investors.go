@@ -5,49 +5,250 @@ import (
 	"log/slog"
 
 	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
 
 	depositsv1 "github.com/iainvm/deposits/application/grpc/gen/deposits/v1"
+	"github.com/iainvm/deposits/application/grpc/gen/deposits/v1/depositsv1connect"
 	"github.com/iainvm/deposits/internal/investors"
 )
 
 type InvestorsService interface {
 	Onboard(ctx context.Context, investor *investors.Investor) error
+	Get(ctx context.Context, id investors.InvestorId) (*investors.Investor, error)
+	Update(ctx context.Context, id investors.InvestorId, update investors.Update) (*investors.Investor, *investors.Investor, error)
+	List(ctx context.Context, filter investors.ListFilter) (*investors.Page, error)
+	Delete(ctx context.Context, id investors.InvestorId) (*investors.Investor, error)
 }
 
 type InvestorsHandler struct {
 	log              *slog.Logger
 	investorsService InvestorsService
+	auditor          Auditor
 }
 
-func NewInvestorsHandler(log *slog.Logger, service InvestorsService) *InvestorsHandler {
+func NewInvestorsHandler(log *slog.Logger, service InvestorsService, auditor Auditor) *InvestorsHandler {
 	return &InvestorsHandler{
 		log:              log,
 		investorsService: service,
+		auditor:          auditor,
 	}
 }
 
 func (h *InvestorsHandler) Onboard(ctx context.Context, req *connect.Request[depositsv1.OnboardRequest]) (*connect.Response[depositsv1.OnboardResponse], error) {
-	h.log.With("header", req.Header()).With("request", req.Msg).Info("Onboard Called")
+	h.log.With("header", loggedHeader(req.Header())).With("request", req.Msg).Info("Onboard Called")
 
-	// Create domain model
-	investor, err := investors.NewInvestor(req.Msg.Investor.Name)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	// Create domain model, collecting every problem with the request
+	violations := &fieldViolations{}
+	investor := createDomainInvestor(req.Msg.Investor, "investor", violations)
+	if err := violations.err(); err != nil {
+		return nil, err
 	}
 
 	// Onboard
-	err = h.investorsService.Onboard(ctx, investor)
+	err := h.investorsService.Onboard(ctx, investor)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, connectError(err)
 	}
 
+	// Audit
+	recordAudit(ctx, h.log, h.auditor, req.Header(), depositsv1connect.InvestorsServiceOnboardProcedure, []string{investor.Id.String()}, nil, []proto.Message{createResponseInvestor(*investor)})
+
 	// Create response
 	res := connect.NewResponse(&depositsv1.OnboardResponse{
-		Investor: &depositsv1.Investor{
-			Id:   investor.Id.String(),
-			Name: investor.Name.String(),
-		},
+		Investor: createResponseInvestor(*investor),
 	})
 	res.Header().Set("Investor-Version", "v1")
 	return res, nil
 }
+
+func (h *InvestorsHandler) GetInvestor(ctx context.Context, req *connect.Request[depositsv1.GetInvestorRequest]) (*connect.Response[depositsv1.GetInvestorResponse], error) {
+	h.log.With("header", loggedHeader(req.Header())).With("request", req.Msg).Info("Get Investor Called")
+
+	investorId, err := investors.ParseInvestorId(req.Msg.Id)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	investor, err := h.investorsService.Get(ctx, investorId)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	// Create response
+	res := connect.NewResponse(&depositsv1.GetInvestorResponse{
+		Investor: createResponseInvestor(*investor),
+	})
+	res.Header().Set("Investor-Version", "v1")
+	return res, nil
+}
+
+func (h *InvestorsHandler) UpdateInvestor(ctx context.Context, req *connect.Request[depositsv1.UpdateInvestorRequest]) (*connect.Response[depositsv1.UpdateInvestorResponse], error) {
+	h.log.With("header", loggedHeader(req.Header())).With("request", req.Msg).Info("Update Investor Called")
+
+	investorId, err := investors.ParseInvestorId(req.Msg.Id)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	violations := &fieldViolations{}
+	update := investors.Update{
+		Name:    req.Msg.Name,
+		Profile: createDomainProfile(req.Msg.DateOfBirth, req.Msg.NiNumber, req.Msg.TaxResidency, req.Msg.Address, req.Msg.Email, "", violations),
+		Fields:  investors.UpdateFields(),
+	}
+	if paths := req.Msg.GetUpdateMask().GetPaths(); len(paths) > 0 {
+		update.Fields = []investors.UpdateField{}
+		for i, path := range paths {
+			field, err := investors.ParseUpdateField(path)
+			if err != nil {
+				violations.add(indexPath(fieldPath("update_mask", "paths"), i), err)
+				continue
+			}
+			update.Fields = append(update.Fields, field)
+		}
+	}
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	before, investor, err := h.investorsService.Update(ctx, investorId, update)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	// Audit
+	recordAudit(ctx, h.log, h.auditor, req.Header(), depositsv1connect.InvestorsServiceUpdateInvestorProcedure, []string{investorId.String()}, []proto.Message{createResponseInvestor(*before)}, []proto.Message{createResponseInvestor(*investor)})
+
+	// Create response
+	res := connect.NewResponse(&depositsv1.UpdateInvestorResponse{
+		Investor: createResponseInvestor(*investor),
+	})
+	res.Header().Set("Investor-Version", "v1")
+	return res, nil
+}
+
+func (h *InvestorsHandler) ListInvestors(ctx context.Context, req *connect.Request[depositsv1.ListInvestorsRequest]) (*connect.Response[depositsv1.ListInvestorsResponse], error) {
+	h.log.With("header", loggedHeader(req.Header())).With("request", req.Msg).Info("List Investors Called")
+
+	filter, err := investors.NewListFilter(req.Msg.NamePrefix, int(req.Msg.PageSize), req.Msg.PageToken)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	page, err := h.investorsService.List(ctx, filter)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	// Create response
+	response := &depositsv1.ListInvestorsResponse{
+		Investors: []*depositsv1.Investor{},
+	}
+	for _, investor := range page.Investors {
+		response.Investors = append(response.Investors, createResponseInvestor(*investor))
+	}
+	if page.Next != nil {
+		response.NextPageToken = page.Next.String()
+	}
+	res := connect.NewResponse(response)
+	res.Header().Set("Investor-Version", "v1")
+	return res, nil
+}
+
+func (h *InvestorsHandler) DeleteInvestor(ctx context.Context, req *connect.Request[depositsv1.DeleteInvestorRequest]) (*connect.Response[depositsv1.DeleteInvestorResponse], error) {
+	h.log.With("header", loggedHeader(req.Header())).With("request", req.Msg).Info("Delete Investor Called")
+
+	investorId, err := investors.ParseInvestorId(req.Msg.Id)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	investor, err := h.investorsService.Delete(ctx, investorId)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	// Audit
+	recordAudit(ctx, h.log, h.auditor, req.Header(), depositsv1connect.InvestorsServiceDeleteInvestorProcedure, []string{investorId.String()}, []proto.Message{createResponseInvestor(*investor)}, nil)
+
+	// Create response
+	res := connect.NewResponse(&depositsv1.DeleteInvestorResponse{})
+	res.Header().Set("Investor-Version", "v1")
+	return res, nil
+}
+
+func createDomainInvestor(reqInvestor *depositsv1.Investor, field string, violations *fieldViolations) *investors.Investor {
+	if reqInvestor == nil {
+		violations.add(field, ErrFieldRequired)
+		return nil
+	}
+
+	investor, err := investors.NewInvestor(reqInvestor.Name)
+	if err != nil {
+		violations.add(fieldPath(field, "name"), err)
+		return nil
+	}
+
+	investor.Profile = createDomainProfile(reqInvestor.DateOfBirth, reqInvestor.NiNumber, reqInvestor.TaxResidency, reqInvestor.Address, reqInvestor.Email, field, violations)
+	return investor
+}
+
+// createDomainProfile creates the parts of the profile that were given, adding any problems with them to the
+// violations
+func createDomainProfile(dateOfBirth string, niNumber string, taxResidency string, address *depositsv1.Address, email string, field string, violations *fieldViolations) investors.Profile {
+	profile := investors.Profile{}
+
+	var err error
+	if dateOfBirth != "" {
+		profile.DateOfBirth, err = investors.ParseDateOfBirth(dateOfBirth)
+		if err != nil {
+			violations.add(fieldPath(field, "date_of_birth"), err)
+		}
+	}
+	if niNumber != "" {
+		profile.NINumber, err = investors.NewNINumber(niNumber)
+		if err != nil {
+			violations.add(fieldPath(field, "ni_number"), err)
+		}
+	}
+	if taxResidency != "" {
+		profile.TaxResidency, err = investors.NewTaxResidency(taxResidency)
+		if err != nil {
+			violations.add(fieldPath(field, "tax_residency"), err)
+		}
+	}
+	if address != nil {
+		profile.Address, err = investors.NewAddress(address.Lines, address.Postcode, address.Country)
+		if err != nil {
+			violations.add(fieldPath(field, "address"), err)
+		}
+	}
+	if email != "" {
+		profile.Email, err = investors.NewEmail(email)
+		if err != nil {
+			violations.add(fieldPath(field, "email"), err)
+		}
+	}
+
+	return profile
+}
+
+func createResponseInvestor(investor investors.Investor) *depositsv1.Investor {
+	response := &depositsv1.Investor{
+		Id:           investor.Id.String(),
+		Name:         investor.Name.String(),
+		DateOfBirth:  investor.Profile.DateOfBirth.String(),
+		NiNumber:     investor.Profile.NINumber.String(),
+		TaxResidency: investor.Profile.TaxResidency.String(),
+		Email:        investor.Profile.Email.String(),
+	}
+	if !investor.Profile.Address.IsZero() {
+		response.Address = &depositsv1.Address{
+			Lines:    investor.Profile.Address.Lines,
+			Postcode: investor.Profile.Address.Postcode,
+			Country:  investor.Profile.Address.Country,
+		}
+	}
+
+	return response
+}
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	depositsv1 "github.com/iainvm/deposits/application/grpc/gen/deposits/v1"
+	"github.com/iainvm/deposits/internal/ledger"
+)
+
+type LedgerService interface {
+	TrialBalance(ctx context.Context, asOf time.Time) (*ledger.TrialBalance, error)
+}
+
+type LedgerHandler struct {
+	log           *slog.Logger
+	ledgerService LedgerService
+}
+
+func NewLedgerHandler(log *slog.Logger, service LedgerService) *LedgerHandler {
+	return &LedgerHandler{
+		log:           log,
+		ledgerService: service,
+	}
+}
+
+func (h *LedgerHandler) GetTrialBalance(ctx context.Context, req *connect.Request[depositsv1.GetTrialBalanceRequest]) (*connect.Response[depositsv1.GetTrialBalanceResponse], error) {
+	h.log.With("header", loggedHeader(req.Header())).With("request", req.Msg).Info("Get Trial Balance Called")
+
+	asOf := time.Now()
+	if req.Msg.AsOf != nil {
+		asOf = req.Msg.AsOf.AsTime()
+	}
+
+	trialBalance, err := h.ledgerService.TrialBalance(ctx, asOf)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	// Create response
+	res := connect.NewResponse(createResponseTrialBalance(*trialBalance))
+	res.Header().Set("Ledger-Version", "v1")
+	return res, nil
+}
+
+func createResponseTrialBalance(trialBalance ledger.TrialBalance) *depositsv1.GetTrialBalanceResponse {
+	lines := []*depositsv1.TrialBalanceLine{}
+	for _, line := range trialBalance.Lines {
+		lines = append(lines, &depositsv1.TrialBalanceLine{
+			Account:  line.Account.String(),
+			Currency: line.Currency,
+			Debits:   line.Debits,
+			Credits:  line.Credits,
+			Balance:  line.Balance(),
+		})
+	}
+
+	totals := []*depositsv1.TrialBalanceTotal{}
+	for _, total := range trialBalance.Totals() {
+		totals = append(totals, &depositsv1.TrialBalanceTotal{
+			Currency: total.Currency,
+			Debits:   total.Debits,
+			Credits:  total.Credits,
+		})
+	}
+
+	return &depositsv1.GetTrialBalanceResponse{
+		AsOf:     timestamppb.New(trialBalance.AsOf),
+		Lines:    lines,
+		Totals:   totals,
+		Balanced: trialBalance.Balanced(),
+	}
+}
@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	depositsv1 "github.com/iainvm/deposits/application/grpc/gen/deposits/v1"
+)
+
+var (
+	ErrFieldRequired = errors.New("field is required")
+	ErrInvalidField  = errors.New("invalid field given")
+)
+
+// fieldViolations collects every problem with a request's fields, so they're all returned together
+type fieldViolations struct {
+	violations []*depositsv1.BadRequest_FieldViolation
+}
+
+// add records the error against the field, which is its path from the request message
+func (violations *fieldViolations) add(field string, err error) {
+	reason := "INVALID_FIELD"
+	if translation, ok := translateError(err); ok {
+		reason = translation.reason
+	}
+
+	violations.violations = append(violations.violations, &depositsv1.BadRequest_FieldViolation{
+		Field:       field,
+		Reason:      reason,
+		Description: err.Error(),
+	})
+}
+
+// err returns nil if there weren't any violations, otherwise an invalid argument error with a BadRequest listing them
+func (violations *fieldViolations) err() *connect.Error {
+	return violations.errWithCode(connect.CodeInvalidArgument, fmt.Errorf("%w: %d invalid fields", ErrInvalidField, len(violations.violations)))
+}
+
+// errWithCode is err for violations that aren't invalid arguments, like the business rules a valid request fails
+func (violations *fieldViolations) errWithCode(code connect.Code, cause error) *connect.Error {
+	if len(violations.violations) == 0 {
+		return nil
+	}
+
+	connectErr := connect.NewError(code, cause)
+	detail, err := connect.NewErrorDetail(&depositsv1.BadRequest{
+		FieldViolations: violations.violations,
+	})
+	if err == nil {
+		connectErr.AddDetail(detail)
+	}
+	return connectErr
+}
+
+// fieldPath gives the path to a field of the message at the parent path
+func fieldPath(parent string, field string) string {
+	if parent == "" {
+		return field
+	}
+
+	return parent + "." + field
+}
+
+// indexPath gives the path to an element of the repeated field at the path
+func indexPath(path string, index int) string {
+	return fmt.Sprintf("%s[%d]", path, index)
+}
@@ -0,0 +1,229 @@
+package handlers_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	depositsv1 "github.com/iainvm/deposits/application/grpc/gen/deposits/v1"
+	"github.com/iainvm/deposits/application/grpc/handlers"
+	"github.com/iainvm/deposits/internal/deposits"
+	"github.com/iainvm/deposits/internal/investors"
+	"github.com/stretchr/testify/require"
+)
+
+func gbpAccount(wrapperType depositsv1.WrapperType, amount int64) *depositsv1.Account {
+	return &depositsv1.Account{
+		WrapperType:   wrapperType,
+		NominalAmount: &depositsv1.Money{Amount: amount, Currency: "GBP"},
+	}
+}
+
+func TestCreateFieldViolations(t *testing.T) {
+	testCases := []struct {
+		description string
+		req         *depositsv1.CreateRequest
+		violations  map[string]string
+	}{
+		{
+			description: "missing deposit",
+			req:         &depositsv1.CreateRequest{InvestorId: uuid.NewString()},
+			violations: map[string]string{
+				"deposit": "FIELD_REQUIRED",
+			},
+		},
+		{
+			description: "every problem",
+			req: &depositsv1.CreateRequest{
+				InvestorId: "not a uuid",
+				Deposit: &depositsv1.Deposit{
+					Pots: []*depositsv1.Pot{
+						{
+							Name: "Valid",
+							Accounts: []*depositsv1.Account{
+								gbpAccount(depositsv1.WrapperType_WRAPPER_TYPE_GIA, 100),
+							},
+						},
+						{
+							Name: "Invalid",
+							Accounts: []*depositsv1.Account{
+								gbpAccount(depositsv1.WrapperType_WRAPPER_TYPE_GIA, 100),
+								gbpAccount(depositsv1.WrapperType_WRAPPER_TYPE_GIA, 200),
+								gbpAccount(depositsv1.WrapperType_WRAPPER_TYPE_UNSPECIFIED, -100),
+								{
+									WrapperType:   depositsv1.WrapperType_WRAPPER_TYPE_SIPP,
+									NominalAmount: &depositsv1.Money{Amount: 100, Currency: "XXX"},
+								},
+							},
+						},
+					},
+				},
+			},
+			violations: map[string]string{
+				"investor_id": "INVALID_FIELD",
+				"deposit.pots[1].accounts[1].wrapper_type":            "WRAPPER_TYPE_EXISTS_IN_POT",
+				"deposit.pots[1].accounts[2].wrapper_type":            "INVALID_WRAPPER_TYPE",
+				"deposit.pots[1].accounts[2].nominal_amount.amount":   "NOMINAL_AMOUNT_NEGATIVE",
+				"deposit.pots[1].accounts[3].nominal_amount.currency": "INVALID_CURRENCY",
+			},
+		},
+		{
+			description: "accounts of a pot with an invalid name",
+			req: &depositsv1.CreateRequest{
+				InvestorId: uuid.NewString(),
+				Deposit: &depositsv1.Deposit{
+					Pots: []*depositsv1.Pot{
+						{
+							Name: "",
+							Accounts: []*depositsv1.Account{
+								gbpAccount(depositsv1.WrapperType_WRAPPER_TYPE_UNSPECIFIED, 100),
+							},
+						},
+					},
+				},
+			},
+			violations: map[string]string{
+				"deposit.pots[0].name":                     "INVALID_POT_NAME",
+				"deposit.pots[0].accounts[0].wrapper_type": "INVALID_WRAPPER_TYPE",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			handler := handlers.NewDepositsHandler(slog.Default(), stubDepositsService{}, &memoryAuditor{})
+
+			_, err := handler.Create(context.Background(), connect.NewRequest(testCase.req))
+			var connectErr *connect.Error
+			require.ErrorAs(t, err, &connectErr)
+			require.Equal(t, connect.CodeInvalidArgument, connectErr.Code())
+
+			// Every violation is returned in one BadRequest
+			require.Len(t, connectErr.Details(), 1)
+			detail, err := connectErr.Details()[0].Value()
+			require.NoError(t, err)
+			violations := map[string]string{}
+			for _, violation := range detail.(*depositsv1.BadRequest).FieldViolations {
+				require.NotEmpty(t, violation.Description)
+				violations[violation.Field] = violation.Reason
+			}
+			require.Equal(t, testCase.violations, violations)
+		})
+	}
+}
+
+// stubInvestorsService onboards every investor
+type stubInvestorsService struct {
+	handlers.InvestorsService
+}
+
+func (service stubInvestorsService) Onboard(ctx context.Context, investor *investors.Investor) error {
+	return nil
+}
+
+func TestOnboardFieldViolations(t *testing.T) {
+	handler := handlers.NewInvestorsHandler(slog.Default(), stubInvestorsService{}, &memoryAuditor{})
+
+	t.Run("every problem", func(t *testing.T) {
+		_, err := handler.Onboard(context.Background(), connect.NewRequest(&depositsv1.OnboardRequest{
+			Investor: &depositsv1.Investor{
+				Name:         "Jane",
+				DateOfBirth:  "31/01/1990",
+				NiNumber:     "GB123456C",
+				TaxResidency: "GB",
+				Address:      &depositsv1.Address{Lines: []string{"10 Downing Street"}, Country: "GB"},
+				Email:        "Jane <jane@example.com>",
+			},
+		}))
+		var connectErr *connect.Error
+		require.ErrorAs(t, err, &connectErr)
+		require.Equal(t, connect.CodeInvalidArgument, connectErr.Code())
+
+		detail, err := connectErr.Details()[0].Value()
+		require.NoError(t, err)
+		violations := map[string]string{}
+		for _, violation := range detail.(*depositsv1.BadRequest).FieldViolations {
+			violations[violation.Field] = violation.Reason
+		}
+		require.Equal(t, map[string]string{
+			"investor.date_of_birth": "INVALID_DATE_OF_BIRTH",
+			"investor.ni_number":     "INVALID_NI_NUMBER",
+			"investor.address":       "INVALID_ADDRESS",
+			"investor.email":         "INVALID_EMAIL",
+		}, violations)
+	})
+
+	t.Run("returns the profile", func(t *testing.T) {
+		res, err := handler.Onboard(context.Background(), connect.NewRequest(&depositsv1.OnboardRequest{
+			Investor: &depositsv1.Investor{
+				Name:         "Jane",
+				DateOfBirth:  "1990-01-31",
+				NiNumber:     "ab 12 34 56 c",
+				TaxResidency: "gb",
+				Address:      &depositsv1.Address{Lines: []string{"10 Downing Street", "London"}, Postcode: "SW1A 2AA", Country: "GB"},
+				Email:        "jane@example.com",
+			},
+		}))
+		require.NoError(t, err)
+
+		investor := res.Msg.Investor
+		require.Equal(t, "1990-01-31", investor.DateOfBirth)
+		require.Equal(t, "AB123456C", investor.NiNumber)
+		require.Equal(t, "GB", investor.TaxResidency)
+		require.Equal(t, "SW1A 2AA", investor.Address.Postcode)
+		require.Equal(t, "jane@example.com", investor.Email)
+	})
+}
+
+func TestCreateEligibilityViolations(t *testing.T) {
+	req := &depositsv1.CreateRequest{
+		InvestorId: uuid.NewString(),
+		Deposit: &depositsv1.Deposit{
+			Pots: []*depositsv1.Pot{
+				{Name: "Pot A", Accounts: []*depositsv1.Account{gbpAccount(depositsv1.WrapperType_WRAPPER_TYPE_GIA, 100)}},
+				{Name: "Pot B", Accounts: []*depositsv1.Account{
+					gbpAccount(depositsv1.WrapperType_WRAPPER_TYPE_GIA, 100),
+					gbpAccount(depositsv1.WrapperType_WRAPPER_TYPE_ISA, 100),
+				}},
+			},
+		},
+	}
+
+	handler := handlers.NewDepositsHandler(slog.Default(), eligibilityService{}, &memoryAuditor{})
+
+	_, err := handler.Create(context.Background(), connect.NewRequest(req))
+	var connectErr *connect.Error
+	require.ErrorAs(t, err, &connectErr)
+	require.Equal(t, connect.CodeFailedPrecondition, connectErr.Code())
+
+	detail, err := connectErr.Details()[0].Value()
+	require.NoError(t, err)
+	violations := map[string]string{}
+	for _, violation := range detail.(*depositsv1.BadRequest).FieldViolations {
+		violations[violation.Field] = violation.Reason
+	}
+	require.Equal(t, map[string]string{
+		"investor_id": "KYC_INCOMPLETE",
+		"deposit.pots[1].accounts[1].wrapper_type": "INVESTOR_TOO_YOUNG",
+	}, violations)
+}
+
+// eligibilityService fails the investor's KYC, and finds them too young for any ISA in the deposit
+type eligibilityService struct {
+	handlers.DepositsService
+}
+
+func (service eligibilityService) Create(ctx context.Context, investorId investors.InvestorId, deposit *deposits.Deposit) error {
+	failures := []deposits.EligibilityFailure{{Err: investors.ErrKYCIncomplete}}
+	for _, pot := range deposit.Pots {
+		for _, account := range pot.Accounts {
+			if account.WrapperType == deposits.WrapperTypeISA {
+				failures = append(failures, deposits.EligibilityFailure{AccountId: account.Id, Err: deposits.ErrInvestorTooYoung})
+			}
+		}
+	}
+
+	return &deposits.EligibilityError{Failures: failures}
+}
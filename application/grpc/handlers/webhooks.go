@@ -0,0 +1,173 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	depositsv1 "github.com/iainvm/deposits/application/grpc/gen/deposits/v1"
+	"github.com/iainvm/deposits/internal/webhooks"
+)
+
+type WebhooksService interface {
+	CreateSubscription(ctx context.Context, url string, eventTypes []string, secret string) (*webhooks.Subscription, error)
+	ListSubscriptions(ctx context.Context) ([]*webhooks.Subscription, error)
+	DeleteSubscription(ctx context.Context, id webhooks.SubscriptionId) error
+	ListDeadLetters(ctx context.Context, subscriptionId webhooks.SubscriptionId, limit int) ([]*webhooks.Delivery, error)
+	Redeliver(ctx context.Context, id webhooks.DeliveryId) (*webhooks.Delivery, error)
+}
+
+type WebhooksHandler struct {
+	log             *slog.Logger
+	webhooksService WebhooksService
+}
+
+func NewWebhooksHandler(log *slog.Logger, service WebhooksService) *WebhooksHandler {
+	return &WebhooksHandler{
+		log:             log,
+		webhooksService: service,
+	}
+}
+
+func (h *WebhooksHandler) CreateWebhookSubscription(ctx context.Context, req *connect.Request[depositsv1.CreateWebhookSubscriptionRequest]) (*connect.Response[depositsv1.CreateWebhookSubscriptionResponse], error) {
+	// The secret isn't logged
+	h.log.With("header", loggedHeader(req.Header())).With("url", req.Msg.Url).With("event_types", req.Msg.EventTypes).Info("Create Webhook Subscription Called")
+
+	subscription, err := h.webhooksService.CreateSubscription(ctx, req.Msg.Url, req.Msg.EventTypes, req.Msg.Secret)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	// Create response
+	res := connect.NewResponse(&depositsv1.CreateWebhookSubscriptionResponse{
+		Subscription: createResponseWebhookSubscription(*subscription),
+	})
+	res.Header().Set("Webhook-Version", "v1")
+	return res, nil
+}
+
+func (h *WebhooksHandler) ListWebhookSubscriptions(ctx context.Context, req *connect.Request[depositsv1.ListWebhookSubscriptionsRequest]) (*connect.Response[depositsv1.ListWebhookSubscriptionsResponse], error) {
+	h.log.With("header", loggedHeader(req.Header())).With("request", req.Msg).Info("List Webhook Subscriptions Called")
+
+	subscriptions, err := h.webhooksService.ListSubscriptions(ctx)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	// Create response
+	response := &depositsv1.ListWebhookSubscriptionsResponse{
+		Subscriptions: []*depositsv1.WebhookSubscription{},
+	}
+	for _, subscription := range subscriptions {
+		response.Subscriptions = append(response.Subscriptions, createResponseWebhookSubscription(*subscription))
+	}
+	res := connect.NewResponse(response)
+	res.Header().Set("Webhook-Version", "v1")
+	return res, nil
+}
+
+func (h *WebhooksHandler) DeleteWebhookSubscription(ctx context.Context, req *connect.Request[depositsv1.DeleteWebhookSubscriptionRequest]) (*connect.Response[depositsv1.DeleteWebhookSubscriptionResponse], error) {
+	h.log.With("header", loggedHeader(req.Header())).With("request", req.Msg).Info("Delete Webhook Subscription Called")
+
+	subscriptionId, err := webhooks.ParseSubscriptionId(req.Msg.Id)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	err = h.webhooksService.DeleteSubscription(ctx, subscriptionId)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	// Create response
+	res := connect.NewResponse(&depositsv1.DeleteWebhookSubscriptionResponse{})
+	res.Header().Set("Webhook-Version", "v1")
+	return res, nil
+}
+
+func (h *WebhooksHandler) ListWebhookDeadLetters(ctx context.Context, req *connect.Request[depositsv1.ListWebhookDeadLettersRequest]) (*connect.Response[depositsv1.ListWebhookDeadLettersResponse], error) {
+	h.log.With("header", loggedHeader(req.Header())).With("request", req.Msg).Info("List Webhook Dead Letters Called")
+
+	var subscriptionId webhooks.SubscriptionId
+	if req.Msg.SubscriptionId != "" {
+		var err error
+		subscriptionId, err = webhooks.ParseSubscriptionId(req.Msg.SubscriptionId)
+		if err != nil {
+			return nil, invalidArgument(err)
+		}
+	}
+
+	deliveries, err := h.webhooksService.ListDeadLetters(ctx, subscriptionId, int(req.Msg.Limit))
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	// Create response
+	response := &depositsv1.ListWebhookDeadLettersResponse{
+		Deliveries: []*depositsv1.WebhookDelivery{},
+	}
+	for _, delivery := range deliveries {
+		response.Deliveries = append(response.Deliveries, createResponseWebhookDelivery(*delivery))
+	}
+	res := connect.NewResponse(response)
+	res.Header().Set("Webhook-Version", "v1")
+	return res, nil
+}
+
+func (h *WebhooksHandler) RedeliverWebhook(ctx context.Context, req *connect.Request[depositsv1.RedeliverWebhookRequest]) (*connect.Response[depositsv1.RedeliverWebhookResponse], error) {
+	h.log.With("header", loggedHeader(req.Header())).With("request", req.Msg).Info("Redeliver Webhook Called")
+
+	deliveryId, err := webhooks.ParseDeliveryId(req.Msg.DeliveryId)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	delivery, err := h.webhooksService.Redeliver(ctx, deliveryId)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	// Create response
+	res := connect.NewResponse(&depositsv1.RedeliverWebhookResponse{
+		Delivery: createResponseWebhookDelivery(*delivery),
+	})
+	res.Header().Set("Webhook-Version", "v1")
+	return res, nil
+}
+
+// createResponseWebhookSubscription leaves out the subscription's secret
+func createResponseWebhookSubscription(subscription webhooks.Subscription) *depositsv1.WebhookSubscription {
+	return &depositsv1.WebhookSubscription{
+		Id:         subscription.Id.String(),
+		Url:        subscription.Url,
+		EventTypes: subscription.EventTypes,
+		CreatedAt:  timestamppb.New(subscription.CreatedAt),
+	}
+}
+
+// webhookDeliveryStatusPrefix is prepended to a delivery status to give its proto enum name
+const webhookDeliveryStatusPrefix = "WEBHOOK_DELIVERY_STATUS_"
+
+func createResponseWebhookDelivery(delivery webhooks.Delivery) *depositsv1.WebhookDelivery {
+	response := &depositsv1.WebhookDelivery{
+		Id:             delivery.Id.String(),
+		SubscriptionId: delivery.SubscriptionId.String(),
+		EventId:        delivery.MessageId,
+		EventType:      delivery.EventType,
+		Payload:        string(delivery.Payload),
+		Status:         depositsv1.WebhookDeliveryStatus(depositsv1.WebhookDeliveryStatus_value[webhookDeliveryStatusPrefix+delivery.Status.String()]),
+		Attempts:       int32(delivery.Attempts),
+		LastError:      delivery.LastError,
+		CreatedAt:      timestamppb.New(delivery.CreatedAt),
+	}
+	if delivery.DeliveredAt != nil {
+		response.DeliveredAt = timestamppb.New(*delivery.DeliveredAt)
+	}
+	if delivery.Status == webhooks.DeliveryStatusPending {
+		response.NextAttemptAt = timestamppb.New(delivery.NextAttemptAt)
+	}
+
+	return response
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
+	"time"
 
+	"connectrpc.com/connect"
 	"github.com/sethvargo/go-envconfig"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
@@ -14,10 +17,18 @@ import (
 	"github.com/iainvm/deposits/application/grpc/gen/deposits/v1/depositsv1connect"
 	"github.com/iainvm/deposits/application/grpc/handlers"
 	"github.com/iainvm/deposits/common/postgres"
+	"github.com/iainvm/deposits/internal/audit"
+	auditStore "github.com/iainvm/deposits/internal/audit/postgres"
 	"github.com/iainvm/deposits/internal/deposits"
 	depositsStore "github.com/iainvm/deposits/internal/deposits/postgres"
 	"github.com/iainvm/deposits/internal/investors"
 	investorsStore "github.com/iainvm/deposits/internal/investors/postgres"
+	"github.com/iainvm/deposits/internal/ledger"
+	ledgerStore "github.com/iainvm/deposits/internal/ledger/postgres"
+	"github.com/iainvm/deposits/internal/outbox"
+	outboxStore "github.com/iainvm/deposits/internal/outbox/postgres"
+	"github.com/iainvm/deposits/internal/webhooks"
+	webhooksStore "github.com/iainvm/deposits/internal/webhooks/postgres"
 )
 
 type DBConfig struct {
@@ -28,9 +39,29 @@ type DBConfig struct {
 	Name     string `env:"NAME"`
 }
 
+type OutboxConfig struct {
+	// WebhookURL is where every outbox message is published, as well as to the webhook subscriptions for it
+	WebhookURL     string        `env:"WEBHOOK_URL"`
+	WebhookTimeout time.Duration `env:"WEBHOOK_TIMEOUT, default=10s"`
+}
+
+type WebhooksConfig struct {
+	// Timeout is how long subscribers have to respond to a delivery
+	Timeout time.Duration `env:"TIMEOUT, default=10s"`
+}
+
+type AuthConfig struct {
+	// Tokens are the bearer tokens calls are authenticated with, by the actor each is given to, like
+	// "ops@example.com:<token>,payments-service:<token>", they're left out of the logged config
+	Tokens map[string]string `env:"TOKENS, required" json:"-"` // TODO: these would be pulled from a secrets vault
+}
+
 type Config struct {
-	Port     string   `env:"PORT, default=8080"`
-	DBConfig DBConfig `env:", prefix=DB_"`
+	Port           string         `env:"PORT, default=8080"`
+	AuthConfig     AuthConfig     `env:", prefix=AUTH_"`
+	DBConfig       DBConfig       `env:", prefix=DB_"`
+	OutboxConfig   OutboxConfig   `env:", prefix=OUTBOX_"`
+	WebhooksConfig WebhooksConfig `env:", prefix=WEBHOOKS_"`
 }
 
 func main() {
@@ -66,12 +97,23 @@ func main() {
 	}
 	logger.With("host", config.DBConfig.Host).With("port", config.DBConfig.Port).Info("Connected to DB")
 
+	// Audit Handler
+	auditService := audit.NewService(
+		auditStore.NewStore(db),
+	)
+	auditHandler := handlers.NewAuditHandler(
+		logger,
+		auditService,
+	)
+
 	// Investors Handler
+	investorsService := investors.NewService(
+		investorsStore.NewStore(db),
+	)
 	investorsHandler := handlers.NewInvestorsHandler(
 		logger,
-		investors.NewService(
-			investorsStore.NewStore(db),
-		),
+		investorsService,
+		auditService,
 	)
 
 	// Deposits Handler
@@ -79,14 +121,68 @@ func main() {
 		logger,
 		deposits.NewService(
 			depositsStore.NewStore(db),
+			investorsService,
+		),
+		auditService,
+	)
+
+	// Ledger Handler
+	ledgerHandler := handlers.NewLedgerHandler(
+		logger,
+		ledger.NewService(
+			ledgerStore.NewStore(db),
 		),
 	)
 
-	// Register handlers
+	// Webhooks Handler
+	webhooksService := webhooks.NewService(
+		webhooksStore.NewStore(db),
+		deposits.OutboxTopics(),
+		net.DefaultResolver,
+	)
+	webhooksHandler := handlers.NewWebhooksHandler(
+		logger,
+		webhooksService,
+	)
+
+	// Webhooks Dispatcher
+	dispatcher := webhooks.NewDispatcher(
+		logger,
+		webhooksStore.NewStore(db),
+		webhooks.NewClient(config.WebhooksConfig.Timeout),
+	)
+	go dispatcher.Run(ctx)
+
+	// Outbox Relay, publishing to the webhook subscriptions
+	publishers := outbox.Publishers{webhooksService}
+	if config.OutboxConfig.WebhookURL != "" {
+		publishers = append(publishers, outbox.NewWebhookPublisher(
+			config.OutboxConfig.WebhookURL,
+			&http.Client{Timeout: config.OutboxConfig.WebhookTimeout},
+		))
+	}
+	relay := outbox.NewRelay(
+		logger,
+		outboxStore.NewStore(db),
+		publishers,
+	)
+	go relay.Run(ctx)
+
+	// Register handlers, every call has a request id for the logs and audit log, and must be authenticated
+	interceptors := connect.WithInterceptors(
+		handlers.NewRequestIdInterceptor(),
+		handlers.NewAuthInterceptor(config.AuthConfig.Tokens),
+	)
 	mux := http.NewServeMux()
-	path, handler := depositsv1connect.NewInvestorsServiceHandler(investorsHandler)
+	path, handler := depositsv1connect.NewInvestorsServiceHandler(investorsHandler, interceptors)
+	mux.Handle(path, handler)
+	path, handler = depositsv1connect.NewDepositsServiceHandler(depositsHandler, interceptors)
+	mux.Handle(path, handler)
+	path, handler = depositsv1connect.NewLedgerServiceHandler(ledgerHandler, interceptors)
+	mux.Handle(path, handler)
+	path, handler = depositsv1connect.NewWebhooksServiceHandler(webhooksHandler, interceptors)
 	mux.Handle(path, handler)
-	path, handler = depositsv1connect.NewDepositsServiceHandler(depositsHandler)
+	path, handler = depositsv1connect.NewAuditServiceHandler(auditHandler, interceptors)
 	mux.Handle(path, handler)
 
 	// Listen
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/sethvargo/go-envconfig"
+
+	"github.com/iainvm/deposits/common/postgres"
+	"github.com/iainvm/deposits/internal/deposits"
+	depositsStore "github.com/iainvm/deposits/internal/deposits/postgres"
+	"github.com/iainvm/deposits/internal/investors"
+	investorsStore "github.com/iainvm/deposits/internal/investors/postgres"
+)
+
+type DBConfig struct {
+	Host     string `env:"HOST, default=localhost"`
+	Port     string `env:"PORT, default=5432"`
+	User     string `env:"USER, default=postgres"`
+	Password string `env:"PASSWORD, default=postgres"`
+	Name     string `env:"NAME, default=postgres"`
+}
+
+type Config struct {
+	DBConfig DBConfig `env:", prefix=DB_"`
+}
+
+// Rebuilds the balances stored on accounts from their event streams, for the account ids given as arguments or every
+// account if none are given
+func main() {
+	// Logger
+	ctx := context.Background()
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+	}))
+	slog.SetDefault(logger)
+
+	// Parse Env Vars
+	var config Config
+	err := envconfig.Process(ctx, &config)
+	if err != nil {
+		logger.With("error", err).Error("failed to parse replay configuration")
+		panic(fmt.Errorf("failed to parse replay configuration: %w", err))
+	}
+
+	// DB
+	dataSource := postgres.NewDataSource(
+		config.DBConfig.Host,
+		config.DBConfig.Port,
+		config.DBConfig.User,
+		config.DBConfig.Password,
+		config.DBConfig.Name,
+		false,
+	)
+	db, err := postgres.Connect(dataSource)
+	if err != nil {
+		logger.With("error", err).Error("failed to connect to DB")
+		panic(fmt.Errorf("failed to connect to DB: %w", err))
+	}
+	logger.With("host", config.DBConfig.Host).With("port", config.DBConfig.Port).Info("Connected to DB")
+
+	investorsService := investors.NewService(
+		investorsStore.NewStore(db),
+	)
+
+	depositsService := deposits.NewService(
+		depositsStore.NewStore(db),
+		investorsService,
+	)
+
+	// Accounts to replay
+	accountIds := []deposits.AccountId{}
+	for _, arg := range os.Args[1:] {
+		accountId, err := deposits.ParseAccountId(arg)
+		if err != nil {
+			logger.With("error", err).With("account_id", arg).Error("invalid account id")
+			panic(fmt.Errorf("invalid account id %q: %w", arg, err))
+		}
+		accountIds = append(accountIds, accountId)
+	}
+	if len(accountIds) == 0 {
+		accountIds, err = depositsService.ListAccountIds(ctx)
+		if err != nil {
+			logger.With("error", err).Error("failed to list accounts")
+			panic(fmt.Errorf("failed to list accounts: %w", err))
+		}
+	}
+
+	// Replay each account on its own, so one bad stream doesn't stop the rest
+	failed := 0
+	for _, accountId := range accountIds {
+		account, err := depositsService.ReplayAccount(ctx, accountId)
+		if err != nil {
+			failed++
+			logger.With("error", err).With("account_id", accountId).Error("failed to replay account")
+			continue
+		}
+
+		logger.
+			With("account_id", account.Id).
+			With("version", account.Version).
+			With("nominal_amount", account.NominalAmount.String()).
+			With("total_allocated_amount", account.TotalAllocatedAmount.String()).
+			With("pending_relief_amount", account.PendingReliefAmount.String()).
+			Info("Account Replayed")
+	}
+
+	logger.With("accounts", len(accountIds)).With("failed", failed).Info("Replay Finished")
+	if failed > 0 {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,59 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+var (
+	ErrInvalidPageToken = errors.New("invalid page token")
+	ErrInvalidPageSize  = errors.New("invalid page size")
+)
+
+const (
+	// DefaultPageSize is used when a list doesn't ask for a page size
+	DefaultPageSize = 50
+	// MaxPageSize is the most returned in one page, larger requests are capped to it
+	MaxPageSize = 100
+)
+
+// NewPageSize defaults a blank page size, and caps it to the MaxPageSize
+func NewPageSize(pageSize int) (int, error) {
+	if pageSize < 0 {
+		return 0, ErrInvalidPageSize
+	}
+	if pageSize == 0 {
+		return DefaultPageSize, nil
+	}
+
+	return min(pageSize, MaxPageSize), nil
+}
+
+// EncodeToken encodes the cursor as an opaque token for clients
+func EncodeToken(cursor any) string {
+	// Cursors are structs of plain values, which can't fail to marshal
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeToken decodes the opaque token given to clients back into its cursor, nil if the token is blank as that's the
+// first page
+func DecodeToken[Cursor any](token string) (*Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidPageToken, err)
+	}
+
+	cursor := new(Cursor)
+	err = json.Unmarshal(data, cursor)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidPageToken, err)
+	}
+
+	return cursor, nil
+}
@@ -0,0 +1,5 @@
+-- Receipts too big for their account are split, with the excess going to the pot's GIA or the deposit's suspense balance
+ALTER TABLE receipts ADD COLUMN overflow_of VARCHAR UNIQUE REFERENCES receipts(id);
+ALTER TABLE receipts ADD COLUMN suspense_deposit_id VARCHAR REFERENCES deposits(id);
+
+CREATE INDEX receipts_suspense_deposit_id ON receipts (suspense_deposit_id);
@@ -0,0 +1,28 @@
+-- Payments that can't be matched to an account are held as receipts without one, until ops allocate them to accounts
+ALTER TABLE receipts ADD COLUMN account_reference VARCHAR(255);
+ALTER TABLE receipts ADD COLUMN unallocated_amount BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE receipts ADD COLUMN allocated_from VARCHAR REFERENCES receipts(id);
+ALTER TABLE receipts ADD COLUMN version BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE receipts ADD COLUMN created_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+-- Overflow already held in suspense is unallocated cash
+UPDATE receipts SET unallocated_amount = allocated_amount WHERE account_id IS NULL;
+
+CREATE INDEX receipts_unallocated ON receipts (created_at) WHERE account_id IS NULL AND unallocated_amount > 0;
+
+-- Who allocated what from each unallocated receipt
+CREATE TABLE suspense_allocations (
+    id VARCHAR PRIMARY KEY,
+    unallocated_receipt_id VARCHAR NOT NULL,
+    receipt_id VARCHAR NOT NULL UNIQUE,
+    account_id VARCHAR NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    amount BIGINT NOT NULL,
+    allocated_by VARCHAR NOT NULL,
+    allocated_at TIMESTAMPTZ NOT NULL,
+    FOREIGN KEY (unallocated_receipt_id) REFERENCES receipts(id),
+    FOREIGN KEY (receipt_id) REFERENCES receipts(id),
+    FOREIGN KEY (account_id) REFERENCES accounts(id)
+);
+
+CREATE INDEX suspense_allocations_unallocated_receipt_id ON suspense_allocations (unallocated_receipt_id, allocated_at);
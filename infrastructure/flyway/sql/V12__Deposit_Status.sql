@@ -0,0 +1,22 @@
+-- Deposits move through a lifecycle, status updates use optimistic locking like accounts
+ALTER TABLE deposits ADD COLUMN status VARCHAR NOT NULL DEFAULT 'OPEN';
+ALTER TABLE deposits ADD COLUMN version BIGINT NOT NULL DEFAULT 0;
+
+-- Existing deposits are funded by what their accounts have already received
+UPDATE deposits d
+SET status = CASE
+        WHEN funding.received AND funding.funded THEN 'FUNDED'
+        WHEN funding.received THEN 'PARTIALLY_FUNDED'
+        ELSE 'OPEN'
+    END
+FROM (
+    SELECT p.deposit_id,
+        bool_or(a.total_allocated_amount + a.pending_relief_amount > 0) AS received,
+        bool_and(a.total_allocated_amount + a.pending_relief_amount >= a.nominal_amount) AS funded
+    FROM pots p
+    JOIN accounts a ON a.pot_id = p.id
+    GROUP BY p.deposit_id
+) funding
+WHERE funding.deposit_id = d.id;
+
+CREATE INDEX deposits_status ON deposits (status);
@@ -0,0 +1,8 @@
+-- Pots and accounts are read back in the order they were added, clock_timestamp keeps them apart within a transaction
+ALTER TABLE pots ADD COLUMN created_at TIMESTAMPTZ NOT NULL DEFAULT clock_timestamp();
+ALTER TABLE accounts ADD COLUMN created_at TIMESTAMPTZ NOT NULL DEFAULT clock_timestamp();
+
+-- Reading a deposit walks down from it to its receipts
+CREATE INDEX pots_deposit_id ON pots (deposit_id, created_at);
+CREATE INDEX accounts_pot_id ON accounts (pot_id, created_at);
+CREATE INDEX receipts_account_id ON receipts (account_id, created_at);
@@ -0,0 +1,5 @@
+-- Investors are listed in name order, paging on (name, id)
+CREATE INDEX investors_name ON investors (name, id);
+
+-- Investors with deposits can't be deleted
+CREATE INDEX deposits_investor_id ON deposits (investor_id);
@@ -0,0 +1,8 @@
+-- Deposits are listed newest first, paging on (created_at, id)
+-- Existing deposits all get the time of the migration, their ids still keep them in a stable order
+ALTER TABLE deposits ADD COLUMN created_at TIMESTAMPTZ NOT NULL DEFAULT clock_timestamp();
+
+-- Listing an investor's deposits replaces the plain investor index
+DROP INDEX deposits_investor_id;
+CREATE INDEX deposits_investor_id_created_at ON deposits (investor_id, created_at, id);
+CREATE INDEX deposits_created_at ON deposits (created_at, id);
@@ -0,0 +1,10 @@
+-- What's known about an investor to decide which wrappers they can hold
+-- Investors onboarded before profiles were collected have none of it
+ALTER TABLE investors
+    ADD COLUMN date_of_birth DATE,
+    ADD COLUMN ni_number VARCHAR(9),
+    ADD COLUMN tax_residency VARCHAR(2),
+    ADD COLUMN address_lines VARCHAR[],
+    ADD COLUMN address_postcode VARCHAR,
+    ADD COLUMN address_country VARCHAR(2),
+    ADD COLUMN email VARCHAR;
@@ -0,0 +1,40 @@
+-- Accounts are rebuilt from their stream of events, the balances kept on accounts are a projection of them
+CREATE TABLE account_events (
+    account_id VARCHAR NOT NULL,
+    sequence BIGINT NOT NULL,
+    type VARCHAR NOT NULL,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT clock_timestamp(),
+    PRIMARY KEY (account_id, sequence),
+    FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE CASCADE
+);
+
+-- Long streams are restored from their latest snapshot and the events after it
+CREATE TABLE account_snapshots (
+    account_id VARCHAR NOT NULL,
+    version BIGINT NOT NULL,
+    wrapper_type VARCHAR NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    nominal_amount BIGINT NOT NULL,
+    total_allocated_amount BIGINT NOT NULL,
+    pending_relief_amount BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT clock_timestamp(),
+    PRIMARY KEY (account_id, version),
+    FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE CASCADE
+);
+
+-- Existing accounts are opened with the balances they have, which starts their stream, and their versions, again from 1
+INSERT INTO account_events (account_id, sequence, type, payload)
+SELECT id,
+    1,
+    'ACCOUNT_OPENED',
+    jsonb_build_object(
+        'wrapper_type', wrapper_type,
+        'currency', currency,
+        'nominal_amount', nominal_amount,
+        'total_allocated_amount', total_allocated_amount,
+        'pending_relief_amount', pending_relief_amount
+    )
+FROM accounts;
+
+UPDATE accounts SET version = 1;
@@ -0,0 +1,42 @@
+-- Money moving through deposits is posted to a double-entry ledger, each journal's entries sum to zero in each currency
+CREATE TABLE journals (
+    id VARCHAR PRIMARY KEY,
+    reference VARCHAR NOT NULL,
+    description VARCHAR NOT NULL,
+    posted_at TIMESTAMPTZ NOT NULL
+);
+
+-- Debits are positive amounts and credits negative, in minor units
+CREATE TABLE journal_entries (
+    journal_id VARCHAR NOT NULL,
+    line INTEGER NOT NULL,
+    account VARCHAR NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount <> 0),
+    PRIMARY KEY (journal_id, line),
+    FOREIGN KEY (journal_id) REFERENCES journals(id)
+);
+
+CREATE INDEX journals_posted_at ON journals (posted_at);
+CREATE INDEX journals_reference ON journals (reference);
+
+-- Journals are checked when the transaction posting them commits, once all their entries are in
+CREATE FUNCTION check_journal_balanced() RETURNS TRIGGER AS $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM journal_entries
+        WHERE journal_id = NEW.journal_id
+        GROUP BY currency
+        HAVING SUM(amount) <> 0
+    ) THEN
+        RAISE EXCEPTION 'journal % does not balance', NEW.journal_id USING ERRCODE = 'check_violation';
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER journal_entries_balanced
+    AFTER INSERT OR UPDATE ON journal_entries
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE FUNCTION check_journal_balanced();
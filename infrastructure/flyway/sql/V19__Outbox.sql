@@ -0,0 +1,16 @@
+-- Events are saved to the outbox in the same transaction as the change they're about, and published from it by a relay
+CREATE TABLE outbox (
+    id VARCHAR PRIMARY KEY,
+    topic VARCHAR NOT NULL,
+    key VARCHAR NOT NULL,
+    payload JSONB NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL,
+    -- attempts counts the failed attempts to publish, next_attempt_at is pushed back after each one
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL,
+    last_error VARCHAR NOT NULL DEFAULT '',
+    published_at TIMESTAMPTZ
+);
+
+-- The relay only looks for messages that haven't been published
+CREATE INDEX outbox_due ON outbox (next_attempt_at) WHERE published_at IS NULL;
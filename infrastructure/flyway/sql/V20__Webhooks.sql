@@ -0,0 +1,30 @@
+-- Partners subscribe URLs to the types of event published through the outbox
+CREATE TABLE webhook_subscriptions (
+    id VARCHAR PRIMARY KEY,
+    url VARCHAR NOT NULL,
+    event_types VARCHAR[] NOT NULL,
+    -- TODO: this would be encrypted, it's needed in the clear to sign deliveries
+    secret VARCHAR NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+-- Each event published is delivered to each subscription to its type once, however many times it's published
+CREATE TABLE webhook_deliveries (
+    id VARCHAR PRIMARY KEY,
+    subscription_id VARCHAR NOT NULL,
+    message_id VARCHAR NOT NULL,
+    event_type VARCHAR NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error VARCHAR NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL,
+    delivered_at TIMESTAMPTZ,
+    next_attempt_at TIMESTAMPTZ NOT NULL,
+    UNIQUE (subscription_id, message_id),
+    FOREIGN KEY (subscription_id) REFERENCES webhook_subscriptions(id) ON DELETE CASCADE
+);
+
+-- The dispatcher only looks for pending deliveries, and the dead letters are listed newest first
+CREATE INDEX webhook_deliveries_due ON webhook_deliveries (next_attempt_at) WHERE status = 'PENDING';
+CREATE INDEX webhook_deliveries_dead ON webhook_deliveries (created_at DESC) WHERE status = 'DEAD';
@@ -0,0 +1,32 @@
+-- Every call to a mutating RPC is recorded, each entry's hash covers the one before it so changes to the log show up
+CREATE TABLE audit_log (
+    sequence BIGINT PRIMARY KEY,
+    request_id VARCHAR NOT NULL,
+    actor VARCHAR NOT NULL,
+    rpc VARCHAR NOT NULL,
+    entity_ids VARCHAR[] NOT NULL,
+    -- Snapshots are kept as text rather than JSONB, which would reformat them so they no longer match their hash
+    before TEXT NOT NULL DEFAULT '',
+    after TEXT NOT NULL DEFAULT '',
+    recorded_at TIMESTAMPTZ NOT NULL,
+    previous_hash VARCHAR NOT NULL,
+    hash VARCHAR NOT NULL UNIQUE
+);
+
+-- Entries are found from any of the entities they touched
+CREATE INDEX audit_log_entity_ids ON audit_log USING GIN (entity_ids);
+
+-- The log is append only
+CREATE FUNCTION reject_audit_log_change() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit log entries can''t be changed or removed' USING ERRCODE = 'insufficient_privilege';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_log_append_only
+    BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION reject_audit_log_change();
+
+CREATE TRIGGER audit_log_no_truncate
+    BEFORE TRUNCATE ON audit_log
+    FOR EACH STATEMENT EXECUTE FUNCTION reject_audit_log_change();
@@ -0,0 +1,7 @@
+-- When the payment arrived, which decides the tax year an ISA receipt uses allowance in
+ALTER TABLE receipts ADD COLUMN received_at TIMESTAMPTZ;
+
+-- Receipts so far were received as they were saved
+UPDATE receipts SET received_at = created_at;
+
+ALTER TABLE receipts ALTER COLUMN received_at SET NOT NULL;
@@ -0,0 +1,2 @@
+-- Allocations from unallocated receipts can be retried safely, an allocation with a key is only made once
+ALTER TABLE suspense_allocations ADD COLUMN idempotency_key VARCHAR(255) UNIQUE;
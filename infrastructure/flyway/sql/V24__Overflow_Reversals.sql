@@ -0,0 +1,2 @@
+-- Overflow held in suspense is reversed with the receipt it split from, without an account to take it off
+ALTER TABLE receipt_reversals ALTER COLUMN account_id DROP NOT NULL;
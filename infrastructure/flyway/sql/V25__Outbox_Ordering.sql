@@ -0,0 +1,5 @@
+-- The relay holds a key's messages back while an earlier one is waiting to be retried
+CREATE INDEX outbox_unpublished_keys ON outbox (key, occurred_at) WHERE published_at IS NULL;
+
+-- Published messages are purged once they're past the retention period
+CREATE INDEX outbox_published ON outbox (published_at) WHERE published_at IS NOT NULL;
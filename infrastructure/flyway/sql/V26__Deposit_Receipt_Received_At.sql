@@ -0,0 +1,12 @@
+-- When the payment for the whole deposit arrived, its child receipts are received then too
+ALTER TABLE deposit_receipts ADD COLUMN received_at TIMESTAMPTZ;
+
+-- Deposit receipts so far were received with their child receipts
+UPDATE deposit_receipts SET received_at = (
+    SELECT MIN(receipts.received_at)
+    FROM receipts
+    WHERE receipts.deposit_receipt_id = deposit_receipts.id
+);
+UPDATE deposit_receipts SET received_at = NOW() WHERE received_at IS NULL;
+
+ALTER TABLE deposit_receipts ALTER COLUMN received_at SET NOT NULL;
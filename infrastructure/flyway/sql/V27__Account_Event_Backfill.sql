@@ -0,0 +1,142 @@
+-- Streams are never deleted, removed accounts are only taken out of their pot
+ALTER TABLE account_events
+    DROP CONSTRAINT account_events_account_id_fkey,
+    ADD CONSTRAINT account_events_account_id_fkey FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE RESTRICT;
+
+ALTER TABLE account_snapshots
+    DROP CONSTRAINT account_snapshots_account_id_fkey,
+    ADD CONSTRAINT account_snapshots_account_id_fkey FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE RESTRICT;
+
+-- Accounts from before streams were opened with the balances they had, so their receipts aren't in their streams.
+-- Accounts opened since start with nothing allocated
+CREATE TEMPORARY TABLE backfilled_accounts AS
+SELECT account_id,
+    payload AS opened,
+    created_at AS opened_at
+FROM account_events
+WHERE sequence = 1
+    AND type = 'ACCOUNT_OPENED'
+    AND ((payload->>'total_allocated_amount')::BIGINT <> 0 OR (payload->>'pending_relief_amount')::BIGINT <> 0);
+
+-- They're opened with nothing allocated instead, then have their receipts, relief and reversals from before their
+-- streams started applied in the order they happened. Reversals of receipts from before receipts were timestamped come
+-- after the receipt they reverse
+CREATE TEMPORARY TABLE backfilled_events AS
+WITH history AS (
+    SELECT account_id,
+        '-infinity'::TIMESTAMPTZ AS occurred_at,
+        0 AS position,
+        'ACCOUNT_OPENED' AS type,
+        opened || jsonb_build_object('total_allocated_amount', 0, 'pending_relief_amount', 0) AS payload
+    FROM backfilled_accounts
+
+    UNION ALL
+
+    -- Receipts reserve the relief claimed on them, relief paid for a claim is its own receipt
+    SELECT r.account_id,
+        r.created_at,
+        1,
+        CASE WHEN paid.id IS NULL THEN 'RECEIPT_ALLOCATED' ELSE 'RELIEF_APPLIED' END,
+        CASE
+            WHEN paid.id IS NULL THEN jsonb_build_object(
+                'currency', r.currency,
+                'receipt_id', r.id,
+                'amount', r.allocated_amount,
+                'relief', COALESCE(claim.relief_amount, 0)
+            )
+            ELSE jsonb_build_object(
+                'currency', r.currency,
+                'receipt_id', r.id,
+                'amount', r.allocated_amount
+            )
+        END
+    FROM receipts r
+    JOIN backfilled_accounts b ON b.account_id = r.account_id
+    LEFT JOIN relief_claims claim ON claim.receipt_id = r.id
+    LEFT JOIN relief_claims paid ON paid.relief_receipt_id = r.id
+    WHERE r.created_at < b.opened_at
+
+    UNION ALL
+
+    SELECT v.account_id,
+        GREATEST(v.created_at, r.created_at),
+        2,
+        'RECEIPT_REVERSED',
+        jsonb_build_object(
+            'currency', v.currency,
+            'receipt_id', v.receipt_id,
+            'amount', v.amount
+        )
+    FROM receipt_reversals v
+    JOIN backfilled_accounts b ON b.account_id = v.account_id
+    JOIN receipts r ON r.id = v.receipt_id
+    WHERE v.created_at < b.opened_at
+
+    UNION ALL
+
+    -- Reversing a receipt cancels the claim for its relief
+    SELECT v.account_id,
+        GREATEST(v.created_at, r.created_at),
+        3,
+        'RELIEF_RELEASED',
+        jsonb_build_object(
+            'currency', claim.currency,
+            'amount', claim.relief_amount
+        )
+    FROM receipt_reversals v
+    JOIN backfilled_accounts b ON b.account_id = v.account_id
+    JOIN receipts r ON r.id = v.receipt_id
+    JOIN relief_claims claim ON claim.receipt_id = v.receipt_id
+    WHERE v.created_at < b.opened_at
+        AND claim.status = 'CANCELLED'
+)
+SELECT account_id,
+    ROW_NUMBER() OVER (PARTITION BY account_id ORDER BY occurred_at, payload->>'receipt_id', position) AS sequence,
+    type,
+    payload
+FROM history;
+
+-- The events since the stream started follow the history, they're moved out of the way first so no two share a
+-- sequence while they're renumbered
+UPDATE account_events e
+SET sequence = -e.sequence
+FROM backfilled_accounts b
+WHERE e.account_id = b.account_id
+    AND e.sequence > 1;
+
+DELETE FROM account_events e
+USING backfilled_accounts b
+WHERE e.account_id = b.account_id
+    AND e.sequence = 1;
+
+INSERT INTO account_events (account_id, sequence, type, payload, created_at)
+SELECT h.account_id, h.sequence, h.type, h.payload, b.opened_at
+FROM backfilled_events h
+JOIN backfilled_accounts b ON b.account_id = h.account_id;
+
+UPDATE account_events e
+SET sequence = h.length - e.sequence - 1
+FROM (
+    SELECT account_id, COUNT(*) AS length
+    FROM backfilled_events
+    GROUP BY account_id
+) h
+WHERE e.account_id = h.account_id
+    AND e.sequence < 0;
+
+-- Snapshots of the old streams are at versions that have moved, they're taken again as the accounts are replayed
+DELETE FROM account_snapshots s
+USING backfilled_accounts b
+WHERE s.account_id = b.account_id;
+
+UPDATE accounts a
+SET version = (
+    SELECT MAX(e.sequence)
+    FROM account_events e
+    WHERE e.account_id = a.id
+)
+FROM backfilled_accounts b
+WHERE a.id = b.account_id;
+
+DROP TABLE backfilled_events;
+DROP TABLE backfilled_accounts;
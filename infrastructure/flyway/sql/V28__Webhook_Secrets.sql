@@ -0,0 +1,2 @@
+-- Secrets are kept as they're given, as every delivery's signed with them, and never returned by the API
+COMMENT ON COLUMN webhook_subscriptions.secret IS 'Kept as it''s given, as every delivery''s signed with it, and never returned by the API';
@@ -0,0 +1,8 @@
+-- Wrapper types are stored by their registered policy code rather than enum number
+ALTER TABLE accounts
+    ALTER COLUMN wrapper_type TYPE VARCHAR
+    USING CASE wrapper_type
+        WHEN 1 THEN 'GIA'
+        WHEN 2 THEN 'ISA'
+        WHEN 3 THEN 'SIPP'
+    END;
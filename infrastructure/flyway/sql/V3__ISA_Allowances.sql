@@ -0,0 +1,12 @@
+CREATE TABLE isa_subscriptions (
+    receipt_id VARCHAR PRIMARY KEY,
+    investor_id VARCHAR,
+    account_id VARCHAR,
+    tax_year INTEGER,
+    amount INTEGER,
+    FOREIGN KEY (receipt_id) REFERENCES receipts(id),
+    FOREIGN KEY (investor_id) REFERENCES investors(id),
+    FOREIGN KEY (account_id) REFERENCES accounts(id)
+);
+
+CREATE INDEX isa_subscriptions_investor_tax_year ON isa_subscriptions (investor_id, tax_year);
@@ -0,0 +1,29 @@
+ALTER TABLE accounts ADD COLUMN pending_relief_amount INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE relief_claim_batches (
+    id VARCHAR PRIMARY KEY,
+    period DATE,
+    status VARCHAR,
+    created_at TIMESTAMPTZ
+);
+
+CREATE TABLE relief_claims (
+    id VARCHAR PRIMARY KEY,
+    investor_id VARCHAR,
+    account_id VARCHAR,
+    receipt_id VARCHAR,
+    net_amount INTEGER,
+    relief_amount INTEGER,
+    status VARCHAR,
+    batch_id VARCHAR,
+    relief_receipt_id VARCHAR,
+    created_at TIMESTAMPTZ,
+    FOREIGN KEY (investor_id) REFERENCES investors(id),
+    FOREIGN KEY (account_id) REFERENCES accounts(id),
+    FOREIGN KEY (receipt_id) REFERENCES receipts(id),
+    FOREIGN KEY (batch_id) REFERENCES relief_claim_batches(id),
+    FOREIGN KEY (relief_receipt_id) REFERENCES receipts(id)
+);
+
+CREATE INDEX relief_claims_status_created_at ON relief_claims (status, created_at);
+CREATE INDEX relief_claims_batch_id ON relief_claims (batch_id);
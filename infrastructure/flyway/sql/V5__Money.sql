@@ -0,0 +1,19 @@
+-- Amounts are in the minor units of their currency, e.g. pence for GBP
+ALTER TABLE accounts
+    ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT 'GBP',
+    ALTER COLUMN nominal_amount TYPE BIGINT,
+    ALTER COLUMN total_allocated_amount TYPE BIGINT,
+    ALTER COLUMN pending_relief_amount TYPE BIGINT;
+
+ALTER TABLE receipts
+    ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT 'GBP',
+    ALTER COLUMN allocated_amount TYPE BIGINT;
+
+ALTER TABLE isa_subscriptions
+    ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT 'GBP',
+    ALTER COLUMN amount TYPE BIGINT;
+
+ALTER TABLE relief_claims
+    ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT 'GBP',
+    ALTER COLUMN net_amount TYPE BIGINT,
+    ALTER COLUMN relief_amount TYPE BIGINT;
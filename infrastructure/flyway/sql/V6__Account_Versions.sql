@@ -0,0 +1,2 @@
+-- Accounts are updated with optimistic locking, each update bumps the version it was read at
+ALTER TABLE accounts ADD COLUMN version BIGINT NOT NULL DEFAULT 0;
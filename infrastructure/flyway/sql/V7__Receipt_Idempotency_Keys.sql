@@ -0,0 +1,2 @@
+-- Payments redelivered with the same key are only received once
+ALTER TABLE receipts ADD COLUMN idempotency_key VARCHAR(255) UNIQUE;
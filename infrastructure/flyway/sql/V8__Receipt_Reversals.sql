@@ -0,0 +1,15 @@
+-- Each receipt can be reversed once, when its payment bounces or is recalled
+CREATE TABLE receipt_reversals (
+    id VARCHAR PRIMARY KEY,
+    receipt_id VARCHAR NOT NULL UNIQUE,
+    account_id VARCHAR NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    amount BIGINT NOT NULL,
+    reason VARCHAR NOT NULL,
+    idempotency_key VARCHAR(255) UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL,
+    FOREIGN KEY (receipt_id) REFERENCES receipts(id),
+    FOREIGN KEY (account_id) REFERENCES accounts(id)
+);
+
+CREATE INDEX relief_claims_receipt_id ON relief_claims (receipt_id);
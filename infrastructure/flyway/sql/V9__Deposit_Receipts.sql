@@ -0,0 +1,13 @@
+-- Payments for a whole deposit, split into a child receipt for each account
+CREATE TABLE deposit_receipts (
+    id VARCHAR PRIMARY KEY,
+    deposit_id VARCHAR NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    allocated_amount BIGINT NOT NULL,
+    idempotency_key VARCHAR(255) UNIQUE,
+    FOREIGN KEY (deposit_id) REFERENCES deposits(id)
+);
+
+ALTER TABLE receipts ADD COLUMN deposit_receipt_id VARCHAR REFERENCES deposit_receipts(id);
+
+CREATE INDEX receipts_deposit_receipt_id ON receipts (deposit_receipt_id);
@@ -0,0 +1,47 @@
+package audit
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrEntryModified is an entry that's been changed since it was recorded, it doesn't match its hash
+	ErrEntryModified = errors.New("audit entry doesn't match its hash")
+	// ErrChainBroken is an entry that doesn't follow on from the one before it, which has been changed or removed
+	ErrChainBroken = errors.New("audit entry doesn't follow the entry before it")
+)
+
+// ChainError is where the chain of entries is broken
+type ChainError struct {
+	Sequence int64
+	Err      error
+}
+
+func (err *ChainError) Error() string {
+	return fmt.Sprintf("audit log broken at entry %d: %s", err.Sequence, err.Err)
+}
+
+func (err *ChainError) Unwrap() error {
+	return err.Err
+}
+
+// VerifyChain checks each entry follows on from the one before, starting from previous, or from the start of the log
+// if it's nil, returning a ChainError for the first that doesn't
+func VerifyChain(previous *Entry, entries []*Entry) error {
+	for _, entry := range entries {
+		expected := Entry{}
+		expected.Chain(previous)
+		if entry.Sequence != expected.Sequence || entry.PreviousHash != expected.PreviousHash {
+			return &ChainError{Sequence: entry.Sequence, Err: ErrChainBroken}
+		}
+
+		if entry.Hash != entry.ComputeHash() {
+			return &ChainError{Sequence: entry.Sequence, Err: ErrEntryModified}
+		}
+
+		previous = entry
+	}
+
+	return nil
+}
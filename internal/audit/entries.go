@@ -0,0 +1,116 @@
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"slices"
+	"time"
+)
+
+var (
+	ErrRpcRequired       = errors.New("audit entry needs the rpc called")
+	ErrEntityIdsRequired = errors.New("audit entry needs at least one entity id")
+	ErrEntityIdRequired  = errors.New("entity id is required to list audit entries")
+)
+
+// AnonymousActor is recorded for calls that don't say who made them
+const AnonymousActor = "anonymous"
+
+// Entry records a call to a mutating RPC, what it changed, and who made it
+//
+// Entries are chained, each one's Hash covers the one before it, so changing or removing any entry breaks the chain
+// from there on
+type Entry struct {
+	// Sequence is the entry's position in the log, starting from 1
+	Sequence  int64
+	RequestId string
+	Actor     string
+	// Rpc is the procedure called, like /deposits.v1.DepositsService/Create
+	Rpc string
+	// EntityIds are the ids of everything the call touched, so the entry can be found from any of them
+	EntityIds []string
+	// Before and After are JSON snapshots of the entity the call changed, Before is empty for entities it created
+	Before     []byte
+	After      []byte
+	RecordedAt time.Time
+	// PreviousHash is the Hash of the entry before, empty for the first
+	PreviousHash string
+	Hash         string
+}
+
+// NewEntry creates an Entry of a call, it's chained once it's appended to the log
+func NewEntry(requestId string, actor string, rpc string, entityIds []string, before []byte, after []byte, recordedAt time.Time) (*Entry, error) {
+	if rpc == "" {
+		return nil, ErrRpcRequired
+	}
+
+	if len(entityIds) == 0 {
+		return nil, ErrEntityIdsRequired
+	}
+
+	if actor == "" {
+		actor = AnonymousActor
+	}
+
+	// Each id is only recorded once however many times it's given
+	entityIds = slices.Clone(entityIds)
+	slices.Sort(entityIds)
+
+	return &Entry{
+		RequestId: requestId,
+		Actor:     actor,
+		Rpc:       rpc,
+		EntityIds: slices.Compact(entityIds),
+		Before:    before,
+		After:     after,
+		// Postgres keeps microseconds, the entry must hash the same once it's read back
+		RecordedAt: recordedAt.UTC().Truncate(time.Microsecond),
+	}, nil
+}
+
+// Chain links the entry onto the previous one in the log, or starts the log if there isn't one, setting its Sequence
+// and hashes
+func (entry *Entry) Chain(previous *Entry) {
+	entry.Sequence = 1
+	entry.PreviousHash = ""
+	if previous != nil {
+		entry.Sequence = previous.Sequence + 1
+		entry.PreviousHash = previous.Hash
+	}
+
+	entry.Hash = entry.ComputeHash()
+}
+
+// hashedEntry is what an entry's hash is computed from, every field but the hash itself
+type hashedEntry struct {
+	Sequence     int64    `json:"sequence"`
+	PreviousHash string   `json:"previous_hash"`
+	RequestId    string   `json:"request_id"`
+	Actor        string   `json:"actor"`
+	Rpc          string   `json:"rpc"`
+	EntityIds    []string `json:"entity_ids"`
+	Before       string   `json:"before"`
+	After        string   `json:"after"`
+	RecordedAt   string   `json:"recorded_at"`
+}
+
+// ComputeHash is the hex encoded SHA-256 of the entry's fields, including the hash of the entry before it, as JSON
+func (entry Entry) ComputeHash() string {
+	// Marshalling a struct always gives the same JSON, and none of its fields can fail to marshal
+	fields, _ := json.Marshal(hashedEntry{
+		Sequence:     entry.Sequence,
+		PreviousHash: entry.PreviousHash,
+		RequestId:    entry.RequestId,
+		Actor:        entry.Actor,
+		Rpc:          entry.Rpc,
+		EntityIds:    entry.EntityIds,
+		Before:       string(entry.Before),
+		After:        string(entry.After),
+		RecordedAt:   entry.RecordedAt.UTC().Format(time.RFC3339Nano),
+	})
+
+	sum := sha256.Sum256(fields)
+	return hex.EncodeToString(sum[:])
+}
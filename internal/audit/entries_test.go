@@ -0,0 +1,118 @@
+package audit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iainvm/deposits/internal/audit"
+	"github.com/stretchr/testify/require"
+)
+
+const rpc = "/deposits.v1.DepositsService/ReceiveReceipt"
+
+func newEntry(t *testing.T, entityIds ...string) *audit.Entry {
+	t.Helper()
+
+	entry, err := audit.NewEntry("request-id", "ops@example.com", rpc, entityIds, []byte(`{"total":100}`), []byte(`{"total":150}`), time.Now())
+	require.NoError(t, err)
+
+	return entry
+}
+
+func TestNewEntry(t *testing.T) {
+	t.Run("needs the rpc", func(t *testing.T) {
+		_, err := audit.NewEntry("request-id", "ops@example.com", "", []string{"account-id"}, nil, nil, time.Now())
+		require.ErrorIs(t, err, audit.ErrRpcRequired)
+	})
+
+	t.Run("needs an entity id", func(t *testing.T) {
+		_, err := audit.NewEntry("request-id", "ops@example.com", rpc, nil, nil, nil, time.Now())
+		require.ErrorIs(t, err, audit.ErrEntityIdsRequired)
+	})
+
+	t.Run("records each entity once", func(t *testing.T) {
+		entry := newEntry(t, "receipt-id", "account-id", "receipt-id")
+		require.Equal(t, []string{"account-id", "receipt-id"}, entry.EntityIds)
+	})
+
+	t.Run("calls without an actor are anonymous", func(t *testing.T) {
+		entry, err := audit.NewEntry("request-id", "", rpc, []string{"account-id"}, nil, nil, time.Now())
+		require.NoError(t, err)
+		require.Equal(t, audit.AnonymousActor, entry.Actor)
+	})
+
+	t.Run("hashes the same once it's been stored", func(t *testing.T) {
+		recordedAt := time.Date(2024, 4, 6, 9, 0, 0, 123456789, time.FixedZone("BST", 60*60))
+		entry, err := audit.NewEntry("request-id", "ops@example.com", rpc, []string{"account-id"}, nil, nil, recordedAt)
+		require.NoError(t, err)
+		entry.Chain(nil)
+
+		// Postgres keeps microseconds, and gives times back in its own zone
+		stored := *entry
+		stored.RecordedAt = recordedAt.Truncate(time.Microsecond).In(time.Local)
+		require.Equal(t, entry.Hash, stored.ComputeHash())
+	})
+}
+
+func TestVerifyChain(t *testing.T) {
+	newChain := func(t *testing.T) []*audit.Entry {
+		entries := []*audit.Entry{}
+		var previous *audit.Entry
+		for range 3 {
+			entry := newEntry(t, "account-id")
+			entry.Chain(previous)
+			entries = append(entries, entry)
+			previous = entry
+		}
+
+		return entries
+	}
+
+	t.Run("chained entries", func(t *testing.T) {
+		entries := newChain(t)
+		require.Equal(t, int64(1), entries[0].Sequence)
+		require.Empty(t, entries[0].PreviousHash)
+		require.Equal(t, int64(3), entries[2].Sequence)
+		require.Equal(t, entries[1].Hash, entries[2].PreviousHash)
+
+		err := audit.VerifyChain(nil, entries)
+		require.NoError(t, err)
+
+		// Verified from part way through
+		err = audit.VerifyChain(entries[0], entries[1:])
+		require.NoError(t, err)
+	})
+
+	t.Run("changed entry", func(t *testing.T) {
+		entries := newChain(t)
+		entries[1].After = []byte(`{"total":1000}`)
+
+		err := audit.VerifyChain(nil, entries)
+		var chainErr *audit.ChainError
+		require.ErrorAs(t, err, &chainErr)
+		require.ErrorIs(t, err, audit.ErrEntryModified)
+		require.Equal(t, int64(2), chainErr.Sequence)
+	})
+
+	t.Run("changed entry with its hash recomputed", func(t *testing.T) {
+		entries := newChain(t)
+		entries[1].Actor = "someone-else"
+		entries[1].Hash = entries[1].ComputeHash()
+
+		err := audit.VerifyChain(nil, entries)
+		var chainErr *audit.ChainError
+		require.ErrorAs(t, err, &chainErr)
+		require.ErrorIs(t, err, audit.ErrChainBroken)
+		require.Equal(t, int64(3), chainErr.Sequence)
+	})
+
+	t.Run("removed entry", func(t *testing.T) {
+		entries := newChain(t)
+
+		err := audit.VerifyChain(nil, []*audit.Entry{entries[0], entries[2]})
+		var chainErr *audit.ChainError
+		require.ErrorAs(t, err, &chainErr)
+		require.ErrorIs(t, err, audit.ErrChainBroken)
+		require.Equal(t, int64(3), chainErr.Sequence)
+	})
+}
@@ -0,0 +1,181 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/iainvm/deposits/internal/audit"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+var (
+	ErrSaveFailed        = errors.New("failed to save audit entry")
+	ErrTransactionFailed = errors.New("failed to run transaction")
+)
+
+type Store struct {
+	db *sqlx.DB
+}
+
+func NewStore(db *sqlx.DB) Store {
+	return Store{
+		db: db,
+	}
+}
+
+type EntryRow struct {
+	Sequence     int64          `db:"sequence"`
+	RequestId    string         `db:"request_id"`
+	Actor        string         `db:"actor"`
+	Rpc          string         `db:"rpc"`
+	EntityIds    pq.StringArray `db:"entity_ids"`
+	Before       string         `db:"before"`
+	After        string         `db:"after"`
+	RecordedAt   time.Time      `db:"recorded_at"`
+	PreviousHash string         `db:"previous_hash"`
+	Hash         string         `db:"hash"`
+}
+
+// AppendEntry locks the log against other appends until the entry is saved, so each entry is chained onto the one saved
+// before it, reads aren't blocked
+func (store Store) AppendEntry(ctx context.Context, entry *audit.Entry) error {
+	// Define query separately for easy editting
+	const lockQuery = `--sql
+	LOCK TABLE audit_log IN EXCLUSIVE MODE
+	`
+	const lastQuery = `--sql
+	SELECT sequence, request_id, actor, rpc, entity_ids, before, after, recorded_at, previous_hash, hash
+	FROM audit_log
+	ORDER BY sequence DESC
+	LIMIT 1
+	`
+	const insertQuery = `--sql
+	INSERT INTO audit_log (sequence, request_id, actor, rpc, entity_ids, before, after, recorded_at, previous_hash, hash)
+	VALUES (:sequence, :request_id, :actor, :rpc, :entity_ids, :before, :after, :recorded_at, :previous_hash, :hash)
+	`
+
+	tx, err := store.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Join(ErrTransactionFailed, err)
+	}
+	// Rolling back after a commit does nothing, this covers failures and panics
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, lockQuery)
+	if err != nil {
+		return errors.Join(ErrSaveFailed, err)
+	}
+
+	// Chain onto the last entry, if there is one
+	var previous *audit.Entry
+	last := EntryRow{}
+	err = tx.GetContext(ctx, &last, lastQuery)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+	case err != nil:
+		return errors.Join(ErrSaveFailed, err)
+	default:
+		previous = createDomainEntry(last)
+	}
+	entry.Chain(previous)
+
+	// Create Row
+	row := EntryRow{
+		Sequence:     entry.Sequence,
+		RequestId:    entry.RequestId,
+		Actor:        entry.Actor,
+		Rpc:          entry.Rpc,
+		EntityIds:    pq.StringArray(entry.EntityIds),
+		Before:       string(entry.Before),
+		After:        string(entry.After),
+		RecordedAt:   entry.RecordedAt,
+		PreviousHash: entry.PreviousHash,
+		Hash:         entry.Hash,
+	}
+
+	// Execute query
+	_, err = tx.NamedExecContext(
+		ctx,
+		insertQuery,
+		row,
+	)
+	if err != nil {
+		return errors.Join(ErrSaveFailed, err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errors.Join(ErrTransactionFailed, err)
+	}
+
+	return nil
+}
+
+func (store Store) ListEntriesForEntity(ctx context.Context, entityId string, limit int) ([]*audit.Entry, error) {
+	const query = `--sql
+	SELECT sequence, request_id, actor, rpc, entity_ids, before, after, recorded_at, previous_hash, hash
+	FROM audit_log
+	WHERE entity_ids @> ARRAY[$1]::VARCHAR[]
+	ORDER BY sequence DESC
+	LIMIT $2
+	`
+
+	rows := []EntryRow{}
+	err := store.db.SelectContext(ctx, &rows, query, entityId, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return createDomainEntries(rows), nil
+}
+
+func (store Store) ListEntries(ctx context.Context, afterSequence int64, limit int) ([]*audit.Entry, error) {
+	const query = `--sql
+	SELECT sequence, request_id, actor, rpc, entity_ids, before, after, recorded_at, previous_hash, hash
+	FROM audit_log
+	WHERE sequence > $1
+	ORDER BY sequence
+	LIMIT $2
+	`
+
+	rows := []EntryRow{}
+	err := store.db.SelectContext(ctx, &rows, query, afterSequence, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return createDomainEntries(rows), nil
+}
+
+func createDomainEntries(rows []EntryRow) []*audit.Entry {
+	entries := []*audit.Entry{}
+	for _, row := range rows {
+		entries = append(entries, createDomainEntry(row))
+	}
+
+	return entries
+}
+
+func createDomainEntry(row EntryRow) *audit.Entry {
+	entry := &audit.Entry{
+		Sequence:     row.Sequence,
+		RequestId:    row.RequestId,
+		Actor:        row.Actor,
+		Rpc:          row.Rpc,
+		EntityIds:    []string(row.EntityIds),
+		RecordedAt:   row.RecordedAt,
+		PreviousHash: row.PreviousHash,
+		Hash:         row.Hash,
+	}
+	if row.Before != "" {
+		entry.Before = []byte(row.Before)
+	}
+	if row.After != "" {
+		entry.After = []byte(row.After)
+	}
+
+	return entry
+}
@@ -0,0 +1,85 @@
+package audit
+
+import (
+	"context"
+)
+
+const (
+	// DefaultEntryLimit is how many entries are listed when no limit is given
+	DefaultEntryLimit = 50
+	// MaxEntryLimit is the most entries listed at once
+	MaxEntryLimit = 100
+	// VerifyBatchSize is how many entries are read at a time verifying the log
+	VerifyBatchSize = 1000
+)
+
+type Repository interface {
+	// AppendEntry chains the entry onto the last one in the log and saves it, entries are appended one at a time so the
+	// chain doesn't fork
+	AppendEntry(ctx context.Context, entry *Entry) error
+	// ListEntriesForEntity returns up to limit of the entries recorded against the entity, newest first
+	ListEntriesForEntity(ctx context.Context, entityId string, limit int) ([]*Entry, error)
+	// ListEntries returns up to limit entries after the sequence, oldest first
+	ListEntries(ctx context.Context, afterSequence int64, limit int) ([]*Entry, error)
+}
+
+type Service struct {
+	repository Repository
+}
+
+func NewService(repository Repository) *Service {
+	return &Service{
+		repository: repository,
+	}
+}
+
+// Record appends the entry to the log, chaining it onto the last entry
+func (service *Service) Record(ctx context.Context, entry *Entry) error {
+	return service.repository.AppendEntry(ctx, entry)
+}
+
+// ListEntries returns the most recent entries recorded against the entity
+func (service *Service) ListEntries(ctx context.Context, entityId string, limit int) ([]*Entry, error) {
+	if entityId == "" {
+		return nil, ErrEntityIdRequired
+	}
+
+	if limit <= 0 {
+		limit = DefaultEntryLimit
+	}
+	limit = min(limit, MaxEntryLimit)
+
+	return service.repository.ListEntriesForEntity(ctx, entityId, limit)
+}
+
+// Verify walks the whole log checking its chain, returning how many entries were verified, and a ChainError for the
+// first entry that's been changed, or that follows one that has been removed
+func (service *Service) Verify(ctx context.Context) (int64, error) {
+	var verified int64
+	var previous *Entry
+	for {
+		var afterSequence int64
+		if previous != nil {
+			afterSequence = previous.Sequence
+		}
+
+		entries, err := service.repository.ListEntries(ctx, afterSequence, VerifyBatchSize)
+		if err != nil {
+			return verified, err
+		}
+
+		for _, entry := range entries {
+			err = VerifyChain(previous, []*Entry{entry})
+			if err != nil {
+				return verified, err
+			}
+
+			verified++
+			previous = entry
+		}
+
+		if len(entries) < VerifyBatchSize {
+			return verified, nil
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package audit_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/iainvm/deposits/internal/audit"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryRepository is an in memory audit.Repository
+type memoryRepository struct {
+	entries []*audit.Entry
+}
+
+func (repository *memoryRepository) AppendEntry(ctx context.Context, entry *audit.Entry) error {
+	var previous *audit.Entry
+	if len(repository.entries) > 0 {
+		previous = repository.entries[len(repository.entries)-1]
+	}
+
+	entry.Chain(previous)
+	repository.entries = append(repository.entries, entry)
+	return nil
+}
+
+func (repository *memoryRepository) ListEntriesForEntity(ctx context.Context, entityId string, limit int) ([]*audit.Entry, error) {
+	entries := []*audit.Entry{}
+	for i := len(repository.entries) - 1; i >= 0 && len(entries) < limit; i-- {
+		if slices.Contains(repository.entries[i].EntityIds, entityId) {
+			entries = append(entries, repository.entries[i])
+		}
+	}
+
+	return entries, nil
+}
+
+func (repository *memoryRepository) ListEntries(ctx context.Context, afterSequence int64, limit int) ([]*audit.Entry, error) {
+	entries := []*audit.Entry{}
+	for _, entry := range repository.entries {
+		if entry.Sequence > afterSequence && len(entries) < limit {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries, nil
+}
+
+func TestServiceListEntries(t *testing.T) {
+	repository := &memoryRepository{}
+	service := audit.NewService(repository)
+
+	require.NoError(t, service.Record(context.Background(), newEntry(t, "investor-id", "deposit-id", "account-id")))
+	require.NoError(t, service.Record(context.Background(), newEntry(t, "account-id", "receipt-1")))
+	require.NoError(t, service.Record(context.Background(), newEntry(t, "other-account-id", "receipt-2")))
+	require.NoError(t, service.Record(context.Background(), newEntry(t, "account-id", "receipt-3")))
+
+	t.Run("entries for the entity, newest first", func(t *testing.T) {
+		entries, err := service.ListEntries(context.Background(), "account-id", 0)
+		require.NoError(t, err)
+		require.Len(t, entries, 3)
+		require.Equal(t, int64(4), entries[0].Sequence)
+		require.Equal(t, int64(2), entries[1].Sequence)
+		require.Equal(t, int64(1), entries[2].Sequence)
+	})
+
+	t.Run("limited", func(t *testing.T) {
+		entries, err := service.ListEntries(context.Background(), "account-id", 1)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, int64(4), entries[0].Sequence)
+	})
+
+	t.Run("needs an entity", func(t *testing.T) {
+		_, err := service.ListEntries(context.Background(), "", 0)
+		require.ErrorIs(t, err, audit.ErrEntityIdRequired)
+	})
+}
+
+func TestServiceVerify(t *testing.T) {
+	// More than a batch, so the chain is followed from one batch to the next
+	repository := &memoryRepository{}
+	service := audit.NewService(repository)
+	for range audit.VerifyBatchSize + 1 {
+		require.NoError(t, service.Record(context.Background(), newEntry(t, "account-id")))
+	}
+
+	verified, err := service.Verify(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(audit.VerifyBatchSize+1), verified)
+
+	// Removing the first entry of the second batch breaks the chain at the one after it
+	require.NoError(t, service.Record(context.Background(), newEntry(t, "account-id")))
+	repository.entries = slices.Delete(repository.entries, audit.VerifyBatchSize, audit.VerifyBatchSize+1)
+
+	verified, err = service.Verify(context.Background())
+	var chainErr *audit.ChainError
+	require.ErrorAs(t, err, &chainErr)
+	require.ErrorIs(t, err, audit.ErrChainBroken)
+	require.Equal(t, int64(audit.VerifyBatchSize+2), chainErr.Sequence)
+	require.Equal(t, int64(audit.VerifyBatchSize), verified)
+}
@@ -2,22 +2,20 @@ package deposits
 
 import (
 	"errors"
+	"slices"
 
 	"github.com/google/uuid"
 )
 
-type WrapperType int
-
 var (
 	ErrNominalExceeded    = errors.New("nomial value exceeded")
 	ErrNegativeAmount     = errors.New("negative amount given")
 	ErrInvalidWrapperType = errors.New("invalid wrapper type given")
-)
-
-const (
-	WrapperTypeGIA WrapperType = iota + 1 //Bump number so that you can't set UNSPECIFIED account type
-	WrapperTypeISA
-	WrapperTypeSIPP
+	// ErrConcurrentModification is returned when an account was changed by someone else since it was read
+	ErrConcurrentModification = errors.New("account modified concurrently")
+	ErrReceiptNotOnAccount    = errors.New("receipt doesn't belong to account")
+	ErrNominalBelowAllocated  = errors.New("nominal can't be reduced below what's allocated to the account")
+	ErrAccountNotFound        = errors.New("account not found")
 )
 
 type Account struct {
@@ -25,28 +23,33 @@ type Account struct {
 	WrapperType          WrapperType
 	TotalAllocatedAmount TotalAllocatedAmount
 	NominalAmount        NominalAmount
+	PendingReliefAmount  TotalAllocatedAmount
 	Receipts             []*Receipt
+	// Version is the stored version the account was read at, updates only succeed if it's unchanged
+	//
+	// It's the sequence of the last event saved to the account's stream
+	Version int64
+	// Events are what's happened to the account since it was read, saved to its stream with it
+	Events []RecordedEvent
+	// Removed is set once the account's been taken out of its deposit, it's only kept as a record
+	Removed bool
 }
 type AccountId string
 
-type NominalAmount int64
-
-type TotalAllocatedAmount int64
+type NominalAmount struct {
+	Money
+}
 
-func (amount TotalAllocatedAmount) Int64() int64 {
-	return int64(amount)
+type TotalAllocatedAmount struct {
+	Money
 }
 
-func NewTotalAllocatedAmount(amount int64) (TotalAllocatedAmount, error) {
-	if amount < 0 {
-		return 0, ErrNegativeAmount
+func NewTotalAllocatedAmount(amount Money) (TotalAllocatedAmount, error) {
+	if amount.IsNegative() {
+		return TotalAllocatedAmount{}, ErrNegativeAmount
 	}
 
-	return TotalAllocatedAmount(amount), nil
-}
-
-func (wrapperType WrapperType) Int() int {
-	return int(wrapperType)
+	return TotalAllocatedAmount{amount}, nil
 }
 
 func newAccountId() (AccountId, error) {
@@ -72,7 +75,7 @@ func (id AccountId) String() string {
 }
 
 // NewAccount creates a new Account with a new Id
-func NewAccount(wrapperType WrapperType, nominalAmount int64) (*Account, error) {
+func NewAccount(wrapperType WrapperType, nominalAmount Money) (*Account, error) {
 	// Generate Id
 	id, err := newAccountId()
 	if err != nil {
@@ -90,32 +93,34 @@ func NewAccount(wrapperType WrapperType, nominalAmount int64) (*Account, error)
 		return nil, err
 	}
 
-	// Create Account
-	return &Account{
-		Id:                   id,
+	// Open Account, everything in the account shares the nominal's currency
+	zero := Money{Currency: nominalAmount.Currency}
+	account := &Account{Id: id}
+	err = account.record(AccountOpened{
 		WrapperType:          wrapperType,
 		NominalAmount:        accountNominalAmount,
-		TotalAllocatedAmount: 0,
-	}, nil
-}
-
-func validateWrapperType(wrapperType WrapperType) error {
-	switch wrapperType {
-	case WrapperTypeGIA, WrapperTypeISA, WrapperTypeSIPP:
-		return nil
+		TotalAllocatedAmount: TotalAllocatedAmount{zero},
+		PendingReliefAmount:  TotalAllocatedAmount{zero},
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return ErrInvalidWrapperType
+	return account, nil
 }
 
 // ParseAccount parses the given data into a Account type, ensuring it's valid data
-func ParseAccount(id string, wrapperType int, nominalAmount int64, totalAllocatedAmount int64) (*Account, error) {
+func ParseAccount(id string, wrapperType int, currency string, nominalAmount int64, totalAllocatedAmount int64) (*Account, error) {
 	accountId, err := ParseAccountId(id)
 	if err != nil {
 		return nil, err
 	}
 
-	accountNominalAmount, err := NewNominalAmount(nominalAmount)
+	nominal, err := NewMoney(nominalAmount, currency)
+	if err != nil {
+		return nil, err
+	}
+	accountNominalAmount, err := NewNominalAmount(nominal)
 	if err != nil {
 		return nil, err
 	}
@@ -126,15 +131,20 @@ func ParseAccount(id string, wrapperType int, nominalAmount int64, totalAllocate
 		return nil, err
 	}
 
-	accountTotalAllocatedAmount, err := NewTotalAllocatedAmount(totalAllocatedAmount)
+	totalAllocated, err := NewMoney(totalAllocatedAmount, currency)
+	if err != nil {
+		return nil, err
+	}
+	accountTotalAllocatedAmount, err := NewTotalAllocatedAmount(totalAllocated)
 	if err != nil {
 		return nil, err
 	}
 
 	account := &Account{
-		Id:            accountId,
-		WrapperType:   accountWrapperType,
-		NominalAmount: accountNominalAmount,
+		Id:                  accountId,
+		WrapperType:         accountWrapperType,
+		NominalAmount:       accountNominalAmount,
+		PendingReliefAmount: TotalAllocatedAmount{Money{Currency: nominal.Currency}},
 	}
 
 	err = account.SetTotalAllocationAmount(accountTotalAllocatedAmount)
@@ -145,33 +155,190 @@ func ParseAccount(id string, wrapperType int, nominalAmount int64, totalAllocate
 	return account, nil
 }
 
-func NewNominalAmount(amount int64) (NominalAmount, error) {
-	if amount < 0 {
-		return 0, ErrNominalAmountNegative
+func NewNominalAmount(amount Money) (NominalAmount, error) {
+	if amount.IsNegative() {
+		return NominalAmount{}, ErrNominalAmountNegative
 	}
 
-	return NominalAmount(amount), nil
+	return NominalAmount{amount}, nil
+}
+
+// clone copies the account, so changes to it don't show up in the copy
+func (account Account) clone() Account {
+	account.Receipts = slices.Clone(account.Receipts)
+	account.Events = slices.Clone(account.Events)
+	return account
 }
 
-func (nominalAmount NominalAmount) Int64() int64 {
-	return int64(nominalAmount)
+// ChangeNominalAmount sets a new NominalAmount, in the account's currency, which capped wrappers can't reduce below
+// what's already allocated to the account
+func (account *Account) ChangeNominalAmount(nominalAmount Money) error {
+	if nominalAmount.Currency != account.NominalAmount.Currency {
+		return ErrCurrencyMismatch
+	}
+
+	accountNominalAmount, err := NewNominalAmount(nominalAmount)
+	if err != nil {
+		return err
+	}
+
+	// The wrapper decides if what's allocated still fits
+	policy, err := LookupWrapperPolicy(account.WrapperType)
+	if err != nil {
+		return err
+	}
+	changed := *account
+	changed.NominalAmount = accountNominalAmount
+	err = policy.ValidateAllocation(changed, account.TotalAllocatedAmount)
+	if err != nil {
+		return errors.Join(ErrNominalBelowAllocated, err)
+	}
+
+	return account.record(NominalChanged{NominalAmount: accountNominalAmount})
 }
 
 // AddReceipt validates that it can allocate the receipt to the Account, then updates account information
 func (account *Account) AddReceipt(receipt *Receipt) error {
-	err := account.IncreaseTotalAllocationAmount(TotalAllocatedAmount(receipt.AllocatedAmount))
+	policy, err := LookupWrapperPolicy(account.WrapperType)
+	if err != nil {
+		return err
+	}
+
+	// Reserve any relief due on the receipt, so the nominal is checked against the gross contribution
+	relief := policy.TaxRelief(receipt.AllocatedAmount)
+	changed := *account
+	changed.PendingReliefAmount.Money, err = account.PendingReliefAmount.Add(relief.Money)
+	if err != nil {
+		return err
+	}
+	err = changed.IncreaseTotalAllocationAmount(TotalAllocatedAmount(receipt.AllocatedAmount))
+	if err != nil {
+		return err
+	}
+
+	err = account.record(ReceiptAllocated{
+		ReceiptId: receipt.Id,
+		Amount:    receipt.AllocatedAmount,
+		Relief:    relief,
+	})
+	if err != nil {
+		return err
+	}
+
+	receipt.AccountId = account.Id
+	account.Receipts = append(account.Receipts, receipt)
+
+	return nil
+}
+
+// ApplyTaxRelief allocates a receipt for relief that was pending on the account
+func (account *Account) ApplyTaxRelief(receipt *Receipt) error {
+	relief := TotalAllocatedAmount(receipt.AllocatedAmount)
+	remaining, err := account.PendingReliefAmount.Subtract(relief.Money)
+	if err != nil {
+		return err
+	}
+	if remaining.IsNegative() {
+		return ErrReliefNotPending
+	}
+
+	// Move the relief from pending to allocated
+	changed := *account
+	changed.PendingReliefAmount = TotalAllocatedAmount{remaining}
+	err = changed.IncreaseTotalAllocationAmount(relief)
+	if err != nil {
+		return err
+	}
+
+	err = account.record(ReliefApplied{
+		ReceiptId: receipt.Id,
+		Amount:    receipt.AllocatedAmount,
+	})
 	if err != nil {
 		return err
 	}
 
 	account.Receipts = append(account.Receipts, receipt)
+	return nil
+}
+
+// ReverseReceipt takes a receipt that was added to the account back off its TotalAllocatedAmount
+func (account *Account) ReverseReceipt(receipt Receipt) error {
+	if receipt.AccountId != account.Id {
+		return ErrReceiptNotOnAccount
+	}
+
+	remaining, err := account.TotalAllocatedAmount.Subtract(receipt.AllocatedAmount.Money)
+	if err != nil {
+		return err
+	}
+	value, err := NewTotalAllocatedAmount(remaining)
+	if err != nil {
+		return err
+	}
+	changed := *account
+	err = changed.SetTotalAllocationAmount(value)
+	if err != nil {
+		return err
+	}
+
+	return account.record(ReceiptReversed{
+		ReceiptId: receipt.Id,
+		Amount:    receipt.AllocatedAmount,
+	})
+}
+
+// Remove records the account being taken out of its deposit, which ends its stream
+func (account *Account) Remove() error {
+	if account.Removed {
+		return ErrAccountRemoved
+	}
+
+	return account.record(AccountRemoved{})
+}
+
+// ReleaseTaxRelief gives up relief that was pending on the account, when the contribution it was due on is reversed
+func (account *Account) ReleaseTaxRelief(relief AllocatedAmount) error {
+	remaining, err := account.PendingReliefAmount.Subtract(relief.Money)
+	if err != nil {
+		return err
+	}
+	if remaining.IsNegative() {
+		return ErrReliefNotPending
+	}
+
+	return account.record(ReliefReleased{Amount: relief})
+}
+
+// SetPendingReliefAmount sets the PendingReliefAmount to the given amount, without recording an event, for restoring
+// an account from what was stored
+func (account *Account) SetPendingReliefAmount(amount TotalAllocatedAmount) error {
+	if amount.IsNegative() {
+		return ErrNegativeAmount
+	}
+
+	policy, err := LookupWrapperPolicy(account.WrapperType)
+	if err != nil {
+		return err
+	}
+
+	pending := account.PendingReliefAmount
+	account.PendingReliefAmount = amount
+	err = policy.ValidateAllocation(*account, account.TotalAllocatedAmount)
+	if err != nil {
+		account.PendingReliefAmount = pending
+		return err
+	}
 
 	return nil
 }
 
-// IncreaseTotalAllocationAmount increases the TotalAllocatedAmount by the given amount
+// IncreaseTotalAllocationAmount increases the TotalAllocatedAmount by the given amount, which must be in the account's currency
 func (account *Account) IncreaseTotalAllocationAmount(amount TotalAllocatedAmount) error {
-	newAmount := account.TotalAllocatedAmount.Int64() + amount.Int64()
+	newAmount, err := account.TotalAllocatedAmount.Add(amount.Money)
+	if err != nil {
+		return err
+	}
 
 	value, err := NewTotalAllocatedAmount(newAmount)
 	if err != nil {
@@ -189,11 +356,14 @@ func (account *Account) IncreaseTotalAllocationAmount(amount TotalAllocatedAmoun
 // SetTotalAllocationAmount sets the TotalAllocatedAmount to the given amount
 func (account *Account) SetTotalAllocationAmount(amount TotalAllocatedAmount) error {
 
-	// ISA and SIPP accounts can't exceed Nominal Amount
-	if account.WrapperType == WrapperTypeISA || account.WrapperType == WrapperTypeSIPP {
-		if account.NominalAmount.Int64() < amount.Int64() {
-			return ErrNominalExceeded
-		}
+	// The wrapper decides what can be allocated
+	policy, err := LookupWrapperPolicy(account.WrapperType)
+	if err != nil {
+		return err
+	}
+	err = policy.ValidateAllocation(*account, amount)
+	if err != nil {
+		return err
 	}
 
 	account.TotalAllocatedAmount = amount
@@ -22,13 +22,13 @@ func TestNewTotalAllocatedAmount(t *testing.T) {
 			description:   "passes for 10",
 			input:         10,
 			expectedError: nil,
-			expectedValue: pointers.New(deposits.TotalAllocatedAmount(10)),
+			expectedValue: pointers.New(deposits.TotalAllocatedAmount{Money: gbp(10)}),
 		},
 		{
 			description:   "passes for 0",
 			input:         0,
 			expectedError: nil,
-			expectedValue: pointers.New(deposits.TotalAllocatedAmount(0)),
+			expectedValue: pointers.New(deposits.TotalAllocatedAmount{Money: gbp(0)}),
 		},
 		{
 			description:   "fails for -1",
@@ -41,7 +41,7 @@ func TestNewTotalAllocatedAmount(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.description, func(t *testing.T) {
 
-			actualValue, actualError := deposits.NewTotalAllocatedAmount(testCase.input)
+			actualValue, actualError := deposits.NewTotalAllocatedAmount(gbp(testCase.input))
 
 			if actualError != nil {
 				require.ErrorIs(t, actualError, testCase.expectedError)
@@ -98,22 +98,34 @@ func TestNewAccount(t *testing.T) {
 	t.Run("successful data", func(t *testing.T) {
 		account, err := deposits.NewAccount(
 			deposits.WrapperTypeISA,
-			123456,
+			gbp(123456),
 		)
 
 		require.NoError(t, err)
 		require.Equal(t, &deposits.Account{
 			Id:                   account.Id,
 			WrapperType:          deposits.WrapperTypeISA,
-			NominalAmount:        123456,
-			TotalAllocatedAmount: 0,
+			NominalAmount:        deposits.NominalAmount{Money: gbp(123456)},
+			TotalAllocatedAmount: deposits.TotalAllocatedAmount{Money: gbp(0)},
+			PendingReliefAmount:  deposits.TotalAllocatedAmount{Money: gbp(0)},
+			Events: []deposits.RecordedEvent{
+				{
+					Sequence: 1,
+					Event: deposits.AccountOpened{
+						WrapperType:          deposits.WrapperTypeISA,
+						NominalAmount:        deposits.NominalAmount{Money: gbp(123456)},
+						TotalAllocatedAmount: deposits.TotalAllocatedAmount{Money: gbp(0)},
+						PendingReliefAmount:  deposits.TotalAllocatedAmount{Money: gbp(0)},
+					},
+				},
+			},
 		}, account)
 	})
 
 	t.Run("invalid wrapper", func(t *testing.T) {
 		_, err := deposits.NewAccount(
 			0,
-			123456,
+			gbp(123456),
 		)
 
 		require.ErrorIs(t, err, deposits.ErrInvalidWrapperType)
@@ -123,31 +135,38 @@ func TestNewAccount(t *testing.T) {
 func TestParseAccount(t *testing.T) {
 
 	t.Run("successful data", func(t *testing.T) {
-		account, err := deposits.ParseAccount(uuid.NewString(), 1, 10, 0)
+		account, err := deposits.ParseAccount(uuid.NewString(), 1, "GBP", 10, 0)
 
 		require.NoError(t, err)
 		require.Equal(t, &deposits.Account{
 			Id:                   account.Id,
 			WrapperType:          1,
-			NominalAmount:        10,
-			TotalAllocatedAmount: 0,
+			NominalAmount:        deposits.NominalAmount{Money: gbp(10)},
+			TotalAllocatedAmount: deposits.TotalAllocatedAmount{Money: gbp(0)},
+			PendingReliefAmount:  deposits.TotalAllocatedAmount{Money: gbp(0)},
 		}, account)
 	})
 
 	t.Run("invalid id", func(t *testing.T) {
-		_, err := deposits.ParseAccount("string", 1, 10, 0)
+		_, err := deposits.ParseAccount("string", 1, "GBP", 10, 0)
 
 		require.ErrorContains(t, err, "invalid UUID length")
 	})
 
 	t.Run("invalid type", func(t *testing.T) {
-		_, err := deposits.ParseAccount(uuid.NewString(), 0, 10, 0)
+		_, err := deposits.ParseAccount(uuid.NewString(), 0, "GBP", 10, 0)
 
 		require.ErrorIs(t, err, deposits.ErrInvalidWrapperType)
 	})
 
+	t.Run("invalid currency", func(t *testing.T) {
+		_, err := deposits.ParseAccount(uuid.NewString(), 1, "ABC", 10, 0)
+
+		require.ErrorIs(t, err, deposits.ErrInvalidCurrency)
+	})
+
 	t.Run("invalid nominal amount", func(t *testing.T) {
-		_, err := deposits.ParseAccount(uuid.NewString(), 1, -1, 0)
+		_, err := deposits.ParseAccount(uuid.NewString(), 1, "GBP", -1, 0)
 
 		require.ErrorIs(t, err, deposits.ErrNominalAmountNegative)
 	})
@@ -155,29 +174,142 @@ func TestParseAccount(t *testing.T) {
 
 func TestAddReceipt(t *testing.T) {
 
+	// SIPP receipts reserve 25% tax relief, so each receipt of 40 uses 50 of the nominal
 	accountUUID := uuid.NewString()
-	account, err := deposits.ParseAccount(accountUUID, deposits.WrapperTypeSIPP.Int(), 100, 0)
+	account, err := deposits.ParseAccount(accountUUID, deposits.WrapperTypeSIPP.Int(), "GBP", 100, 0)
 	require.NoError(t, err)
 
 	receiptUUID := uuid.NewString()
-	receipt, err := deposits.ParseReceipt(receiptUUID, 50)
+	receipt, err := deposits.ParseReceipt(receiptUUID, "GBP", 40)
 	require.NoError(t, err)
 
 	err = account.AddReceipt(receipt)
 	require.NoError(t, err)
 
 	receiptUUID = uuid.NewString()
-	receipt, err = deposits.ParseReceipt(receiptUUID, 50)
+	receipt, err = deposits.ParseReceipt(receiptUUID, "GBP", 40)
 	require.NoError(t, err)
 
 	err = account.AddReceipt(receipt)
 	require.NoError(t, err)
 
 	receiptUUID = uuid.NewString()
-	receipt, err = deposits.ParseReceipt(receiptUUID, 50)
+	receipt, err = deposits.ParseReceipt(receiptUUID, "GBP", 40)
 	require.NoError(t, err)
 
 	err = account.AddReceipt(receipt)
 	slog.Info("asdf", "account", account)
 	require.ErrorIs(t, err, deposits.ErrNominalExceeded)
 }
+
+func TestReverseReceipt(t *testing.T) {
+	account, err := deposits.ParseAccount(uuid.NewString(), deposits.WrapperTypeISA.Int(), "GBP", 100, 0)
+	require.NoError(t, err)
+
+	receipt, err := deposits.NewReceipt(gbp(60))
+	require.NoError(t, err)
+	err = account.AddReceipt(receipt)
+	require.NoError(t, err)
+	require.Equal(t, account.Id, receipt.AccountId)
+
+	err = account.ReverseReceipt(*receipt)
+	require.NoError(t, err)
+	require.Equal(t, gbp(0), account.TotalAllocatedAmount.Money)
+
+	// Can't take off more than was allocated
+	err = account.ReverseReceipt(*receipt)
+	require.ErrorIs(t, err, deposits.ErrNegativeAmount)
+
+	// Receipts for other accounts can't be reversed
+	other, err := deposits.NewReceipt(gbp(10))
+	require.NoError(t, err)
+	err = account.ReverseReceipt(*other)
+	require.ErrorIs(t, err, deposits.ErrReceiptNotOnAccount)
+}
+
+func TestReleaseTaxRelief(t *testing.T) {
+	account, err := deposits.ParseAccount(uuid.NewString(), deposits.WrapperTypeSIPP.Int(), "GBP", 100_00, 0)
+	require.NoError(t, err)
+
+	receipt, err := deposits.NewReceipt(gbp(80_00))
+	require.NoError(t, err)
+	err = account.AddReceipt(receipt)
+	require.NoError(t, err)
+
+	err = account.ReleaseTaxRelief(deposits.AllocatedAmount{Money: gbp(20_00)})
+	require.NoError(t, err)
+	require.Equal(t, gbp(0), account.PendingReliefAmount.Money)
+
+	err = account.ReleaseTaxRelief(deposits.AllocatedAmount{Money: gbp(1)})
+	require.ErrorIs(t, err, deposits.ErrReliefNotPending)
+}
+
+func TestChangeNominalAmount(t *testing.T) {
+	testCases := []struct {
+		description string
+		wrapperType deposits.WrapperType
+		nominal     deposits.Money
+		expected    error
+	}{
+		{
+			description: "raise",
+			wrapperType: deposits.WrapperTypeISA,
+			nominal:     gbp(200_00),
+		},
+		{
+			description: "reduce to allocated",
+			wrapperType: deposits.WrapperTypeISA,
+			nominal:     gbp(80_00),
+		},
+		{
+			description: "ISA below allocated",
+			wrapperType: deposits.WrapperTypeISA,
+			nominal:     gbp(79_99),
+			expected:    deposits.ErrNominalBelowAllocated,
+		},
+		{
+			// The SIPP's gross, with the 20_00 relief pending, is 100_00
+			description: "SIPP below gross",
+			wrapperType: deposits.WrapperTypeSIPP,
+			nominal:     gbp(99_99),
+			expected:    deposits.ErrNominalBelowAllocated,
+		},
+		{
+			description: "GIA below allocated",
+			wrapperType: deposits.WrapperTypeGIA,
+			nominal:     gbp(0),
+		},
+		{
+			description: "negative",
+			wrapperType: deposits.WrapperTypeGIA,
+			nominal:     gbp(-1),
+			expected:    deposits.ErrNominalAmountNegative,
+		},
+		{
+			description: "other currency",
+			wrapperType: deposits.WrapperTypeGIA,
+			nominal:     eur(100_00),
+			expected:    deposits.ErrCurrencyMismatch,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			account, err := deposits.NewAccount(testCase.wrapperType, gbp(100_00))
+			require.NoError(t, err)
+			receipt, err := deposits.NewReceipt(gbp(80_00))
+			require.NoError(t, err)
+			err = account.AddReceipt(receipt)
+			require.NoError(t, err)
+
+			err = account.ChangeNominalAmount(testCase.nominal)
+			if testCase.expected != nil {
+				require.ErrorIs(t, err, testCase.expected)
+				require.Equal(t, gbp(100_00), account.NominalAmount.Money)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, testCase.nominal, account.NominalAmount.Money)
+		})
+	}
+}
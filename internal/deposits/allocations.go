@@ -0,0 +1,392 @@
+package deposits
+
+import (
+	"errors"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidAllocationStrategy = errors.New("invalid allocation strategy given")
+	ErrAllocationExceedsNominal  = errors.New("receipt exceeds what the deposit's accounts can take")
+	ErrNoAccountsToAllocate      = errors.New("deposit has no accounts to allocate to")
+	ErrDepositReceiptNotFound    = errors.New("deposit receipt not found")
+	ErrDuplicateWaterfallWrapper = errors.New("wrapper type appears more than once in the waterfall order")
+)
+
+// Allocation is the part of a deposit level receipt given to an account
+type Allocation struct {
+	AccountId AccountId
+	Amount    AllocatedAmount
+}
+
+// AllocationRule splits a deposit level receipt between the deposit's accounts
+//
+// The isaAllowance is what's left of the investor's ISA allowance for the tax year the receipt's received in
+type AllocationRule interface {
+	Allocate(deposit Deposit, amount AllocatedAmount, isaAllowance Money) ([]Allocation, error)
+}
+
+type AllocationStrategy string
+
+const (
+	// AllocationStrategyProportional splits receipts in proportion to the accounts' nominal amounts, see ProportionalAllocation
+	AllocationStrategyProportional AllocationStrategy = "PROPORTIONAL"
+	// AllocationStrategyWaterfall fills accounts in wrapper order, see WaterfallAllocation
+	AllocationStrategyWaterfall AllocationStrategy = "WATERFALL"
+)
+
+func ParseAllocationStrategy(strategy string) (AllocationStrategy, error) {
+	switch AllocationStrategy(strategy) {
+	case AllocationStrategyProportional, AllocationStrategyWaterfall:
+		return AllocationStrategy(strategy), nil
+	}
+
+	return "", ErrInvalidAllocationStrategy
+}
+
+func (strategy AllocationStrategy) String() string {
+	return string(strategy)
+}
+
+// DefaultWaterfallOrder fills tax efficient wrappers first, overflowing into the GIA
+var DefaultWaterfallOrder = []WrapperType{
+	WrapperTypeISA,
+	WrapperTypeLifetimeISA,
+	WrapperTypeJISAToISA,
+	WrapperTypeCashISA,
+	WrapperTypeJuniorISA,
+	WrapperTypeSIPP,
+	WrapperTypeGIA,
+}
+
+// NewAllocationRule creates the AllocationRule for the strategy, waterfalls use the DefaultWaterfallOrder if no order is given
+func NewAllocationRule(strategy AllocationStrategy, waterfallOrder []WrapperType) (AllocationRule, error) {
+	switch strategy {
+	case AllocationStrategyProportional:
+		return ProportionalAllocation{}, nil
+	case AllocationStrategyWaterfall:
+		if len(waterfallOrder) == 0 {
+			waterfallOrder = DefaultWaterfallOrder
+		}
+		for i, wrapperType := range waterfallOrder {
+			err := validateWrapperType(wrapperType)
+			if err != nil {
+				return nil, err
+			}
+			if slices.Contains(waterfallOrder[:i], wrapperType) {
+				return nil, ErrDuplicateWaterfallWrapper
+			}
+		}
+		return WaterfallAllocation{Order: waterfallOrder}, nil
+	}
+
+	return nil, ErrInvalidAllocationStrategy
+}
+
+// depositAccounts returns every account in the deposit, in pot order
+func depositAccounts(deposit Deposit) []*Account {
+	accounts := []*Account{}
+	for _, pot := range deposit.Pots {
+		accounts = append(accounts, pot.Accounts...)
+	}
+
+	return accounts
+}
+
+// ProportionalAllocation splits receipts in proportion to the accounts' nominal amounts
+//
+// Shares are rounded down, with the minor units left over going to the accounts with the largest remainders.
+// ISA wrappers between them only take what's left of the investor's ISA allowance, the rest is split between the other
+// accounts in proportion to their nominal amounts.
+type ProportionalAllocation struct{}
+
+func (rule ProportionalAllocation) Allocate(deposit Deposit, amount AllocatedAmount, isaAllowance Money) ([]Allocation, error) {
+	accounts := depositAccounts(deposit)
+	for _, account := range accounts {
+		if account.NominalAmount.Currency != amount.Currency {
+			return nil, ErrCurrencyMismatch
+		}
+	}
+
+	shares, err := splitProportionally(amount.Amount, accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cap the ISAs' shares at the allowance, splitting what's over it between the others
+	isaAccounts, otherAccounts := []*Account{}, []*Account{}
+	isaIndexes, otherIndexes := []int{}, []int{}
+	isaShare := int64(0)
+	for i, account := range accounts {
+		policy, err := LookupWrapperPolicy(account.WrapperType)
+		if err != nil {
+			return nil, err
+		}
+		if policy.UsesISAAllowance() {
+			isaAccounts = append(isaAccounts, account)
+			isaIndexes = append(isaIndexes, i)
+			isaShare += shares[i]
+			continue
+		}
+		otherAccounts = append(otherAccounts, account)
+		otherIndexes = append(otherIndexes, i)
+	}
+	if len(isaAccounts) > 0 && isaAllowance.Currency != amount.Currency {
+		return nil, ErrCurrencyMismatch
+	}
+	if isaShare > isaAllowance.Amount {
+		isaShares, err := splitProportionally(isaAllowance.Amount, isaAccounts)
+		if err != nil {
+			return nil, err
+		}
+		otherShares, err := splitProportionally(amount.Amount-isaAllowance.Amount, otherAccounts)
+		if errors.Is(err, ErrNoAccountsToAllocate) {
+			return nil, ErrAllocationExceedsNominal
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for j, i := range isaIndexes {
+			shares[i] = isaShares[j]
+		}
+		for j, i := range otherIndexes {
+			shares[i] = otherShares[j]
+		}
+	}
+
+	allocations := []Allocation{}
+	for i, account := range accounts {
+		if shares[i] == 0 {
+			continue
+		}
+
+		allocations = append(allocations, Allocation{
+			AccountId: account.Id,
+			Amount:    AllocatedAmount{Money{Amount: shares[i], Currency: amount.Currency}},
+		})
+	}
+
+	return allocations, nil
+}
+
+// splitProportionally splits the amount between the accounts in proportion to their nominal amounts, rounding each
+// share down and handing the minor units left over to the largest remainders, then in the accounts' order
+func splitProportionally(amount int64, accounts []*Account) ([]int64, error) {
+	// Total nominal to share by, big to avoid overflowing when multiplying
+	totalNominal := new(big.Int)
+	for _, account := range accounts {
+		totalNominal.Add(totalNominal, big.NewInt(account.NominalAmount.Amount))
+	}
+	if totalNominal.Sign() == 0 {
+		return nil, ErrNoAccountsToAllocate
+	}
+
+	// Round each share down
+	shares := make([]int64, len(accounts))
+	remainders := make([]*big.Int, len(accounts))
+	allocated := int64(0)
+	for i, account := range accounts {
+		share := new(big.Int).Mul(big.NewInt(amount), big.NewInt(account.NominalAmount.Amount))
+		share, remainders[i] = share.QuoRem(share, totalNominal, new(big.Int))
+		shares[i] = share.Int64()
+		allocated += shares[i]
+	}
+
+	// Hand out what's left over by largest remainder, then account order
+	order := make([]int, len(accounts))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a int, b int) int {
+		return remainders[b].Cmp(remainders[a])
+	})
+	for _, i := range order[:amount-allocated] {
+		shares[i]++
+	}
+
+	return shares, nil
+}
+
+// WaterfallAllocation fills each account up to its nominal in wrapper order, then pot order
+//
+// Uncapped wrappers, like the GIA, take everything that's left so should come last.
+// Accounts with a wrapper type not in the order aren't allocated to.
+// ISA wrappers between them only take what's left of the investor's ISA allowance.
+type WaterfallAllocation struct {
+	Order []WrapperType
+}
+
+func (rule WaterfallAllocation) Allocate(deposit Deposit, amount AllocatedAmount, isaAllowance Money) ([]Allocation, error) {
+	accounts := depositAccounts(deposit)
+	if len(accounts) == 0 {
+		return nil, ErrNoAccountsToAllocate
+	}
+
+	remaining := amount.Amount
+	remainingAllowance := isaAllowance.Amount
+	allocations := []Allocation{}
+	for _, wrapperType := range rule.Order {
+		policy, err := LookupWrapperPolicy(wrapperType)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, account := range accounts {
+			if remaining == 0 {
+				return allocations, nil
+			}
+			if account.WrapperType != wrapperType {
+				continue
+			}
+			if account.NominalAmount.Currency != amount.Currency {
+				return nil, ErrCurrencyMismatch
+			}
+
+			// Capped accounts only take what fits
+			share := remaining
+			headroom, capped, err := policy.Headroom(*account)
+			if err != nil {
+				return nil, err
+			}
+			if capped {
+				share = min(share, headroom.Amount)
+			}
+
+			// As do ISAs, sharing the investor's allowance
+			if policy.UsesISAAllowance() {
+				if isaAllowance.Currency != amount.Currency {
+					return nil, ErrCurrencyMismatch
+				}
+				share = min(share, remainingAllowance)
+				remainingAllowance -= share
+			}
+			if share == 0 {
+				continue
+			}
+
+			allocations = append(allocations, Allocation{
+				AccountId: account.Id,
+				Amount:    AllocatedAmount{Money{Amount: share, Currency: amount.Currency}},
+			})
+			remaining -= share
+		}
+	}
+
+	if remaining > 0 {
+		return nil, ErrAllocationExceedsNominal
+	}
+
+	return allocations, nil
+}
+
+type DepositReceiptId string
+
+func newDepositReceiptId() (DepositReceiptId, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return "", errors.Join(ErrIdGeneration, err)
+	}
+
+	return DepositReceiptId(id.String()), nil
+}
+
+func ParseDepositReceiptId(id string) (DepositReceiptId, error) {
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return "", err
+	}
+
+	return DepositReceiptId(id), nil
+}
+
+func (id DepositReceiptId) String() string {
+	return string(id)
+}
+
+// DepositReceipt is a payment for a whole deposit, received as a child Receipt for each account it's allocated to
+type DepositReceipt struct {
+	Id              DepositReceiptId
+	DepositId       DepositId
+	AllocatedAmount AllocatedAmount
+	IdempotencyKey  IdempotencyKey
+	// ReceivedAt is when the payment arrived, its child receipts are received then too
+	ReceivedAt time.Time
+	Receipts   []*Receipt
+}
+
+// NewDepositReceipt creates a new DepositReceipt with a new Id
+func NewDepositReceipt(depositId DepositId, allocatedAmount Money) (*DepositReceipt, error) {
+	id, err := newDepositReceiptId()
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := NewAllocatedAmount(allocatedAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DepositReceipt{
+		Id:              id,
+		DepositId:       depositId,
+		AllocatedAmount: amount,
+		ReceivedAt:      time.Now(),
+	}, nil
+}
+
+// ParseDepositReceipt parses the given data into a DepositReceipt type, ensuring it's valid data
+func ParseDepositReceipt(id string, depositId string, currency string, allocatedAmount int64, idempotencyKey string) (*DepositReceipt, error) {
+	depositReceiptId, err := ParseDepositReceiptId(id)
+	if err != nil {
+		return nil, err
+	}
+
+	receiptDepositId, err := ParseDepositId(depositId)
+	if err != nil {
+		return nil, err
+	}
+
+	money, err := NewMoney(allocatedAmount, currency)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := NewAllocatedAmount(money)
+	if err != nil {
+		return nil, err
+	}
+
+	depositReceipt := &DepositReceipt{
+		Id:              depositReceiptId,
+		DepositId:       receiptDepositId,
+		AllocatedAmount: amount,
+	}
+
+	// Keys are optional
+	if idempotencyKey != "" {
+		depositReceipt.IdempotencyKey, err = NewIdempotencyKey(idempotencyKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return depositReceipt, nil
+}
+
+// AddReceipt creates the child Receipt for an allocation
+func (depositReceipt *DepositReceipt) AddReceipt(allocation Allocation) (*Receipt, error) {
+	receipt, err := NewReceipt(allocation.Amount.Money)
+	if err != nil {
+		return nil, err
+	}
+	receipt.AccountId = allocation.AccountId
+	receipt.DepositReceiptId = depositReceipt.Id
+	receipt.ReceivedAt = depositReceipt.ReceivedAt
+
+	depositReceipt.Receipts = append(depositReceipt.Receipts, receipt)
+	return receipt, nil
+}
@@ -0,0 +1,343 @@
+package deposits_test
+
+import (
+	"testing"
+
+	"github.com/iainvm/deposits/internal/deposits"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAllocationStrategy(t *testing.T) {
+	testCases := []struct {
+		description   string
+		input         string
+		expectedError error
+		expectedValue deposits.AllocationStrategy
+	}{
+		{
+			description:   "passes for PROPORTIONAL",
+			input:         "PROPORTIONAL",
+			expectedValue: deposits.AllocationStrategyProportional,
+		},
+		{
+			description:   "passes for WATERFALL",
+			input:         "WATERFALL",
+			expectedValue: deposits.AllocationStrategyWaterfall,
+		},
+		{
+			description:   "fails for unknown strategy",
+			input:         "UNSPECIFIED",
+			expectedError: deposits.ErrInvalidAllocationStrategy,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			actualValue, actualError := deposits.ParseAllocationStrategy(testCase.input)
+			if testCase.expectedError != nil {
+				require.ErrorIs(t, actualError, testCase.expectedError)
+				return
+			}
+
+			require.NoError(t, actualError)
+			require.Equal(t, testCase.expectedValue, actualValue)
+		})
+	}
+}
+
+func TestNewAllocationRule(t *testing.T) {
+	t.Run("waterfall defaults the order", func(t *testing.T) {
+		rule, err := deposits.NewAllocationRule(deposits.AllocationStrategyWaterfall, nil)
+		require.NoError(t, err)
+		require.Equal(t, deposits.WaterfallAllocation{Order: deposits.DefaultWaterfallOrder}, rule)
+	})
+
+	t.Run("waterfall fails for unknown wrappers", func(t *testing.T) {
+		_, err := deposits.NewAllocationRule(deposits.AllocationStrategyWaterfall, []deposits.WrapperType{deposits.WrapperType(99)})
+		require.ErrorIs(t, err, deposits.ErrInvalidWrapperType)
+	})
+
+	t.Run("waterfall fails for repeated wrappers", func(t *testing.T) {
+		_, err := deposits.NewAllocationRule(deposits.AllocationStrategyWaterfall, []deposits.WrapperType{deposits.WrapperTypeISA, deposits.WrapperTypeGIA, deposits.WrapperTypeISA})
+		require.ErrorIs(t, err, deposits.ErrDuplicateWaterfallWrapper)
+	})
+
+	t.Run("fails for unknown strategy", func(t *testing.T) {
+		_, err := deposits.NewAllocationRule(deposits.AllocationStrategy("UNSPECIFIED"), nil)
+		require.ErrorIs(t, err, deposits.ErrInvalidAllocationStrategy)
+	})
+}
+
+// newAllocationDeposit creates a deposit with an account for each nominal, using the matching wrapper type
+func newAllocationDeposit(t *testing.T, wrapperTypes []deposits.WrapperType, nominals []int64) *deposits.Deposit {
+	t.Helper()
+
+	deposit, err := deposits.NewDeposit()
+	require.NoError(t, err)
+
+	pot, err := deposits.NewPot("Pot A")
+	require.NoError(t, err)
+	for i, wrapperType := range wrapperTypes {
+		account, err := deposits.NewAccount(wrapperType, gbp(nominals[i]))
+		require.NoError(t, err)
+		pot.Accounts = append(pot.Accounts, account)
+	}
+	deposit.AddPot(pot)
+
+	return deposit
+}
+
+// allocatedAmounts returns the amount allocated to each of the deposit's accounts, in pot order
+func allocatedAmounts(deposit *deposits.Deposit, allocations []deposits.Allocation) []int64 {
+	amounts := []int64{}
+	for _, account := range deposit.Pots[0].Accounts {
+		amount := int64(0)
+		for _, allocation := range allocations {
+			if allocation.AccountId == account.Id {
+				amount += allocation.Amount.Amount
+			}
+		}
+		amounts = append(amounts, amount)
+	}
+
+	return amounts
+}
+
+func TestProportionalAllocation(t *testing.T) {
+	testCases := []struct {
+		description     string
+		wrapperTypes    []deposits.WrapperType
+		nominals        []int64
+		amount          int64
+		isaAllowance    deposits.Money
+		expectedError   error
+		expectedAmounts []int64
+	}{
+		{
+			description:     "splits by nominal",
+			nominals:        []int64{300_00, 100_00},
+			amount:          200_00,
+			expectedAmounts: []int64{150_00, 50_00},
+		},
+		{
+			description:     "leftover goes to the largest remainders",
+			nominals:        []int64{100, 100, 100},
+			amount:          100,
+			expectedAmounts: []int64{34, 33, 33},
+		},
+		{
+			description:     "leftover goes to the largest remainder before pot order",
+			nominals:        []int64{100, 200},
+			amount:          1,
+			expectedAmounts: []int64{0, 1},
+		},
+		{
+			description:     "splits beyond nominal",
+			nominals:        []int64{100_00, 100_00},
+			amount:          500_00,
+			expectedAmounts: []int64{250_00, 250_00},
+		},
+		{
+			description:   "fails without nominal",
+			nominals:      []int64{},
+			amount:        100,
+			expectedError: deposits.ErrNoAccountsToAllocate,
+		},
+		{
+			description:     "splits within the allowance",
+			wrapperTypes:    []deposits.WrapperType{deposits.WrapperTypeISA, deposits.WrapperTypeGIA},
+			nominals:        []int64{100_00, 100_00},
+			amount:          100_00,
+			isaAllowance:    gbp(50_00),
+			expectedAmounts: []int64{50_00, 50_00},
+		},
+		{
+			description:     "caps the ISA by the remaining allowance",
+			wrapperTypes:    []deposits.WrapperType{deposits.WrapperTypeISA, deposits.WrapperTypeGIA, deposits.WrapperTypeSIPP},
+			nominals:        []int64{200_00, 100_00, 100_00},
+			amount:          200_00,
+			isaAllowance:    gbp(40_00),
+			expectedAmounts: []int64{40_00, 80_00, 80_00},
+		},
+		{
+			description:     "shares the allowance between ISAs",
+			wrapperTypes:    []deposits.WrapperType{deposits.WrapperTypeISA, deposits.WrapperTypeCashISA, deposits.WrapperTypeGIA},
+			nominals:        []int64{100_00, 100_00, 100_00},
+			amount:          300_00,
+			isaAllowance:    gbp(150_00),
+			expectedAmounts: []int64{75_00, 75_00, 150_00},
+		},
+		{
+			description:   "fails if the allowance is used up without other accounts",
+			wrapperTypes:  []deposits.WrapperType{deposits.WrapperTypeISA},
+			nominals:      []int64{100_00},
+			amount:        10_00,
+			isaAllowance:  gbp(0),
+			expectedError: deposits.ErrAllocationExceedsNominal,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			// Accounts are GIAs unless the case says otherwise
+			wrapperTypes := testCase.wrapperTypes
+			if wrapperTypes == nil {
+				for range testCase.nominals {
+					wrapperTypes = append(wrapperTypes, deposits.WrapperTypeGIA)
+				}
+			}
+			deposit := newAllocationDeposit(t, wrapperTypes, testCase.nominals)
+
+			// The whole allowance is left unless the case says otherwise
+			isaAllowance := testCase.isaAllowance
+			if isaAllowance == (deposits.Money{}) {
+				isaAllowance = deposits.ISAAnnualAllowance
+			}
+
+			allocations, err := deposits.ProportionalAllocation{}.Allocate(*deposit, deposits.AllocatedAmount{Money: gbp(testCase.amount)}, isaAllowance)
+			if testCase.expectedError != nil {
+				require.ErrorIs(t, err, testCase.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedAmounts, allocatedAmounts(deposit, allocations))
+			for _, allocation := range allocations {
+				require.NotZero(t, allocation.Amount.Amount)
+			}
+		})
+	}
+
+	t.Run("fails for a different currency", func(t *testing.T) {
+		deposit := newAllocationDeposit(t, []deposits.WrapperType{deposits.WrapperTypeGIA}, []int64{100})
+
+		_, err := deposits.ProportionalAllocation{}.Allocate(*deposit, deposits.AllocatedAmount{Money: eur(100)}, deposits.ISAAnnualAllowance)
+		require.ErrorIs(t, err, deposits.ErrCurrencyMismatch)
+	})
+}
+
+func TestWaterfallAllocation(t *testing.T) {
+	testCases := []struct {
+		description     string
+		wrapperTypes    []deposits.WrapperType
+		order           []deposits.WrapperType
+		amount          int64
+		isaAllowance    deposits.Money
+		expectedError   error
+		expectedAmounts []int64
+	}{
+		{
+			description:     "fills the ISA first",
+			wrapperTypes:    []deposits.WrapperType{deposits.WrapperTypeGIA, deposits.WrapperTypeISA},
+			order:           deposits.DefaultWaterfallOrder,
+			amount:          60_00,
+			expectedAmounts: []int64{0, 60_00},
+		},
+		{
+			description:     "overflows to the GIA",
+			wrapperTypes:    []deposits.WrapperType{deposits.WrapperTypeGIA, deposits.WrapperTypeISA},
+			order:           deposits.DefaultWaterfallOrder,
+			amount:          150_00,
+			expectedAmounts: []int64{50_00, 100_00},
+		},
+		{
+			description:     "leaves room for SIPP relief",
+			wrapperTypes:    []deposits.WrapperType{deposits.WrapperTypeISA, deposits.WrapperTypeSIPP, deposits.WrapperTypeGIA},
+			order:           deposits.DefaultWaterfallOrder,
+			amount:          200_00,
+			expectedAmounts: []int64{100_00, 80_00, 20_00},
+		},
+		{
+			description:     "follows the given order",
+			wrapperTypes:    []deposits.WrapperType{deposits.WrapperTypeISA, deposits.WrapperTypeGIA},
+			order:           []deposits.WrapperType{deposits.WrapperTypeGIA, deposits.WrapperTypeISA},
+			amount:          150_00,
+			expectedAmounts: []int64{0, 150_00},
+		},
+		{
+			description:     "caps the ISA by the remaining allowance",
+			wrapperTypes:    []deposits.WrapperType{deposits.WrapperTypeISA, deposits.WrapperTypeGIA},
+			order:           deposits.DefaultWaterfallOrder,
+			amount:          100_00,
+			isaAllowance:    gbp(40_00),
+			expectedAmounts: []int64{40_00, 60_00},
+		},
+		{
+			description:     "shares the allowance between ISAs",
+			wrapperTypes:    []deposits.WrapperType{deposits.WrapperTypeISA, deposits.WrapperTypeCashISA, deposits.WrapperTypeGIA},
+			order:           deposits.DefaultWaterfallOrder,
+			amount:          250_00,
+			isaAllowance:    gbp(150_00),
+			expectedAmounts: []int64{100_00, 50_00, 100_00},
+		},
+		{
+			description:   "fails if the allowance is used up without a GIA",
+			wrapperTypes:  []deposits.WrapperType{deposits.WrapperTypeISA},
+			order:         deposits.DefaultWaterfallOrder,
+			amount:        10_00,
+			isaAllowance:  gbp(0),
+			expectedError: deposits.ErrAllocationExceedsNominal,
+		},
+		{
+			description:   "fails if it doesn't fit",
+			wrapperTypes:  []deposits.WrapperType{deposits.WrapperTypeISA},
+			order:         deposits.DefaultWaterfallOrder,
+			amount:        100_01,
+			expectedError: deposits.ErrAllocationExceedsNominal,
+		},
+		{
+			description:   "fails if the order skips the GIA",
+			wrapperTypes:  []deposits.WrapperType{deposits.WrapperTypeISA, deposits.WrapperTypeGIA},
+			order:         []deposits.WrapperType{deposits.WrapperTypeISA},
+			amount:        150_00,
+			expectedError: deposits.ErrAllocationExceedsNominal,
+		},
+		{
+			description:   "fails without accounts",
+			wrapperTypes:  []deposits.WrapperType{},
+			order:         deposits.DefaultWaterfallOrder,
+			amount:        100,
+			expectedError: deposits.ErrNoAccountsToAllocate,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			nominals := []int64{}
+			for range testCase.wrapperTypes {
+				nominals = append(nominals, 100_00)
+			}
+			deposit := newAllocationDeposit(t, testCase.wrapperTypes, nominals)
+
+			// The whole allowance is left unless the case says otherwise
+			isaAllowance := testCase.isaAllowance
+			if isaAllowance == (deposits.Money{}) {
+				isaAllowance = deposits.ISAAnnualAllowance
+			}
+
+			rule := deposits.WaterfallAllocation{Order: testCase.order}
+			allocations, err := rule.Allocate(*deposit, deposits.AllocatedAmount{Money: gbp(testCase.amount)}, isaAllowance)
+			if testCase.expectedError != nil {
+				require.ErrorIs(t, err, testCase.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedAmounts, allocatedAmounts(deposit, allocations))
+		})
+	}
+}
+
+func TestDepositReceiptAddReceipt(t *testing.T) {
+	deposit := newAllocationDeposit(t, []deposits.WrapperType{deposits.WrapperTypeGIA}, []int64{100_00})
+	account := deposit.Pots[0].Accounts[0]
+
+	depositReceipt, err := deposits.NewDepositReceipt(deposit.Id, gbp(10_00))
+	require.NoError(t, err)
+
+	receipt, err := depositReceipt.AddReceipt(deposits.Allocation{AccountId: account.Id, Amount: deposits.AllocatedAmount{Money: gbp(10_00)}})
+	require.NoError(t, err)
+	require.Equal(t, account.Id, receipt.AccountId)
+	require.Equal(t, depositReceipt.Id, receipt.DepositReceiptId)
+	require.Equal(t, []*deposits.Receipt{receipt}, depositReceipt.Receipts)
+}
@@ -0,0 +1,81 @@
+package deposits
+
+import (
+	"errors"
+
+	"github.com/iainvm/deposits/internal/investors"
+)
+
+var ErrAnnualAllowanceExceeded = errors.New("annual ISA allowance exceeded")
+
+// ISAAnnualAllowance is the amount an investor can subscribe to ISAs in a tax year
+var ISAAnnualAllowance = Money{Amount: 20_000_00, Currency: CurrencyGBP}
+
+// ISAAllowance is an investor's ISA subscriptions for a single tax year
+type ISAAllowance struct {
+	InvestorId investors.InvestorId
+	TaxYear    TaxYear
+	Limit      Money
+	Used       Money
+}
+
+// ISASubscription is an entry in the allowance ledger, recording a receipt that used allowance
+type ISASubscription struct {
+	ReceiptId  ReceiptId
+	InvestorId investors.InvestorId
+	AccountId  AccountId
+	TaxYear    TaxYear
+	Amount     AllocatedAmount
+}
+
+// NewISAAllowance creates the investor's allowance for the tax year with the amount already used
+func NewISAAllowance(investorId investors.InvestorId, taxYear TaxYear, used Money) (*ISAAllowance, error) {
+	if used.IsNegative() {
+		return nil, ErrNegativeAmount
+	}
+	if used.Currency != ISAAnnualAllowance.Currency {
+		return nil, ErrCurrencyMismatch
+	}
+
+	return &ISAAllowance{
+		InvestorId: investorId,
+		TaxYear:    taxYear,
+		Limit:      ISAAnnualAllowance,
+		Used:       used,
+	}, nil
+}
+
+// Remaining returns how much of the allowance can still be subscribed
+func (allowance ISAAllowance) Remaining() Money {
+	remaining, err := allowance.Limit.Subtract(allowance.Used)
+	if err != nil || remaining.IsNegative() {
+		return Money{Currency: allowance.Limit.Currency}
+	}
+
+	return remaining
+}
+
+// Subscribe uses the allowance for the receipt, returning a ledger entry for it
+func (allowance *ISAAllowance) Subscribe(accountId AccountId, receipt Receipt) (*ISASubscription, error) {
+	comparison, err := receipt.AllocatedAmount.Compare(allowance.Remaining())
+	if err != nil {
+		return nil, err
+	}
+	if comparison > 0 {
+		return nil, ErrAnnualAllowanceExceeded
+	}
+
+	used, err := allowance.Used.Add(receipt.AllocatedAmount.Money)
+	if err != nil {
+		return nil, err
+	}
+	allowance.Used = used
+
+	return &ISASubscription{
+		ReceiptId:  receipt.Id,
+		InvestorId: allowance.InvestorId,
+		AccountId:  accountId,
+		TaxYear:    allowance.TaxYear,
+		Amount:     receipt.AllocatedAmount,
+	}, nil
+}
@@ -0,0 +1,64 @@
+package deposits_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/iainvm/deposits/internal/deposits"
+	"github.com/iainvm/deposits/internal/investors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewISAAllowance(t *testing.T) {
+	investorId := investors.InvestorId(uuid.NewString())
+
+	t.Run("successful data", func(t *testing.T) {
+		allowance, err := deposits.NewISAAllowance(investorId, 2024, gbp(5_000_00))
+		require.NoError(t, err)
+		require.Equal(t, &deposits.ISAAllowance{
+			InvestorId: investorId,
+			TaxYear:    2024,
+			Limit:      deposits.ISAAnnualAllowance,
+			Used:       gbp(5_000_00),
+		}, allowance)
+		require.Equal(t, gbp(15_000_00), allowance.Remaining())
+	})
+
+	t.Run("negative used", func(t *testing.T) {
+		_, err := deposits.NewISAAllowance(investorId, 2024, gbp(-1))
+		require.ErrorIs(t, err, deposits.ErrNegativeAmount)
+	})
+
+	t.Run("used in another currency", func(t *testing.T) {
+		_, err := deposits.NewISAAllowance(investorId, 2024, eur(1))
+		require.ErrorIs(t, err, deposits.ErrCurrencyMismatch)
+	})
+}
+
+func TestISAAllowanceSubscribe(t *testing.T) {
+	investorId := investors.InvestorId(uuid.NewString())
+	accountId := deposits.AccountId(uuid.NewString())
+
+	allowance, err := deposits.NewISAAllowance(investorId, 2024, gbp(15_000_00))
+	require.NoError(t, err)
+
+	receipt, err := deposits.NewReceipt(gbp(5_000_00))
+	require.NoError(t, err)
+
+	subscription, err := allowance.Subscribe(accountId, *receipt)
+	require.NoError(t, err)
+	require.Equal(t, &deposits.ISASubscription{
+		ReceiptId:  receipt.Id,
+		InvestorId: investorId,
+		AccountId:  accountId,
+		TaxYear:    2024,
+		Amount:     deposits.AllocatedAmount{Money: gbp(5_000_00)},
+	}, subscription)
+	require.Equal(t, gbp(0), allowance.Remaining())
+
+	receipt, err = deposits.NewReceipt(gbp(1))
+	require.NoError(t, err)
+
+	_, err = allowance.Subscribe(accountId, *receipt)
+	require.ErrorIs(t, err, deposits.ErrAnnualAllowanceExceeded)
+}
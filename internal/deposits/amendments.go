@@ -0,0 +1,131 @@
+package deposits
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrAccountHasReceipts = errors.New("account has receipts and can't be removed")
+)
+
+// Amendment is a change to a deposit after it's been created, see Service.UpdateDeposit
+type Amendment interface {
+	// amend applies the change to the deposit, then saves it with the repository
+	amend(ctx context.Context, repository Repository, deposit *Deposit) error
+}
+
+// AddPotAmendment adds a new pot, with any accounts it already has, to the deposit
+type AddPotAmendment struct {
+	Pot *Pot
+}
+
+func (amendment AddPotAmendment) amend(ctx context.Context, repository Repository, deposit *Deposit) error {
+	deposit.AddPot(amendment.Pot)
+
+	err := repository.SavePot(ctx, deposit.Id, *amendment.Pot)
+	if err != nil {
+		return err
+	}
+
+	for _, account := range amendment.Pot.Accounts {
+		err := repository.SaveAccount(ctx, amendment.Pot.Id, *account)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RenamePotAmendment gives one of the deposit's pots a new name
+type RenamePotAmendment struct {
+	PotId PotId
+	Name  string
+}
+
+func (amendment RenamePotAmendment) amend(ctx context.Context, repository Repository, deposit *Deposit) error {
+	pot, err := deposit.Pot(amendment.PotId)
+	if err != nil {
+		return err
+	}
+
+	err = pot.Rename(amendment.Name)
+	if err != nil {
+		return err
+	}
+
+	return repository.UpdatePot(ctx, *pot)
+}
+
+// AddAccountAmendment adds a new account to one of the deposit's pots, if the pot can take it
+type AddAccountAmendment struct {
+	PotId   PotId
+	Account *Account
+}
+
+func (amendment AddAccountAmendment) amend(ctx context.Context, repository Repository, deposit *Deposit) error {
+	pot, err := deposit.Pot(amendment.PotId)
+	if err != nil {
+		return err
+	}
+
+	err = pot.AddAccount(amendment.Account)
+	if err != nil {
+		return err
+	}
+
+	return repository.SaveAccount(ctx, pot.Id, *amendment.Account)
+}
+
+// ChangeNominalAmendment changes the nominal amount of one of the deposit's accounts
+type ChangeNominalAmendment struct {
+	AccountId     AccountId
+	NominalAmount Money
+}
+
+func (amendment ChangeNominalAmendment) amend(ctx context.Context, repository Repository, deposit *Deposit) error {
+	_, account, err := deposit.AccountPot(amendment.AccountId)
+	if err != nil {
+		return err
+	}
+
+	err = account.ChangeNominalAmount(amendment.NominalAmount)
+	if err != nil {
+		return err
+	}
+
+	return repository.UpdateAccount(ctx, *account)
+}
+
+// RemoveAccountAmendment removes one of the deposit's accounts, which is only allowed before it's received anything
+type RemoveAccountAmendment struct {
+	AccountId AccountId
+}
+
+func (amendment RemoveAccountAmendment) amend(ctx context.Context, repository Repository, deposit *Deposit) error {
+	pot, _, err := deposit.AccountPot(amendment.AccountId)
+	if err != nil {
+		return err
+	}
+
+	// Receipts, even reversed ones, are kept against the account
+	hasReceipts, err := repository.AccountHasReceipts(ctx, amendment.AccountId)
+	if err != nil {
+		return err
+	}
+	if hasReceipts {
+		return ErrAccountHasReceipts
+	}
+
+	account, err := pot.RemoveAccount(amendment.AccountId)
+	if err != nil {
+		return err
+	}
+	err = account.Remove()
+	if err != nil {
+		return err
+	}
+
+	return repository.RemoveAccount(ctx, *account)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"github.com/google/uuid"
+	"github.com/iainvm/deposits/internal/investors"
 )
 
 var (
@@ -11,13 +12,22 @@ var (
 	ErrWrapperTypeExistsInPot  = errors.New("pot already contains wrapper type")
 	ErrNominalAmountNegative   = errors.New("nominal amount cannot be negative value")
 	ErrAllocatedAmountNegative = errors.New("allocated amount cannot be negative value")
+	ErrDepositNotFound         = errors.New("deposit not found")
 )
 
 type DepositId string
 
 type Deposit struct {
-	Id   DepositId
-	Pots []*Pot
+	Id         DepositId
+	InvestorId investors.InvestorId
+	Status     DepositStatus
+	Pots       []*Pot
+	// SuspenseReceipts are the receipts held in the deposit's suspense balance, instead of on an account
+	SuspenseReceipts []*Receipt
+	// Version is the stored version the deposit was read at, status updates only succeed if it's unchanged
+	Version int64
+	// Events are the status changes made since the deposit was read, they're published once it's saved
+	Events []DepositStatusChanged
 }
 
 func newDepositId() (DepositId, error) {
@@ -52,22 +62,65 @@ func NewDeposit() (*Deposit, error) {
 
 	// Create Deposit
 	return &Deposit{
-		Id: id,
+		Id:     id,
+		Status: DepositStatusDraft,
 	}, nil
 }
 
 // ParseDeposit parses the given data into a Deposit type, ensuring it's valid data
-func ParseDeposit(id string) (*Deposit, error) {
+func ParseDeposit(id string, status string) (*Deposit, error) {
 	depositId, err := ParseDepositId(id)
 	if err != nil {
 		return nil, err
 	}
 
+	depositStatus, err := ParseDepositStatus(status)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Deposit{
-		Id: depositId,
+		Id:     depositId,
+		Status: depositStatus,
 	}, nil
 }
 
 func (deposit *Deposit) AddPot(pot *Pot) {
 	deposit.Pots = append(deposit.Pots, pot)
 }
+
+// Pot returns the deposit's pot with the id
+func (deposit Deposit) Pot(potId PotId) (*Pot, error) {
+	for _, pot := range deposit.Pots {
+		if pot.Id == potId {
+			return pot, nil
+		}
+	}
+
+	return nil, ErrPotNotFound
+}
+
+// AccountPot returns the deposit's account with the id, and the pot it's in
+func (deposit Deposit) AccountPot(accountId AccountId) (*Pot, *Account, error) {
+	for _, pot := range deposit.Pots {
+		for _, account := range pot.Accounts {
+			if account.Id == accountId {
+				return pot, account, nil
+			}
+		}
+	}
+
+	return nil, nil, ErrAccountNotInPot
+}
+
+// AccountIds returns the ids of every account in the deposit's pots
+func (deposit Deposit) AccountIds() []AccountId {
+	accountIds := []AccountId{}
+	for _, pot := range deposit.Pots {
+		for _, account := range pot.Accounts {
+			accountIds = append(accountIds, account.Id)
+		}
+	}
+
+	return accountIds
+}
@@ -9,11 +9,36 @@ import (
 )
 
 func TestParseDeposits(t *testing.T) {
-	_, err := deposits.ParseDeposit(uuid.NewString())
+	deposit, err := deposits.ParseDeposit(uuid.NewString(), "FUNDED")
 	require.NoError(t, err)
+	require.Equal(t, deposits.DepositStatusFunded, deposit.Status)
+
+	_, err = deposits.ParseDeposit(uuid.NewString(), "UNSPECIFIED")
+	require.ErrorIs(t, err, deposits.ErrInvalidDepositStatus)
 }
 
 func TestNewDeposits(t *testing.T) {
-	_, err := deposits.NewDeposit()
+	deposit, err := deposits.NewDeposit()
+	require.NoError(t, err)
+	require.Equal(t, deposits.DepositStatusDraft, deposit.Status)
+}
+
+func TestDepositAccountPot(t *testing.T) {
+	deposit := newTestDeposit(t, gbp(100), deposits.WrapperTypeISA, deposits.WrapperTypeGIA)
+	pot, gia := deposit.Pots[0], deposit.Pots[0].Accounts[1]
+
+	found, err := deposit.Pot(pot.Id)
 	require.NoError(t, err)
+	require.Equal(t, pot, found)
+
+	_, err = deposit.Pot(deposits.PotId(uuid.NewString()))
+	require.ErrorIs(t, err, deposits.ErrPotNotFound)
+
+	foundPot, foundAccount, err := deposit.AccountPot(gia.Id)
+	require.NoError(t, err)
+	require.Equal(t, pot, foundPot)
+	require.Equal(t, gia, foundAccount)
+
+	_, _, err = deposit.AccountPot(deposits.AccountId(uuid.NewString()))
+	require.ErrorIs(t, err, deposits.ErrAccountNotInPot)
 }
@@ -0,0 +1,129 @@
+package deposits
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/iainvm/deposits/internal/investors"
+)
+
+var ErrInvestorIneligible = errors.New("investor is not eligible for the deposit")
+
+// EligibilityFailure is a rule the investor fails for one of the deposit's accounts, or for the whole deposit when
+// AccountId is blank
+type EligibilityFailure struct {
+	AccountId AccountId
+	Err       error
+}
+
+// EligibilityError is every rule the investor fails for a deposit
+type EligibilityError struct {
+	Failures []EligibilityFailure
+}
+
+func (err *EligibilityError) Error() string {
+	messages := []string{}
+	for _, failure := range err.Failures {
+		message := failure.Err.Error()
+		if failure.AccountId != "" {
+			message = "account " + failure.AccountId.String() + ": " + message
+		}
+		messages = append(messages, message)
+	}
+
+	return ErrInvestorIneligible.Error() + ": " + strings.Join(messages, "; ")
+}
+
+// Unwrap lets errors.Is find ErrInvestorIneligible, and each of the rules failed
+func (err *EligibilityError) Unwrap() []error {
+	errs := []error{ErrInvestorIneligible}
+	for _, failure := range err.Failures {
+		errs = append(errs, failure.Err)
+	}
+
+	return errs
+}
+
+// ValidateInvestorEligibility checks the investor can hold every account in the deposit, returning an EligibilityError
+// with every rule they fail
+//
+// held is the wrapper types of the investor's accounts in their other deposits, and ages are taken on the UK date of
+// the given time
+func (deposit Deposit) ValidateInvestorEligibility(investor investors.Investor, held []WrapperType, on time.Time) error {
+	return deposit.validateEligibility(investor, held, deposit.AccountIds(), on)
+}
+
+// ValidateAddedAccountsEligibility checks the investor can hold the accounts added to the deposit, alongside the
+// deposit's other accounts, returning an EligibilityError with every rule they fail
+//
+// The deposit's other accounts aren't checked again, so a deposit can still be amended once the investor's aged out of
+// one of its wrappers
+func (deposit Deposit) ValidateAddedAccountsEligibility(investor investors.Investor, held []WrapperType, added []AccountId, on time.Time) error {
+	return deposit.validateEligibility(investor, held, added, on)
+}
+
+// validateEligibility checks the investor can hold the deposit's accounts in checked, the others are only counted as
+// already held
+func (deposit Deposit) validateEligibility(investor investors.Investor, held []WrapperType, checked []AccountId, on time.Time) error {
+	ukOn := on.In(ukLocation)
+	failures := []EligibilityFailure{}
+
+	err := investor.Profile.ValidateKYC(ukOn)
+	if err != nil {
+		failures = append(failures, EligibilityFailure{Err: err})
+	}
+
+	holding := slices.Clone(held)
+	for _, pot := range deposit.Pots {
+		for _, account := range pot.Accounts {
+			if !slices.Contains(checked, account.Id) {
+				holding = append(holding, account.WrapperType)
+			}
+		}
+	}
+
+	for _, pot := range deposit.Pots {
+		for _, account := range pot.Accounts {
+			if !slices.Contains(checked, account.Id) {
+				continue
+			}
+
+			policy, err := LookupWrapperPolicy(account.WrapperType)
+			if err != nil {
+				return err
+			}
+
+			err = policy.ValidateInvestor(investor, ukOn)
+			for _, err := range splitJoined(err) {
+				failures = append(failures, EligibilityFailure{AccountId: account.Id, Err: err})
+			}
+
+			if policy.SingleAccount() && slices.Contains(holding, account.WrapperType) {
+				failures = append(failures, EligibilityFailure{AccountId: account.Id, Err: ErrWrapperAlreadyHeld})
+			}
+			holding = append(holding, account.WrapperType)
+		}
+	}
+
+	if len(failures) > 0 {
+		return &EligibilityError{Failures: failures}
+	}
+
+	return nil
+}
+
+// splitJoined returns each of the errors joined into err, so each rule failed is its own failure
+func splitJoined(err error) []error {
+	if err == nil {
+		return nil
+	}
+
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		return []error{err}
+	}
+
+	return joined.Unwrap()
+}
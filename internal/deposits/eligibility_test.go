@@ -0,0 +1,166 @@
+package deposits_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iainvm/deposits/internal/deposits"
+	"github.com/iainvm/deposits/internal/investors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateInvestorEligibility(t *testing.T) {
+	// Midday in the UK on 1 June 2024
+	on := time.Date(2024, time.June, 1, 11, 0, 0, 0, time.UTC)
+	id := investors.InvestorId(uuid.NewString())
+
+	overseas := newTestInvestor(id, "1990-01-31")
+	overseas.Profile.TaxResidency = "FR"
+	unverified := newTestInvestor(id, "1990-01-31")
+	unverified.Profile.Address = investors.Address{}
+
+	testCases := []struct {
+		description  string
+		investor     *investors.Investor
+		wrapperTypes []deposits.WrapperType
+		held         []deposits.WrapperType
+		expectedErrs []error
+	}{
+		{
+			description:  "eligible adult",
+			investor:     newTestInvestor(id, "1990-01-31"),
+			wrapperTypes: []deposits.WrapperType{deposits.WrapperTypeGIA, deposits.WrapperTypeISA, deposits.WrapperTypeSIPP},
+		},
+		{
+			description:  "eligible child",
+			investor:     newTestInvestor(id, "2015-01-31"),
+			wrapperTypes: []deposits.WrapperType{deposits.WrapperTypeJuniorISA, deposits.WrapperTypeSIPP},
+		},
+		{
+			description:  "turns 18 on the day",
+			investor:     newTestInvestor(id, "2006-06-01"),
+			wrapperTypes: []deposits.WrapperType{deposits.WrapperTypeISA},
+		},
+		{
+			description:  "child too young for an ISA",
+			investor:     newTestInvestor(id, "2006-06-02"),
+			wrapperTypes: []deposits.WrapperType{deposits.WrapperTypeISA},
+			expectedErrs: []error{deposits.ErrInvestorTooYoung},
+		},
+		{
+			description:  "adult too old for a junior ISA",
+			investor:     newTestInvestor(id, "2006-06-01"),
+			wrapperTypes: []deposits.WrapperType{deposits.WrapperTypeJuniorISA},
+			expectedErrs: []error{deposits.ErrInvestorTooOld},
+		},
+		{
+			description:  "too old for a SIPP",
+			investor:     newTestInvestor(id, "1949-01-01"),
+			wrapperTypes: []deposits.WrapperType{deposits.WrapperTypeSIPP},
+			expectedErrs: []error{deposits.ErrInvestorTooOld},
+		},
+		{
+			description:  "ISA outside the UK",
+			investor:     overseas,
+			wrapperTypes: []deposits.WrapperType{deposits.WrapperTypeGIA, deposits.WrapperTypeISA},
+			expectedErrs: []error{deposits.ErrInvestorNotUKResident},
+		},
+		{
+			description:  "junior ISA already held",
+			investor:     newTestInvestor(id, "2015-01-31"),
+			wrapperTypes: []deposits.WrapperType{deposits.WrapperTypeJuniorISA},
+			held:         []deposits.WrapperType{deposits.WrapperTypeJuniorISA},
+			expectedErrs: []error{deposits.ErrWrapperAlreadyHeld},
+		},
+		{
+			description:  "incomplete KYC",
+			investor:     unverified,
+			wrapperTypes: []deposits.WrapperType{deposits.WrapperTypeGIA},
+			expectedErrs: []error{investors.ErrKYCIncomplete},
+		},
+		{
+			description:  "every failure",
+			investor:     &investors.Investor{Id: id, Name: "Unknown"},
+			wrapperTypes: []deposits.WrapperType{deposits.WrapperTypeJuniorISA},
+			held:         []deposits.WrapperType{deposits.WrapperTypeJuniorISA},
+			expectedErrs: []error{investors.ErrKYCIncomplete, deposits.ErrWrapperAlreadyHeld},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			deposit := newTestDeposit(t, gbp(100), testCase.wrapperTypes...)
+
+			err := deposit.ValidateInvestorEligibility(*testCase.investor, testCase.held, on)
+			if len(testCase.expectedErrs) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			var eligibilityErr *deposits.EligibilityError
+			require.ErrorAs(t, err, &eligibilityErr)
+			require.ErrorIs(t, err, deposits.ErrInvestorIneligible)
+			require.Len(t, eligibilityErr.Failures, len(testCase.expectedErrs))
+			for i, expectedErr := range testCase.expectedErrs {
+				require.ErrorIs(t, eligibilityErr.Failures[i].Err, expectedErr)
+			}
+		})
+	}
+}
+
+func TestValidateInvestorEligibilityAcrossPots(t *testing.T) {
+	child := newTestInvestor(investors.InvestorId(uuid.NewString()), "2015-01-31")
+
+	// Junior ISAs in different pots still count as two
+	deposit := newTestDeposit(t, gbp(100), deposits.WrapperTypeJuniorISA)
+	pot, err := deposits.NewPot("Pot B")
+	require.NoError(t, err)
+	account, err := deposits.NewAccount(deposits.WrapperTypeJuniorISA, gbp(100))
+	require.NoError(t, err)
+	require.NoError(t, pot.AddAccount(account))
+	deposit.AddPot(pot)
+
+	err = deposit.ValidateInvestorEligibility(*child, nil, time.Now())
+	var eligibilityErr *deposits.EligibilityError
+	require.True(t, errors.As(err, &eligibilityErr))
+	require.Equal(t, []deposits.EligibilityFailure{
+		{AccountId: account.Id, Err: deposits.ErrWrapperAlreadyHeld},
+	}, eligibilityErr.Failures)
+}
+
+func TestValidateAddedAccountsEligibility(t *testing.T) {
+	// The investor turned 18 after their junior ISA was opened
+	on := time.Date(2024, time.June, 1, 11, 0, 0, 0, time.UTC)
+	adult := newTestInvestor(investors.InvestorId(uuid.NewString()), "2006-01-31")
+
+	deposit := newTestDeposit(t, gbp(100), deposits.WrapperTypeJuniorISA)
+	gia, err := deposits.NewAccount(deposits.WrapperTypeGIA, gbp(100))
+	require.NoError(t, err)
+	require.NoError(t, deposit.Pots[0].AddAccount(gia))
+
+	t.Run("only checks the added accounts", func(t *testing.T) {
+		err := deposit.ValidateAddedAccountsEligibility(*adult, nil, []deposits.AccountId{gia.Id}, on)
+		require.NoError(t, err)
+	})
+
+	t.Run("counts the deposit's other accounts as held", func(t *testing.T) {
+		child := newTestInvestor(adult.Id, "2015-01-31")
+		pot, err := deposits.NewPot("Pot B")
+		require.NoError(t, err)
+		junior, err := deposits.NewAccount(deposits.WrapperTypeJuniorISA, gbp(100))
+		require.NoError(t, err)
+		require.NoError(t, pot.AddAccount(junior))
+		amended := *deposit
+		amended.Pots = append(slices.Clone(deposit.Pots), pot)
+
+		err = amended.ValidateAddedAccountsEligibility(*child, nil, []deposits.AccountId{junior.Id}, on)
+		var eligibilityErr *deposits.EligibilityError
+		require.True(t, errors.As(err, &eligibilityErr))
+		require.Equal(t, []deposits.EligibilityFailure{
+			{AccountId: junior.Id, Err: deposits.ErrWrapperAlreadyHeld},
+		}, eligibilityErr.Failures)
+	})
+}
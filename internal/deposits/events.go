@@ -0,0 +1,254 @@
+package deposits
+
+import (
+	"errors"
+)
+
+var (
+	ErrEventOutOfSequence   = errors.New("account event out of sequence")
+	ErrAccountNotOpened     = errors.New("account event before the account was opened")
+	ErrAccountAlreadyOpened = errors.New("account already opened")
+	ErrAccountRemoved       = errors.New("account event after the account was removed")
+	ErrInvalidAccountEvent  = errors.New("invalid account event type given")
+)
+
+// SnapshotInterval is how many events an account's stream grows by between snapshots of its state
+const SnapshotInterval = 100
+
+// AccountEventType is the code an AccountEvent is stored with
+type AccountEventType string
+
+const (
+	AccountEventTypeAccountOpened    AccountEventType = "ACCOUNT_OPENED"
+	AccountEventTypeNominalChanged   AccountEventType = "NOMINAL_CHANGED"
+	AccountEventTypeReceiptAllocated AccountEventType = "RECEIPT_ALLOCATED"
+	AccountEventTypeReceiptReversed  AccountEventType = "RECEIPT_REVERSED"
+	AccountEventTypeReliefApplied    AccountEventType = "RELIEF_APPLIED"
+	AccountEventTypeReliefReleased   AccountEventType = "RELIEF_RELEASED"
+	AccountEventTypeAccountRemoved   AccountEventType = "ACCOUNT_REMOVED"
+)
+
+func (eventType AccountEventType) String() string {
+	return string(eventType)
+}
+
+// AccountEvent is something that happened to an account, the account's state is what you get applying them in order
+//
+// Events have already happened, so applying one only fails if it can't be applied at all, not if it breaks a rule
+// the account checked when it was recorded
+type AccountEvent interface {
+	Type() AccountEventType
+	apply(account *Account) error
+}
+
+// RecordedEvent is an AccountEvent at its place in the account's stream, sequences start at 1 and have no gaps
+type RecordedEvent struct {
+	Sequence int64
+	Event    AccountEvent
+}
+
+// AccountOpened starts an account's stream
+//
+// Accounts opened before events were kept start with the balances they had, new accounts open with nothing allocated
+type AccountOpened struct {
+	WrapperType          WrapperType
+	NominalAmount        NominalAmount
+	TotalAllocatedAmount TotalAllocatedAmount
+	PendingReliefAmount  TotalAllocatedAmount
+}
+
+func (event AccountOpened) Type() AccountEventType {
+	return AccountEventTypeAccountOpened
+}
+
+func (event AccountOpened) apply(account *Account) error {
+	if account.WrapperType != 0 {
+		return ErrAccountAlreadyOpened
+	}
+
+	account.WrapperType = event.WrapperType
+	account.NominalAmount = event.NominalAmount
+	account.TotalAllocatedAmount = event.TotalAllocatedAmount
+	account.PendingReliefAmount = event.PendingReliefAmount
+	return nil
+}
+
+// NominalChanged replaces the account's nominal
+type NominalChanged struct {
+	NominalAmount NominalAmount
+}
+
+func (event NominalChanged) Type() AccountEventType {
+	return AccountEventTypeNominalChanged
+}
+
+func (event NominalChanged) apply(account *Account) error {
+	account.NominalAmount = event.NominalAmount
+	return nil
+}
+
+// ReceiptAllocated adds a receipt to the account, reserving the relief due on it
+type ReceiptAllocated struct {
+	ReceiptId ReceiptId
+	Amount    AllocatedAmount
+	Relief    AllocatedAmount
+}
+
+func (event ReceiptAllocated) Type() AccountEventType {
+	return AccountEventTypeReceiptAllocated
+}
+
+func (event ReceiptAllocated) apply(account *Account) error {
+	total, err := account.TotalAllocatedAmount.Add(event.Amount.Money)
+	if err != nil {
+		return err
+	}
+	pending, err := account.PendingReliefAmount.Add(event.Relief.Money)
+	if err != nil {
+		return err
+	}
+
+	account.TotalAllocatedAmount = TotalAllocatedAmount{total}
+	account.PendingReliefAmount = TotalAllocatedAmount{pending}
+	return nil
+}
+
+// ReceiptReversed takes a receipt back off the account
+type ReceiptReversed struct {
+	ReceiptId ReceiptId
+	Amount    AllocatedAmount
+}
+
+func (event ReceiptReversed) Type() AccountEventType {
+	return AccountEventTypeReceiptReversed
+}
+
+func (event ReceiptReversed) apply(account *Account) error {
+	total, err := account.TotalAllocatedAmount.Subtract(event.Amount.Money)
+	if err != nil {
+		return err
+	}
+
+	account.TotalAllocatedAmount = TotalAllocatedAmount{total}
+	return nil
+}
+
+// ReliefApplied moves relief that was pending on the account to allocated, when it's received as its own receipt
+type ReliefApplied struct {
+	ReceiptId ReceiptId
+	Amount    AllocatedAmount
+}
+
+func (event ReliefApplied) Type() AccountEventType {
+	return AccountEventTypeReliefApplied
+}
+
+func (event ReliefApplied) apply(account *Account) error {
+	pending, err := account.PendingReliefAmount.Subtract(event.Amount.Money)
+	if err != nil {
+		return err
+	}
+	total, err := account.TotalAllocatedAmount.Add(event.Amount.Money)
+	if err != nil {
+		return err
+	}
+
+	account.PendingReliefAmount = TotalAllocatedAmount{pending}
+	account.TotalAllocatedAmount = TotalAllocatedAmount{total}
+	return nil
+}
+
+// ReliefReleased gives up relief that was pending on the account
+type ReliefReleased struct {
+	Amount AllocatedAmount
+}
+
+func (event ReliefReleased) Type() AccountEventType {
+	return AccountEventTypeReliefReleased
+}
+
+func (event ReliefReleased) apply(account *Account) error {
+	pending, err := account.PendingReliefAmount.Subtract(event.Amount.Money)
+	if err != nil {
+		return err
+	}
+
+	account.PendingReliefAmount = TotalAllocatedAmount{pending}
+	return nil
+}
+
+// AccountRemoved ends an account's stream, when it's taken out of its deposit before receiving anything
+type AccountRemoved struct{}
+
+func (event AccountRemoved) Type() AccountEventType {
+	return AccountEventTypeAccountRemoved
+}
+
+func (event AccountRemoved) apply(account *Account) error {
+	account.Removed = true
+	return nil
+}
+
+// RestoreAccount folds the events into the snapshot, or into an unopened account if there isn't one
+//
+// The events must carry on the stream from the snapshot, or start it if there's no snapshot. Removed accounts aren't
+// restored, their streams are only kept as a record of them
+func RestoreAccount(accountId AccountId, snapshot *Account, events []RecordedEvent) (*Account, error) {
+	account := &Account{Id: accountId}
+	if snapshot != nil {
+		account.WrapperType = snapshot.WrapperType
+		account.NominalAmount = snapshot.NominalAmount
+		account.TotalAllocatedAmount = snapshot.TotalAllocatedAmount
+		account.PendingReliefAmount = snapshot.PendingReliefAmount
+		account.Version = snapshot.Version
+	}
+
+	for _, event := range events {
+		if event.Sequence != account.Version+1 {
+			return nil, ErrEventOutOfSequence
+		}
+		_, opening := event.Event.(AccountOpened)
+		if account.WrapperType == 0 && !opening {
+			return nil, ErrAccountNotOpened
+		}
+		if account.Removed {
+			return nil, ErrAccountRemoved
+		}
+
+		err := event.Event.apply(account)
+		if err != nil {
+			return nil, err
+		}
+		account.Version = event.Sequence
+	}
+
+	// Nothing has opened the account, or it's been removed
+	if account.WrapperType == 0 || account.Removed {
+		return nil, ErrAccountNotFound
+	}
+
+	return account, nil
+}
+
+// record applies the event to the account, keeping it to be saved with the account
+func (account *Account) record(event AccountEvent) error {
+	sequence := account.LatestVersion() + 1
+	err := event.apply(account)
+	if err != nil {
+		return err
+	}
+
+	account.Events = append(account.Events, RecordedEvent{Sequence: sequence, Event: event})
+	return nil
+}
+
+// LatestVersion is the version the account is at once its events are saved
+func (account Account) LatestVersion() int64 {
+	return account.Version + int64(len(account.Events))
+}
+
+// SnapshotDue reports if saving the account's events takes its stream past a multiple of SnapshotInterval, removed
+// accounts aren't snapshotted as they're never restored
+func (account Account) SnapshotDue() bool {
+	return !account.Removed && account.Version/SnapshotInterval != account.LatestVersion()/SnapshotInterval
+}
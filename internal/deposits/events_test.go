@@ -0,0 +1,218 @@
+package deposits_test
+
+import (
+	"testing"
+
+	"github.com/iainvm/deposits/internal/deposits"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestStream opens a SIPP, adds a receipt, applies its relief, and reverses another, returning the account and its
+// events
+func newTestStream(t *testing.T) (*deposits.Account, []deposits.RecordedEvent) {
+	account, err := deposits.NewAccount(deposits.WrapperTypeSIPP, gbp(100_00))
+	require.NoError(t, err)
+
+	receipt, err := deposits.NewReceipt(gbp(40_00))
+	require.NoError(t, err)
+	require.NoError(t, account.AddReceipt(receipt))
+
+	relief, err := deposits.NewReceipt(gbp(10_00))
+	require.NoError(t, err)
+	require.NoError(t, account.ApplyTaxRelief(relief))
+
+	bounced, err := deposits.NewReceipt(gbp(8_00))
+	require.NoError(t, err)
+	require.NoError(t, account.AddReceipt(bounced))
+	require.NoError(t, account.ReverseReceipt(*bounced))
+	require.NoError(t, account.ReleaseTaxRelief(deposits.AllocatedAmount{Money: gbp(2_00)}))
+
+	require.NoError(t, account.ChangeNominalAmount(gbp(90_00)))
+
+	return account, account.Events
+}
+
+func TestAccountEvents(t *testing.T) {
+	account, events := newTestStream(t)
+
+	types := []deposits.AccountEventType{}
+	for i, event := range events {
+		require.Equal(t, int64(i+1), event.Sequence)
+		types = append(types, event.Event.Type())
+	}
+	require.Equal(t, []deposits.AccountEventType{
+		deposits.AccountEventTypeAccountOpened,
+		deposits.AccountEventTypeReceiptAllocated,
+		deposits.AccountEventTypeReliefApplied,
+		deposits.AccountEventTypeReceiptAllocated,
+		deposits.AccountEventTypeReceiptReversed,
+		deposits.AccountEventTypeReliefReleased,
+		deposits.AccountEventTypeNominalChanged,
+	}, types)
+
+	require.Equal(t, int64(0), account.Version)
+	require.Equal(t, int64(7), account.LatestVersion())
+	require.Equal(t, gbp(50_00), account.TotalAllocatedAmount.Money)
+	require.Equal(t, gbp(0), account.PendingReliefAmount.Money)
+	require.Equal(t, gbp(90_00), account.NominalAmount.Money)
+}
+
+func TestAccountEventsNotRecordedOnFailure(t *testing.T) {
+	account, err := deposits.NewAccount(deposits.WrapperTypeISA, gbp(100))
+	require.NoError(t, err)
+
+	receipt, err := deposits.NewReceipt(gbp(101))
+	require.NoError(t, err)
+	err = account.AddReceipt(receipt)
+	require.ErrorIs(t, err, deposits.ErrNominalExceeded)
+
+	require.Len(t, account.Events, 1)
+	require.Equal(t, gbp(0), account.TotalAllocatedAmount.Money)
+}
+
+func TestRestoreAccount(t *testing.T) {
+	t.Run("folds the whole stream", func(t *testing.T) {
+		account, events := newTestStream(t)
+
+		restored, err := deposits.RestoreAccount(account.Id, nil, events)
+		require.NoError(t, err)
+		require.Equal(t, account.Id, restored.Id)
+		require.Equal(t, account.WrapperType, restored.WrapperType)
+		require.Equal(t, account.NominalAmount, restored.NominalAmount)
+		require.Equal(t, account.TotalAllocatedAmount, restored.TotalAllocatedAmount)
+		require.Equal(t, account.PendingReliefAmount, restored.PendingReliefAmount)
+		require.Equal(t, account.LatestVersion(), restored.Version)
+		require.Empty(t, restored.Events)
+	})
+
+	t.Run("folds the events after a snapshot", func(t *testing.T) {
+		account, events := newTestStream(t)
+
+		snapshot, err := deposits.RestoreAccount(account.Id, nil, events[:3])
+		require.NoError(t, err)
+		restored, err := deposits.RestoreAccount(account.Id, snapshot, events[3:])
+		require.NoError(t, err)
+
+		full, err := deposits.RestoreAccount(account.Id, nil, events)
+		require.NoError(t, err)
+		require.Equal(t, full, restored)
+	})
+
+	t.Run("returns a snapshot without events", func(t *testing.T) {
+		account, events := newTestStream(t)
+
+		snapshot, err := deposits.RestoreAccount(account.Id, nil, events)
+		require.NoError(t, err)
+		restored, err := deposits.RestoreAccount(account.Id, snapshot, nil)
+		require.NoError(t, err)
+		require.Equal(t, snapshot, restored)
+	})
+
+	t.Run("fails for gaps in the stream", func(t *testing.T) {
+		account, events := newTestStream(t)
+
+		_, err := deposits.RestoreAccount(account.Id, nil, append(events[:2:2], events[3:]...))
+		require.ErrorIs(t, err, deposits.ErrEventOutOfSequence)
+	})
+
+	t.Run("fails for events that don't carry on from the snapshot", func(t *testing.T) {
+		account, events := newTestStream(t)
+
+		snapshot, err := deposits.RestoreAccount(account.Id, nil, events[:3])
+		require.NoError(t, err)
+		_, err = deposits.RestoreAccount(account.Id, snapshot, events[2:])
+		require.ErrorIs(t, err, deposits.ErrEventOutOfSequence)
+	})
+
+	t.Run("fails for a stream that isn't opened", func(t *testing.T) {
+		account, events := newTestStream(t)
+		events[0].Event = deposits.NominalChanged{NominalAmount: deposits.NominalAmount{Money: gbp(100_00)}}
+
+		_, err := deposits.RestoreAccount(account.Id, nil, events)
+		require.ErrorIs(t, err, deposits.ErrAccountNotOpened)
+	})
+
+	t.Run("fails for an account opened twice", func(t *testing.T) {
+		account, events := newTestStream(t)
+		events = append(events, deposits.RecordedEvent{Sequence: int64(len(events) + 1), Event: events[0].Event})
+
+		_, err := deposits.RestoreAccount(account.Id, nil, events)
+		require.ErrorIs(t, err, deposits.ErrAccountAlreadyOpened)
+	})
+
+	t.Run("doesn't restore removed accounts", func(t *testing.T) {
+		account, _ := newTestStream(t)
+		require.NoError(t, account.Remove())
+
+		_, err := deposits.RestoreAccount(account.Id, nil, account.Events)
+		require.ErrorIs(t, err, deposits.ErrAccountNotFound)
+		require.False(t, account.SnapshotDue())
+	})
+
+	t.Run("fails for events after the account's removed", func(t *testing.T) {
+		account, _ := newTestStream(t)
+		require.NoError(t, account.Remove())
+		require.NoError(t, account.ChangeNominalAmount(gbp(80_00)))
+
+		_, err := deposits.RestoreAccount(account.Id, nil, account.Events)
+		require.ErrorIs(t, err, deposits.ErrAccountRemoved)
+	})
+
+	t.Run("fails for an empty stream", func(t *testing.T) {
+		account, _ := newTestStream(t)
+
+		_, err := deposits.RestoreAccount(account.Id, nil, nil)
+		require.ErrorIs(t, err, deposits.ErrAccountNotFound)
+	})
+}
+
+func TestSnapshotDue(t *testing.T) {
+	testCases := []struct {
+		description string
+		version     int64
+		events      int
+		expected    bool
+	}{
+		{
+			description: "not due within an interval",
+			version:     1,
+			events:      5,
+			expected:    false,
+		},
+		{
+			description: "due reaching the interval",
+			version:     deposits.SnapshotInterval - 1,
+			events:      1,
+			expected:    true,
+		},
+		{
+			description: "due passing the interval",
+			version:     deposits.SnapshotInterval - 1,
+			events:      3,
+			expected:    true,
+		},
+		{
+			description: "not due just after the interval",
+			version:     deposits.SnapshotInterval,
+			events:      1,
+			expected:    false,
+		},
+		{
+			description: "not due without events",
+			version:     deposits.SnapshotInterval - 1,
+			events:      0,
+			expected:    false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			account := deposits.Account{
+				Version: testCase.version,
+				Events:  make([]deposits.RecordedEvent, testCase.events),
+			}
+
+			require.Equal(t, testCase.expected, account.SnapshotDue())
+		})
+	}
+}
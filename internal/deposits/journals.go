@@ -0,0 +1,63 @@
+package deposits
+
+import (
+	"context"
+	"time"
+
+	"github.com/iainvm/deposits/internal/ledger"
+)
+
+// investorLiability is the ledger account for what's owed to investors in the wrapper
+func investorLiability(wrapperType WrapperType) ledger.AccountCode {
+	return ledger.InvestorLiability(wrapperType.String())
+}
+
+// receiptJournal moves the receipt's cash to the ledger account it's owed on, from the client money bank it was paid
+// into, or from suspense if it was allocated from an unallocated receipt
+//
+// Receipts for nothing don't move any cash, so don't have a journal
+func receiptJournal(receipt Receipt, owedOn ledger.AccountCode, postedAt time.Time) (*ledger.Journal, error) {
+	amount := receipt.AllocatedAmount
+	if amount.IsZero() {
+		return nil, nil
+	}
+
+	from := ledger.AccountClientMoneyBank
+	if receipt.AllocatedFrom != "" {
+		from = ledger.AccountSuspense
+	}
+
+	currency := amount.Currency.String()
+	return ledger.NewJournal(
+		receipt.Id.String(),
+		"receipt",
+		postedAt,
+		ledger.Debit(from, currency, amount.Amount),
+		ledger.Credit(owedOn, currency, amount.Amount),
+	)
+}
+
+// postReceipt saves the receipt's journal, posted when the payment was received, in the repository's transaction
+func postReceipt(ctx context.Context, repository Repository, receipt Receipt, owedOn ledger.AccountCode) error {
+	journal, err := receiptJournal(receipt, owedOn, receipt.ReceivedAt)
+	if err != nil || journal == nil {
+		return err
+	}
+
+	return repository.SaveJournal(ctx, *journal)
+}
+
+// postReversal saves a journal undoing the receipt's journal, in the repository's transaction
+func postReversal(ctx context.Context, repository Repository, reversal Reversal, receipt Receipt, owedOn ledger.AccountCode) error {
+	journal, err := receiptJournal(receipt, owedOn, reversal.CreatedAt)
+	if err != nil || journal == nil {
+		return err
+	}
+
+	reversalJournal, err := journal.Reversal(reversal.Id.String(), "reversal", reversal.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	return repository.SaveJournal(ctx, *reversalJournal)
+}
@@ -0,0 +1,145 @@
+package deposits
+
+import "errors"
+
+var (
+	ErrInvalidDepositStatus     = errors.New("invalid deposit status given")
+	ErrInvalidDepositTransition = errors.New("deposit can't move to that status from its current status")
+	// ErrDepositClosed is returned for receipts against a deposit that's closed or cancelled
+	ErrDepositClosed = errors.New("deposit is closed or cancelled")
+)
+
+type DepositStatus string
+
+const (
+	// DepositStatusDraft is a deposit being built that hasn't been created yet
+	DepositStatusDraft DepositStatus = "DRAFT"
+	// DepositStatusOpen is a created deposit that hasn't received anything
+	DepositStatusOpen DepositStatus = "OPEN"
+	// DepositStatusPartiallyFunded is an open deposit with receipts, but accounts still short of their nominal
+	DepositStatusPartiallyFunded DepositStatus = "PARTIALLY_FUNDED"
+	// DepositStatusFunded is an open deposit with every account at its nominal
+	DepositStatusFunded DepositStatus = "FUNDED"
+	// DepositStatusClosed is a deposit that's finished, it can't receive anything more
+	DepositStatusClosed DepositStatus = "CLOSED"
+	// DepositStatusCancelled is a deposit that was abandoned before receiving anything
+	DepositStatusCancelled DepositStatus = "CANCELLED"
+)
+
+func ParseDepositStatus(status string) (DepositStatus, error) {
+	switch DepositStatus(status) {
+	case DepositStatusDraft, DepositStatusOpen, DepositStatusPartiallyFunded, DepositStatusFunded, DepositStatusClosed, DepositStatusCancelled:
+		return DepositStatus(status), nil
+	}
+
+	return "", ErrInvalidDepositStatus
+}
+
+func (status DepositStatus) String() string {
+	return string(status)
+}
+
+// IsReceiving is true for the statuses that accept receipts
+func (status DepositStatus) IsReceiving() bool {
+	switch status {
+	case DepositStatusOpen, DepositStatusPartiallyFunded, DepositStatusFunded:
+		return true
+	}
+
+	return false
+}
+
+// Open moves a draft deposit to open, ready to receive
+func (deposit *Deposit) Open() error {
+	if deposit.Status != DepositStatusDraft {
+		return ErrInvalidDepositTransition
+	}
+
+	deposit.changeStatus(DepositStatusOpen)
+	return nil
+}
+
+// Cancel abandons a deposit that hasn't received anything
+func (deposit *Deposit) Cancel() error {
+	if deposit.Status != DepositStatusDraft && deposit.Status != DepositStatusOpen {
+		return ErrInvalidDepositTransition
+	}
+
+	deposit.changeStatus(DepositStatusCancelled)
+	return nil
+}
+
+// Close finishes a deposit that's received something, after which it can't receive anything more
+func (deposit *Deposit) Close() error {
+	if deposit.Status != DepositStatusPartiallyFunded && deposit.Status != DepositStatusFunded {
+		return ErrInvalidDepositTransition
+	}
+
+	deposit.changeStatus(DepositStatusClosed)
+	return nil
+}
+
+// ValidateReceiving checks the deposit can receive receipts
+func (deposit Deposit) ValidateReceiving() error {
+	if !deposit.Status.IsReceiving() {
+		return ErrDepositClosed
+	}
+
+	return nil
+}
+
+// UpdateFundingStatus moves a receiving deposit between open, partially funded and funded to match its accounts,
+// returning true if the status changed
+//
+// Accounts are funded once their allocated amount, with any relief pending on it, reaches their nominal
+func (deposit *Deposit) UpdateFundingStatus() (bool, error) {
+	if !deposit.Status.IsReceiving() {
+		return false, nil
+	}
+
+	received := false
+	funded := true
+	for _, pot := range deposit.Pots {
+		for _, account := range pot.Accounts {
+			gross, err := account.TotalAllocatedAmount.Add(account.PendingReliefAmount.Money)
+			if err != nil {
+				return false, err
+			}
+			comparison, err := gross.Compare(account.NominalAmount.Money)
+			if err != nil {
+				return false, err
+			}
+
+			received = received || !gross.IsZero()
+			funded = funded && comparison >= 0
+		}
+	}
+
+	status := DepositStatusOpen
+	switch {
+	case received && funded:
+		status = DepositStatusFunded
+	case received:
+		status = DepositStatusPartiallyFunded
+	}
+
+	changed := status != deposit.Status
+	deposit.changeStatus(status)
+	return changed, nil
+}
+
+// DepositStatusChanged is a deposit moving from one status to another
+type DepositStatusChanged struct {
+	From DepositStatus
+	To   DepositStatus
+}
+
+// changeStatus moves the deposit to the status, recording the change if it's to a different one
+func (deposit *Deposit) changeStatus(status DepositStatus) {
+	if status == deposit.Status {
+		return
+	}
+
+	deposit.Events = append(deposit.Events, DepositStatusChanged{From: deposit.Status, To: status})
+	deposit.Status = status
+}
@@ -0,0 +1,255 @@
+package deposits_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/iainvm/deposits/internal/deposits"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDepositStatus(t *testing.T) {
+	testCases := []struct {
+		description   string
+		input         string
+		expectedError error
+		expectedValue deposits.DepositStatus
+	}{
+		{
+			description:   "passes for DRAFT",
+			input:         "DRAFT",
+			expectedValue: deposits.DepositStatusDraft,
+		},
+		{
+			description:   "passes for PARTIALLY_FUNDED",
+			input:         "PARTIALLY_FUNDED",
+			expectedValue: deposits.DepositStatusPartiallyFunded,
+		},
+		{
+			description:   "passes for CANCELLED",
+			input:         "CANCELLED",
+			expectedValue: deposits.DepositStatusCancelled,
+		},
+		{
+			description:   "fails for unknown status",
+			input:         "UNSPECIFIED",
+			expectedError: deposits.ErrInvalidDepositStatus,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			actualValue, actualError := deposits.ParseDepositStatus(testCase.input)
+			if testCase.expectedError != nil {
+				require.ErrorIs(t, actualError, testCase.expectedError)
+				return
+			}
+
+			require.NoError(t, actualError)
+			require.Equal(t, testCase.expectedValue, actualValue)
+		})
+	}
+}
+
+func TestDepositTransitions(t *testing.T) {
+	testCases := []struct {
+		description    string
+		status         deposits.DepositStatus
+		transition     func(deposit *deposits.Deposit) error
+		expectedError  error
+		expectedStatus deposits.DepositStatus
+	}{
+		{
+			description:    "opens drafts",
+			status:         deposits.DepositStatusDraft,
+			transition:     (*deposits.Deposit).Open,
+			expectedStatus: deposits.DepositStatusOpen,
+		},
+		{
+			description:   "can't reopen",
+			status:        deposits.DepositStatusClosed,
+			transition:    (*deposits.Deposit).Open,
+			expectedError: deposits.ErrInvalidDepositTransition,
+		},
+		{
+			description:    "cancels open deposits",
+			status:         deposits.DepositStatusOpen,
+			transition:     (*deposits.Deposit).Cancel,
+			expectedStatus: deposits.DepositStatusCancelled,
+		},
+		{
+			description:   "can't cancel deposits that have received",
+			status:        deposits.DepositStatusPartiallyFunded,
+			transition:    (*deposits.Deposit).Cancel,
+			expectedError: deposits.ErrInvalidDepositTransition,
+		},
+		{
+			description:    "closes partially funded deposits",
+			status:         deposits.DepositStatusPartiallyFunded,
+			transition:     (*deposits.Deposit).Close,
+			expectedStatus: deposits.DepositStatusClosed,
+		},
+		{
+			description:    "closes funded deposits",
+			status:         deposits.DepositStatusFunded,
+			transition:     (*deposits.Deposit).Close,
+			expectedStatus: deposits.DepositStatusClosed,
+		},
+		{
+			description:   "can't close deposits that haven't received",
+			status:        deposits.DepositStatusOpen,
+			transition:    (*deposits.Deposit).Close,
+			expectedError: deposits.ErrInvalidDepositTransition,
+		},
+		{
+			description:   "can't close cancelled deposits",
+			status:        deposits.DepositStatusCancelled,
+			transition:    (*deposits.Deposit).Close,
+			expectedError: deposits.ErrInvalidDepositTransition,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			deposit, err := deposits.ParseDeposit(uuid.NewString(), testCase.status.String())
+			require.NoError(t, err)
+
+			err = testCase.transition(deposit)
+			if testCase.expectedError != nil {
+				require.ErrorIs(t, err, testCase.expectedError)
+				require.Equal(t, testCase.status, deposit.Status)
+				require.Empty(t, deposit.Events)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedStatus, deposit.Status)
+			require.Equal(t, []deposits.DepositStatusChanged{
+				{From: testCase.status, To: testCase.expectedStatus},
+			}, deposit.Events)
+		})
+	}
+}
+
+func TestDepositValidateReceiving(t *testing.T) {
+	testCases := []struct {
+		status        deposits.DepositStatus
+		expectedError error
+	}{
+		{status: deposits.DepositStatusDraft, expectedError: deposits.ErrDepositClosed},
+		{status: deposits.DepositStatusOpen},
+		{status: deposits.DepositStatusPartiallyFunded},
+		{status: deposits.DepositStatusFunded},
+		{status: deposits.DepositStatusClosed, expectedError: deposits.ErrDepositClosed},
+		{status: deposits.DepositStatusCancelled, expectedError: deposits.ErrDepositClosed},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.status.String(), func(t *testing.T) {
+			deposit, err := deposits.ParseDeposit(uuid.NewString(), testCase.status.String())
+			require.NoError(t, err)
+
+			err = deposit.ValidateReceiving()
+			if testCase.expectedError != nil {
+				require.ErrorIs(t, err, testCase.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDepositUpdateFundingStatus(t *testing.T) {
+	testCases := []struct {
+		description     string
+		status          deposits.DepositStatus
+		totalAllocated  []int64
+		expectedChanged bool
+		expectedStatus  deposits.DepositStatus
+	}{
+		{
+			description:     "stays open without receipts",
+			status:          deposits.DepositStatusOpen,
+			totalAllocated:  []int64{0, 0},
+			expectedChanged: false,
+			expectedStatus:  deposits.DepositStatusOpen,
+		},
+		{
+			description:     "partially funded with receipts",
+			status:          deposits.DepositStatusOpen,
+			totalAllocated:  []int64{100_00, 0},
+			expectedChanged: true,
+			expectedStatus:  deposits.DepositStatusPartiallyFunded,
+		},
+		{
+			description:     "funded with every account at nominal",
+			status:          deposits.DepositStatusPartiallyFunded,
+			totalAllocated:  []int64{100_00, 120_00},
+			expectedChanged: true,
+			expectedStatus:  deposits.DepositStatusFunded,
+		},
+		{
+			description:     "back to partially funded when an account drops below nominal",
+			status:          deposits.DepositStatusFunded,
+			totalAllocated:  []int64{100_00, 50_00},
+			expectedChanged: true,
+			expectedStatus:  deposits.DepositStatusPartiallyFunded,
+		},
+		{
+			description:     "closed deposits stay closed",
+			status:          deposits.DepositStatusClosed,
+			totalAllocated:  []int64{0, 0},
+			expectedChanged: false,
+			expectedStatus:  deposits.DepositStatusClosed,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			deposit, err := deposits.ParseDeposit(uuid.NewString(), testCase.status.String())
+			require.NoError(t, err)
+			pot, err := deposits.NewPot("Pot A")
+			require.NoError(t, err)
+			wrapperTypes := []deposits.WrapperType{deposits.WrapperTypeISA, deposits.WrapperTypeGIA}
+			for i, totalAllocated := range testCase.totalAllocated {
+				account, err := deposits.ParseAccount(uuid.NewString(), wrapperTypes[i].Int(), "GBP", 100_00, totalAllocated)
+				require.NoError(t, err)
+				require.NoError(t, pot.AddAccount(account))
+			}
+			deposit.AddPot(pot)
+
+			changed, err := deposit.UpdateFundingStatus()
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedChanged, changed)
+			require.Equal(t, testCase.expectedStatus, deposit.Status)
+
+			// Only changes are recorded
+			if testCase.expectedChanged {
+				require.Equal(t, []deposits.DepositStatusChanged{
+					{From: testCase.status, To: testCase.expectedStatus},
+				}, deposit.Events)
+			} else {
+				require.Empty(t, deposit.Events)
+			}
+		})
+	}
+
+	t.Run("counts pending relief towards nominal", func(t *testing.T) {
+		deposit, err := deposits.ParseDeposit(uuid.NewString(), "OPEN")
+		require.NoError(t, err)
+		pot, err := deposits.NewPot("Pot A")
+		require.NoError(t, err)
+		sipp, err := deposits.NewAccount(deposits.WrapperTypeSIPP, gbp(100_00))
+		require.NoError(t, err)
+		receipt, err := deposits.NewReceipt(gbp(80_00))
+		require.NoError(t, err)
+		require.NoError(t, sipp.AddReceipt(receipt))
+		require.NoError(t, pot.AddAccount(sipp))
+		deposit.AddPot(pot)
+
+		_, err = deposit.UpdateFundingStatus()
+		require.NoError(t, err)
+		require.Equal(t, deposits.DepositStatusFunded, deposit.Status)
+	})
+}
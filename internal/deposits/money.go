@@ -0,0 +1,168 @@
+package deposits
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var (
+	ErrInvalidCurrency  = errors.New("invalid currency given")
+	ErrCurrencyMismatch = errors.New("currencies don't match")
+	ErrAmountOverflow   = errors.New("amount overflowed")
+)
+
+// Currency is an ISO 4217 currency code
+type Currency string
+
+const (
+	CurrencyGBP Currency = "GBP"
+	CurrencyEUR Currency = "EUR"
+	CurrencyUSD Currency = "USD"
+)
+
+// minorUnits is the number of decimal places of each supported currency's minor unit
+var minorUnits = map[Currency]int{
+	CurrencyGBP: 2,
+	CurrencyEUR: 2,
+	CurrencyUSD: 2,
+}
+
+// ParseCurrency ensures the given code is a supported ISO 4217 currency
+func ParseCurrency(code string) (Currency, error) {
+	currency := Currency(code)
+	if _, ok := minorUnits[currency]; !ok {
+		return "", ErrInvalidCurrency
+	}
+
+	return currency, nil
+}
+
+// MinorUnits returns the number of decimal places in the currency's minor unit
+func (currency Currency) MinorUnits() int {
+	return minorUnits[currency]
+}
+
+func (currency Currency) String() string {
+	return string(currency)
+}
+
+// Money is an amount in the minor units of its currency, e.g. pence for GBP
+//
+// The zero value is treated as zero in any currency, so it can be combined with Money of any currency
+type Money struct {
+	Amount   int64
+	Currency Currency
+}
+
+// NewMoney creates Money of the amount, in minor units, in the given currency
+func NewMoney(amount int64, currency string) (Money, error) {
+	moneyCurrency, err := ParseCurrency(currency)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return Money{
+		Amount:   amount,
+		Currency: moneyCurrency,
+	}, nil
+}
+
+func (money Money) Int64() int64 {
+	return money.Amount
+}
+
+func (money Money) IsZero() bool {
+	return money.Amount == 0
+}
+
+func (money Money) IsNegative() bool {
+	return money.Amount < 0
+}
+
+// currencyWith returns the currency shared with other, failing if they're different
+func (money Money) currencyWith(other Money) (Currency, error) {
+	switch {
+	case money.Currency == other.Currency:
+		return money.Currency, nil
+	case money == Money{}:
+		return other.Currency, nil
+	case other == Money{}:
+		return money.Currency, nil
+	}
+
+	return "", ErrCurrencyMismatch
+}
+
+// Add returns the sum of the amounts, refusing to add different currencies or overflow
+func (money Money) Add(other Money) (Money, error) {
+	currency, err := money.currencyWith(other)
+	if err != nil {
+		return Money{}, err
+	}
+
+	if (other.Amount > 0 && money.Amount > math.MaxInt64-other.Amount) ||
+		(other.Amount < 0 && money.Amount < math.MinInt64-other.Amount) {
+		return Money{}, ErrAmountOverflow
+	}
+
+	return Money{
+		Amount:   money.Amount + other.Amount,
+		Currency: currency,
+	}, nil
+}
+
+// Subtract returns the difference of the amounts, refusing to subtract different currencies or overflow
+func (money Money) Subtract(other Money) (Money, error) {
+	if other.Amount == math.MinInt64 {
+		return Money{}, ErrAmountOverflow
+	}
+
+	return money.Add(Money{
+		Amount:   -other.Amount,
+		Currency: other.Currency,
+	})
+}
+
+// Compare returns -1, 0 or 1 if the amount is less than, equal to or greater than other
+func (money Money) Compare(other Money) (int, error) {
+	_, err := money.currencyWith(other)
+	if err != nil {
+		return 0, err
+	}
+
+	switch {
+	case money.Amount < other.Amount:
+		return -1, nil
+	case money.Amount > other.Amount:
+		return 1, nil
+	}
+
+	return 0, nil
+}
+
+// String formats the amount in major units, e.g. "12.34 GBP"
+func (money Money) String() string {
+	digits := money.Currency.MinorUnits()
+	if digits == 0 {
+		return fmt.Sprintf("%d %s", money.Amount, money.Currency)
+	}
+
+	sign := ""
+	amount := money.Amount
+	if amount < 0 {
+		sign = "-"
+	}
+
+	scale := int64(math.Pow10(digits))
+	major := amount / scale
+	minor := amount % scale
+	if major < 0 {
+		major = -major
+	}
+	if minor < 0 {
+		minor = -minor
+	}
+
+	return fmt.Sprintf("%s%d.%0*d %s", sign, major, digits, minor, money.Currency)
+}
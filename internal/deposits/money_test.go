@@ -0,0 +1,169 @@
+package deposits_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/iainvm/deposits/internal/deposits"
+	"github.com/stretchr/testify/require"
+)
+
+func gbp(amount int64) deposits.Money {
+	return deposits.Money{Amount: amount, Currency: deposits.CurrencyGBP}
+}
+
+func eur(amount int64) deposits.Money {
+	return deposits.Money{Amount: amount, Currency: deposits.CurrencyEUR}
+}
+
+func TestNewMoney(t *testing.T) {
+	testCases := []struct {
+		description   string
+		amount        int64
+		currency      string
+		expectedError error
+		expectedValue deposits.Money
+	}{
+		{
+			description:   "passes for GBP",
+			amount:        1234,
+			currency:      "GBP",
+			expectedValue: gbp(1234),
+		},
+		{
+			description:   "fails for lowercase currency",
+			amount:        1234,
+			currency:      "gbp",
+			expectedError: deposits.ErrInvalidCurrency,
+		},
+		{
+			description:   "fails for blank currency",
+			amount:        1234,
+			currency:      "",
+			expectedError: deposits.ErrInvalidCurrency,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			actualValue, actualError := deposits.NewMoney(testCase.amount, testCase.currency)
+			if testCase.expectedError != nil {
+				require.ErrorIs(t, actualError, testCase.expectedError)
+				return
+			}
+
+			require.NoError(t, actualError)
+			require.Equal(t, testCase.expectedValue, actualValue)
+		})
+	}
+}
+
+func TestMoneyAdd(t *testing.T) {
+	testCases := []struct {
+		description   string
+		a             deposits.Money
+		b             deposits.Money
+		expectedError error
+		expectedValue deposits.Money
+	}{
+		{
+			description:   "adds same currency",
+			a:             gbp(100),
+			b:             gbp(23),
+			expectedValue: gbp(123),
+		},
+		{
+			description:   "adds zero value",
+			a:             deposits.Money{},
+			b:             gbp(23),
+			expectedValue: gbp(23),
+		},
+		{
+			description:   "refuses mixed currencies",
+			a:             gbp(100),
+			b:             eur(23),
+			expectedError: deposits.ErrCurrencyMismatch,
+		},
+		{
+			description:   "refuses overflow",
+			a:             gbp(math.MaxInt64),
+			b:             gbp(1),
+			expectedError: deposits.ErrAmountOverflow,
+		},
+		{
+			description:   "refuses underflow",
+			a:             gbp(math.MinInt64),
+			b:             gbp(-1),
+			expectedError: deposits.ErrAmountOverflow,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			actualValue, actualError := testCase.a.Add(testCase.b)
+			if testCase.expectedError != nil {
+				require.ErrorIs(t, actualError, testCase.expectedError)
+				return
+			}
+
+			require.NoError(t, actualError)
+			require.Equal(t, testCase.expectedValue, actualValue)
+		})
+	}
+}
+
+func TestMoneySubtract(t *testing.T) {
+	actual, err := gbp(100).Subtract(gbp(123))
+	require.NoError(t, err)
+	require.Equal(t, gbp(-23), actual)
+
+	_, err = gbp(100).Subtract(eur(1))
+	require.ErrorIs(t, err, deposits.ErrCurrencyMismatch)
+
+	_, err = gbp(0).Subtract(gbp(math.MinInt64))
+	require.ErrorIs(t, err, deposits.ErrAmountOverflow)
+}
+
+func TestMoneyCompare(t *testing.T) {
+	comparison, err := gbp(1).Compare(gbp(2))
+	require.NoError(t, err)
+	require.Equal(t, -1, comparison)
+
+	comparison, err = gbp(2).Compare(gbp(2))
+	require.NoError(t, err)
+	require.Equal(t, 0, comparison)
+
+	comparison, err = gbp(3).Compare(gbp(2))
+	require.NoError(t, err)
+	require.Equal(t, 1, comparison)
+
+	_, err = gbp(3).Compare(eur(2))
+	require.ErrorIs(t, err, deposits.ErrCurrencyMismatch)
+}
+
+func TestMoneyString(t *testing.T) {
+	require.Equal(t, "12.34 GBP", gbp(1234).String())
+	require.Equal(t, "0.05 GBP", gbp(5).String())
+	require.Equal(t, "-0.05 GBP", gbp(-5).String())
+	require.Equal(t, "-12.34 EUR", eur(-1234).String())
+}
+
+func TestAccountCurrency(t *testing.T) {
+	account, err := deposits.NewAccount(deposits.WrapperTypeGIA, gbp(100))
+	require.NoError(t, err)
+
+	receipt, err := deposits.NewReceipt(eur(10))
+	require.NoError(t, err)
+
+	err = account.AddReceipt(receipt)
+	require.ErrorIs(t, err, deposits.ErrCurrencyMismatch)
+	require.Equal(t, gbp(0), account.TotalAllocatedAmount.Money)
+}
+
+func TestIncreaseTotalAllocationAmountOverflow(t *testing.T) {
+	account, err := deposits.ParseAccount("00000000-0000-0000-0000-000000000000", deposits.WrapperTypeGIA.Int(), "GBP", 0, math.MaxInt64)
+	require.NoError(t, err)
+
+	err = account.IncreaseTotalAllocationAmount(deposits.TotalAllocatedAmount{Money: gbp(1)})
+	require.ErrorIs(t, err, deposits.ErrAmountOverflow)
+}
@@ -0,0 +1,187 @@
+package deposits
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/iainvm/deposits/internal/outbox"
+)
+
+// Account events are published with their AccountEventType as the topic, deposits with these
+const (
+	// TopicDepositStatusChanged is published when a deposit moves status
+	TopicDepositStatusChanged = "DEPOSIT_STATUS_CHANGED"
+	// TopicDepositFunded is also published when a deposit moves to funded, for those only interested in that
+	TopicDepositFunded = "DEPOSIT_FUNDED"
+	// TopicReceiptRejected is published when a receipt breaks a business rule, see ReceiptRejection
+	TopicReceiptRejected = "RECEIPT_REJECTED"
+)
+
+// OutboxTopics are the topics of every message published about deposits and their accounts
+func OutboxTopics() []string {
+	return []string{
+		TopicDepositStatusChanged,
+		TopicDepositFunded,
+		TopicReceiptRejected,
+		AccountEventTypeAccountOpened.String(),
+		AccountEventTypeNominalChanged.String(),
+		AccountEventTypeReceiptAllocated.String(),
+		AccountEventTypeReceiptReversed.String(),
+		AccountEventTypeReliefApplied.String(),
+		AccountEventTypeReliefReleased.String(),
+		AccountEventTypeAccountRemoved.String(),
+	}
+}
+
+// depositStatusChangedPayload is what's published for a DepositStatusChanged
+type depositStatusChangedPayload struct {
+	DepositId  string `json:"deposit_id"`
+	InvestorId string `json:"investor_id"`
+	From       string `json:"from"`
+	To         string `json:"to"`
+}
+
+// receiptRejectedPayload is what's published for a ReceiptRejection, leaving out the ids it hasn't got
+type receiptRejectedPayload struct {
+	ReceiptId        string `json:"receipt_id,omitempty"`
+	DepositReceiptId string `json:"deposit_receipt_id,omitempty"`
+	AccountId        string `json:"account_id,omitempty"`
+	DepositId        string `json:"deposit_id,omitempty"`
+	AllocatedFrom    string `json:"allocated_from,omitempty"`
+	IdempotencyKey   string `json:"idempotency_key,omitempty"`
+	Currency         string `json:"currency"`
+	Amount           *int64 `json:"amount"`
+	Reason           string `json:"reason"`
+}
+
+// accountEventPayload is what's published for each type of account event, amounts are in minor units of the currency
+// and each type leaves out what it doesn't have
+type accountEventPayload struct {
+	AccountId            string `json:"account_id"`
+	Sequence             int64  `json:"sequence"`
+	Currency             string `json:"currency"`
+	WrapperType          string `json:"wrapper_type,omitempty"`
+	NominalAmount        *int64 `json:"nominal_amount,omitempty"`
+	TotalAllocatedAmount *int64 `json:"total_allocated_amount,omitempty"`
+	PendingReliefAmount  *int64 `json:"pending_relief_amount,omitempty"`
+	ReceiptId            string `json:"receipt_id,omitempty"`
+	Amount               *int64 `json:"amount,omitempty"`
+	Relief               *int64 `json:"relief,omitempty"`
+}
+
+// DepositOutboxMessages creates the messages publishing the deposit's status changes
+func DepositOutboxMessages(deposit Deposit, occurredAt time.Time) ([]outbox.Message, error) {
+	messages := []outbox.Message{}
+	for _, event := range deposit.Events {
+		payload, err := json.Marshal(depositStatusChangedPayload{
+			DepositId:  deposit.Id.String(),
+			InvestorId: deposit.InvestorId.String(),
+			From:       event.From.String(),
+			To:         event.To.String(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		topics := []string{TopicDepositStatusChanged}
+		if event.To == DepositStatusFunded {
+			topics = append(topics, TopicDepositFunded)
+		}
+		for _, topic := range topics {
+			message, err := outbox.NewMessage(topic, deposit.Id.String(), payload, occurredAt)
+			if err != nil {
+				return nil, err
+			}
+			messages = append(messages, *message)
+		}
+	}
+
+	return messages, nil
+}
+
+// ReceiptRejectionOutboxMessage creates the message publishing the rejection, keyed by the account, or deposit, the
+// payment was for
+func ReceiptRejectionOutboxMessage(rejection ReceiptRejection) (*outbox.Message, error) {
+	payload, err := json.Marshal(receiptRejectedPayload{
+		ReceiptId:        rejection.ReceiptId.String(),
+		DepositReceiptId: rejection.DepositReceiptId.String(),
+		AccountId:        rejection.AccountId.String(),
+		DepositId:        rejection.DepositId.String(),
+		AllocatedFrom:    rejection.AllocatedFrom.String(),
+		IdempotencyKey:   rejection.IdempotencyKey.String(),
+		Currency:         rejection.Amount.Currency.String(),
+		Amount:           publishedAmount(rejection.Amount.Money),
+		Reason:           rejection.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	key := rejection.AccountId.String()
+	if key == "" {
+		key = rejection.DepositId.String()
+	}
+
+	return outbox.NewMessage(TopicReceiptRejected, key, payload, rejection.RejectedAt)
+}
+
+// AccountOutboxMessages creates the messages publishing the account's events
+func AccountOutboxMessages(account Account, occurredAt time.Time) ([]outbox.Message, error) {
+	messages := []outbox.Message{}
+	for _, recorded := range account.Events {
+		payload := accountEventPayload{
+			AccountId: account.Id.String(),
+			Sequence:  recorded.Sequence,
+		}
+		switch event := recorded.Event.(type) {
+		case AccountOpened:
+			payload.Currency = event.NominalAmount.Currency.String()
+			payload.WrapperType = event.WrapperType.String()
+			payload.NominalAmount = publishedAmount(event.NominalAmount.Money)
+			payload.TotalAllocatedAmount = publishedAmount(event.TotalAllocatedAmount.Money)
+			payload.PendingReliefAmount = publishedAmount(event.PendingReliefAmount.Money)
+		case NominalChanged:
+			payload.Currency = event.NominalAmount.Currency.String()
+			payload.NominalAmount = publishedAmount(event.NominalAmount.Money)
+		case ReceiptAllocated:
+			payload.Currency = event.Amount.Currency.String()
+			payload.ReceiptId = event.ReceiptId.String()
+			payload.Amount = publishedAmount(event.Amount.Money)
+			payload.Relief = publishedAmount(event.Relief.Money)
+		case ReceiptReversed:
+			payload.Currency = event.Amount.Currency.String()
+			payload.ReceiptId = event.ReceiptId.String()
+			payload.Amount = publishedAmount(event.Amount.Money)
+		case ReliefApplied:
+			payload.Currency = event.Amount.Currency.String()
+			payload.ReceiptId = event.ReceiptId.String()
+			payload.Amount = publishedAmount(event.Amount.Money)
+		case ReliefReleased:
+			payload.Currency = event.Amount.Currency.String()
+			payload.Amount = publishedAmount(event.Amount.Money)
+		case AccountRemoved:
+			payload.Currency = account.NominalAmount.Currency.String()
+		default:
+			return nil, ErrInvalidAccountEvent
+		}
+
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+
+		message, err := outbox.NewMessage(recorded.Event.Type().String(), account.Id.String(), data, occurredAt)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, *message)
+	}
+
+	return messages, nil
+}
+
+// publishedAmount points to the amount, so zero amounts are still published
+func publishedAmount(money Money) *int64 {
+	amount := money.Int64()
+	return &amount
+}
@@ -0,0 +1,57 @@
+package deposits
+
+import "errors"
+
+var (
+	ErrOverflowNotNeeded = errors.New("receipt fits the account without overflowing")
+	ErrAccountNotInPot   = errors.New("account not found in the deposit's pots")
+)
+
+// Overflow splits off the part of the receipt above the amount the account can take, returning it as a new receipt
+// linked back to this one
+//
+// The idempotency key stays on this receipt, so a repeat of the payment finds the original split
+func (receipt *Receipt) Overflow(fits AllocatedAmount) (*Receipt, error) {
+	comparison, err := receipt.AllocatedAmount.Compare(fits.Money)
+	if err != nil {
+		return nil, err
+	}
+	if comparison <= 0 {
+		return nil, ErrOverflowNotNeeded
+	}
+
+	excess, err := receipt.AllocatedAmount.Subtract(fits.Money)
+	if err != nil {
+		return nil, err
+	}
+	overflow, err := NewReceipt(excess)
+	if err != nil {
+		return nil, err
+	}
+	overflow.OverflowOf = receipt.Id
+	overflow.DepositReceiptId = receipt.DepositReceiptId
+	overflow.ReceivedAt = receipt.ReceivedAt
+
+	receipt.AllocatedAmount = fits
+	return overflow, nil
+}
+
+// OverflowAccount returns the GIA in the same pot as the account, or nil if the pot hasn't got one
+func (deposit Deposit) OverflowAccount(accountId AccountId) (*Account, error) {
+	for _, pot := range deposit.Pots {
+		for _, account := range pot.Accounts {
+			if account.Id != accountId {
+				continue
+			}
+
+			for _, potAccount := range pot.Accounts {
+				if potAccount.WrapperType == WrapperTypeGIA {
+					return potAccount, nil
+				}
+			}
+			return nil, nil
+		}
+	}
+
+	return nil, ErrAccountNotInPot
+}
@@ -0,0 +1,109 @@
+package deposits_test
+
+import (
+	"testing"
+
+	"github.com/iainvm/deposits/internal/deposits"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReceiptOverflow(t *testing.T) {
+	testCases := []struct {
+		description      string
+		amount           int64
+		fits             int64
+		expectedError    error
+		expectedOverflow deposits.Money
+	}{
+		{
+			description:      "splits off the excess",
+			amount:           150_00,
+			fits:             100_00,
+			expectedOverflow: gbp(50_00),
+		},
+		{
+			description:      "splits off everything if nothing fits",
+			amount:           150_00,
+			fits:             0,
+			expectedOverflow: gbp(150_00),
+		},
+		{
+			description:   "fails if the receipt fits",
+			amount:        100_00,
+			fits:          100_00,
+			expectedError: deposits.ErrOverflowNotNeeded,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			receipt, err := deposits.NewReceipt(gbp(testCase.amount))
+			require.NoError(t, err)
+			receipt.IdempotencyKey = "BANK-REF-1"
+
+			overflow, err := receipt.Overflow(deposits.AllocatedAmount{Money: gbp(testCase.fits)})
+			if testCase.expectedError != nil {
+				require.ErrorIs(t, err, testCase.expectedError)
+				require.Equal(t, gbp(testCase.amount), receipt.AllocatedAmount.Money)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, gbp(testCase.fits), receipt.AllocatedAmount.Money)
+			require.Equal(t, testCase.expectedOverflow, overflow.AllocatedAmount.Money)
+			require.Equal(t, receipt.Id, overflow.OverflowOf)
+			require.Empty(t, overflow.IdempotencyKey)
+			require.Equal(t, receipt.ReceivedAt, overflow.ReceivedAt)
+		})
+	}
+
+	t.Run("fails for a different currency", func(t *testing.T) {
+		receipt, err := deposits.NewReceipt(gbp(100))
+		require.NoError(t, err)
+
+		_, err = receipt.Overflow(deposits.AllocatedAmount{Money: eur(50)})
+		require.ErrorIs(t, err, deposits.ErrCurrencyMismatch)
+	})
+}
+
+func TestDepositOverflowAccount(t *testing.T) {
+	deposit, err := deposits.NewDeposit()
+	require.NoError(t, err)
+
+	withGIA, err := deposits.NewPot("Pot A")
+	require.NoError(t, err)
+	isa, err := deposits.NewAccount(deposits.WrapperTypeISA, gbp(100_00))
+	require.NoError(t, err)
+	gia, err := deposits.NewAccount(deposits.WrapperTypeGIA, gbp(100_00))
+	require.NoError(t, err)
+	require.NoError(t, withGIA.AddAccount(isa))
+	require.NoError(t, withGIA.AddAccount(gia))
+	deposit.AddPot(withGIA)
+
+	withoutGIA, err := deposits.NewPot("Pot B")
+	require.NoError(t, err)
+	sipp, err := deposits.NewAccount(deposits.WrapperTypeSIPP, gbp(100_00))
+	require.NoError(t, err)
+	require.NoError(t, withoutGIA.AddAccount(sipp))
+	deposit.AddPot(withoutGIA)
+
+	t.Run("finds the GIA in the same pot", func(t *testing.T) {
+		account, err := deposit.OverflowAccount(isa.Id)
+		require.NoError(t, err)
+		require.Equal(t, gia, account)
+	})
+
+	t.Run("finds nothing in a pot without a GIA", func(t *testing.T) {
+		account, err := deposit.OverflowAccount(sipp.Id)
+		require.NoError(t, err)
+		require.Nil(t, account)
+	})
+
+	t.Run("fails for accounts in other deposits", func(t *testing.T) {
+		other, err := deposits.NewAccount(deposits.WrapperTypeISA, gbp(100_00))
+		require.NoError(t, err)
+
+		_, err = deposit.OverflowAccount(other.Id)
+		require.ErrorIs(t, err, deposits.ErrAccountNotInPot)
+	})
+}
@@ -2,111 +2,276 @@ package store
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"errors"
-	"fmt"
-	"log/slog"
+	"time"
 
 	"github.com/iainvm/deposits/internal/deposits"
 	"github.com/iainvm/deposits/internal/investors"
+	"github.com/iainvm/deposits/internal/ledger"
+	ledgerStore "github.com/iainvm/deposits/internal/ledger/postgres"
+	"github.com/iainvm/deposits/internal/outbox"
+	outboxStore "github.com/iainvm/deposits/internal/outbox/postgres"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
-var ErrSaveFailed = errors.New("failed to save deposit")
+var (
+	ErrSaveFailed        = errors.New("failed to save deposit")
+	ErrTransactionFailed = errors.New("failed to run transaction")
+)
+
+// uniqueViolation is the postgres error code for a unique constraint failing
+const uniqueViolation = "23505"
+
+const receiptIdempotencyKeyConstraint = "receipts_idempotency_key_key"
+
+// accountEventsConstraint is the primary key of account_events, an account's stream only has one event at each sequence
+const accountEventsConstraint = "account_events_pkey"
+
+// foreignKeyViolation is the postgres error code for a foreign key constraint failing
+const foreignKeyViolation = "23503"
+
+// foreignKeyErrors are the not found errors for what each foreign key references
+var foreignKeyErrors = map[string]error{
+	"deposits_investor_id_fkey":            investors.ErrInvestorNotFound,
+	"pots_deposit_id_fkey":                 deposits.ErrDepositNotFound,
+	"accounts_pot_id_fkey":                 deposits.ErrPotNotFound,
+	"receipts_account_id_fkey":             deposits.ErrAccountNotFound,
+	"receipts_suspense_deposit_id_fkey":    deposits.ErrDepositNotFound,
+	"deposit_receipts_deposit_id_fkey":     deposits.ErrDepositNotFound,
+	"suspense_allocations_account_id_fkey": deposits.ErrAccountNotFound,
+	"isa_subscriptions_investor_id_fkey":   investors.ErrInvestorNotFound,
+	"relief_claims_investor_id_fkey":       investors.ErrInvestorNotFound,
+}
+
+// saveFailed wraps an error from saving, translating foreign key violations into the not found error for what's
+// missing
+func saveFailed(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == foreignKeyViolation {
+		if notFound, ok := foreignKeyErrors[pqErr.Constraint]; ok {
+			return errors.Join(notFound, err)
+		}
+	}
+
+	return errors.Join(ErrSaveFailed, err)
+}
+
+// queryer runs queries against either the database or a transaction
+type queryer interface {
+	GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	NamedExecContext(ctx context.Context, query string, arg interface{}) (sql.Result, error)
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
 
 type Store struct {
-	db *sqlx.DB
+	db queryer
+	// conn begins transactions, it's nil when the Store is already in one
+	conn *sqlx.DB
 }
 
 func NewStore(db *sqlx.DB) Store {
 	return Store{
-		db: db,
+		db:   db,
+		conn: db,
+	}
+}
+
+// WithinTx runs fn in a transaction, committing if it succeeds and rolling back if it fails
+func (store Store) WithinTx(ctx context.Context, fn func(repository deposits.Repository) error) error {
+	// Already in a transaction, so join it
+	if store.conn == nil {
+		return fn(store)
+	}
+
+	tx, err := store.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Join(ErrTransactionFailed, err)
+	}
+	// Rolling back after a commit does nothing, this covers failures and panics
+	defer tx.Rollback()
+
+	err = fn(Store{db: tx})
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errors.Join(ErrTransactionFailed, err)
 	}
+
+	return nil
 }
 
 type DepositRow struct {
-	Id         string `db:"id"`
-	InvestorId string `db:"investor_id"`
+	Id         string    `db:"id"`
+	InvestorId string    `db:"investor_id"`
+	Status     string    `db:"status"`
+	Version    int64     `db:"version"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+// DepositSummaryRow is a deposit with the totals of its accounts in one currency, which are null for deposits without
+// accounts
+type DepositSummaryRow struct {
+	Id               string          `db:"id"`
+	InvestorId       string          `db:"investor_id"`
+	Status           string          `db:"status"`
+	CreatedAt        time.Time       `db:"created_at"`
+	Currency         sql.NullString  `db:"currency"`
+	NominalAmount    sql.NullInt64   `db:"nominal_amount"`
+	AllocatedAmount  sql.NullInt64   `db:"allocated_amount"`
+	FundedPercentage sql.NullFloat64 `db:"funded_percentage"`
 }
 
+// FullDeposit is a row of a deposit joined to its pots and accounts, which are null for pots without accounts and
+// deposits without pots
 type FullDeposit struct {
-	Id                          string `db:"id"`
-	InvestorId                  string `db:"investor_id"`
-	PotId                       string `db:"pots_id"`
-	PotName                     string `db:"pots_name"`
-	AccountId                   string `db:"account_id"`
-	AccountWrapperType          int    `db:"account_wrapper_type"`
-	AccountNominalAmount        int64  `db:"account_nominal_amount"`
-	AccountTotalAllocatedAmount int64  `db:"account_total_allocated_amount"`
+	Id                          string         `db:"id"`
+	InvestorId                  string         `db:"investor_id"`
+	Status                      string         `db:"status"`
+	Version                     int64          `db:"version"`
+	PotId                       sql.NullString `db:"pots_id"`
+	PotName                     sql.NullString `db:"pots_name"`
+	AccountId                   sql.NullString `db:"account_id"`
+	AccountWrapperType          sql.NullString `db:"account_wrapper_type"`
+	AccountCurrency             sql.NullString `db:"account_currency"`
+	AccountNominalAmount        sql.NullInt64  `db:"account_nominal_amount"`
+	AccountTotalAllocatedAmount sql.NullInt64  `db:"account_total_allocated_amount"`
+	AccountPendingReliefAmount  sql.NullInt64  `db:"account_pending_relief_amount"`
+	AccountVersion              sql.NullInt64  `db:"account_version"`
 }
 
+// GetFullDeposit returns the deposit with all its pots, accounts and receipts
 func (store Store) GetFullDeposit(ctx context.Context, depositId deposits.DepositId) (*deposits.Deposit, error) {
+	deposit, err := store.GetDepositBalances(ctx, depositId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The balances on the rows are only a projection, the accounts' streams are what they really are
+	err = store.restoreDepositAccounts(ctx, deposit)
+	if err != nil {
+		return nil, err
+	}
+
+	receipts, err := store.getDepositReceipts(ctx, depositId)
+	if err != nil {
+		return nil, err
+	}
+	err = attachReceipts(deposit, receipts)
+	if err != nil {
+		return nil, err
+	}
+
+	return deposit, nil
+}
+
+// GetDepositBalances returns the deposit with its pots and accounts, with the balances projected onto the accounts
+// table, but without reading their streams or receipts
+func (store Store) GetDepositBalances(ctx context.Context, depositId deposits.DepositId) (*deposits.Deposit, error) {
 	const query = `--sql
 	SELECT d.id AS "id",
 		d.investor_id AS "investor_id",
+		d.status AS "status",
+		d.version AS "version",
 		p.id AS "pots_id",
 		p.name AS "pots_name",
 		a.id AS "account_id",
 		a.wrapper_type AS "account_wrapper_type",
+		a.currency AS "account_currency",
 		a.nominal_amount AS "account_nominal_amount",
-		a.total_allocated_amount AS "account_total_allocated_amount"
+		a.total_allocated_amount AS "account_total_allocated_amount",
+		a.pending_relief_amount AS "account_pending_relief_amount",
+		a.version AS "account_version"
 	FROM deposits d
-	JOIN pots p ON d.id = p.deposit_id
-	JOIN accounts a ON p.id = a.pot_id
+	LEFT JOIN pots p ON d.id = p.deposit_id
+	LEFT JOIN accounts a ON p.id = a.pot_id
 	WHERE d.id = $1
+	ORDER BY p.created_at, p.id, a.created_at, a.id
 	`
 
 	rows := []FullDeposit{}
 
-	err := store.db.Select(&rows, query, depositId.String())
+	err := store.db.SelectContext(ctx, &rows, query, depositId.String())
 	if err != nil {
 		return nil, err
 	}
 
-	slog.Info("Row Count", "len", len(rows))
-	deposit, err := createDomainDeposit(rows)
-	if err != nil {
-		return nil, err
-	}
-	return deposit, nil
+	return createDomainDeposit(rows)
 }
 
 func createDomainDeposit(rows []FullDeposit) (*deposits.Deposit, error) {
+	// Every row has the deposit, so no rows means there's no deposit
 	if len(rows) == 0 {
-		return nil, fmt.Errorf("no deposit data")
+		return nil, deposits.ErrDepositNotFound
 	}
 
 	// Create the deposit
 	depositId := rows[0].Id
-	deposit, err := deposits.ParseDeposit(depositId)
+	deposit, err := deposits.ParseDeposit(depositId, rows[0].Status)
+	if err != nil {
+		return nil, err
+	}
+	deposit.InvestorId, err = investors.ParseInvestorId(rows[0].InvestorId)
 	if err != nil {
 		return nil, err
 	}
+	deposit.Version = rows[0].Version
 
 	potIndexes := map[string]int{}
 	for _, row := range rows {
+		// Deposit without pots
+		if !row.PotId.Valid {
+			continue
+		}
+
 		// Check if pot exists
 		var pot *deposits.Pot
-		potIndex, ok := potIndexes[row.PotId]
+		potIndex, ok := potIndexes[row.PotId.String]
 
 		// Create pot if doesn't exist
 		if !ok {
-			pot, err = deposits.ParsePot(row.PotId, row.PotName)
+			pot, err = deposits.ParsePot(row.PotId.String, row.PotName.String)
 			if err != nil {
 				return nil, err
 			}
 
 			// Index to find next row
-			potIndexes[row.PotId] = len(deposit.Pots)
+			potIndexes[row.PotId.String] = len(deposit.Pots)
 			deposit.AddPot(pot)
 		} else {
 			// Get pot if it exists
 			pot = deposit.Pots[potIndex]
 		}
 
-		account, err := deposits.ParseAccount(row.AccountId, row.AccountWrapperType, row.AccountNominalAmount, row.AccountTotalAllocatedAmount)
+		// Pot without accounts
+		if !row.AccountId.Valid {
+			continue
+		}
+
+		wrapperType, err := deposits.ParseWrapperTypeCode(row.AccountWrapperType.String)
+		if err != nil {
+			return nil, err
+		}
+
+		account, err := deposits.ParseAccount(row.AccountId.String, wrapperType.Int(), row.AccountCurrency.String, row.AccountNominalAmount.Int64, row.AccountTotalAllocatedAmount.Int64)
 		if err != nil {
 			return nil, err
 		}
+		pendingRelief, err := deposits.NewMoney(row.AccountPendingReliefAmount.Int64, row.AccountCurrency.String)
+		if err != nil {
+			return nil, err
+		}
+		err = account.SetPendingReliefAmount(deposits.TotalAllocatedAmount{Money: pendingRelief})
+		if err != nil {
+			return nil, err
+		}
+		account.Version = row.AccountVersion.Int64
 		err = pot.AddAccount(account)
 		if err != nil {
 			return nil, err
@@ -116,38 +281,251 @@ func createDomainDeposit(rows []FullDeposit) (*deposits.Deposit, error) {
 	return deposit, nil
 }
 
-func (store Store) GetDeposit(ctx context.Context, depositId deposits.DepositId) (*deposits.Deposit, error) {
+// restoreDepositAccounts replaces each of the deposit's accounts with the account restored from its stream
+func (store Store) restoreDepositAccounts(ctx context.Context, deposit *deposits.Deposit) error {
+	accountIds := []string{}
+	for _, pot := range deposit.Pots {
+		for _, account := range pot.Accounts {
+			accountIds = append(accountIds, account.Id.String())
+		}
+	}
+
+	restored, err := store.restoreAccounts(ctx, accountIds)
+	if err != nil {
+		return err
+	}
+
+	for _, pot := range deposit.Pots {
+		for _, account := range pot.Accounts {
+			restoredAccount, ok := restored[account.Id]
+			if !ok {
+				return deposits.ErrAccountNotFound
+			}
+			*account = *restoredAccount
+		}
+	}
+
+	return nil
+}
+
+// getDepositReceipts returns the receipts on the deposit's accounts and those held in its suspense balance
+func (store Store) getDepositReceipts(ctx context.Context, depositId deposits.DepositId) ([]*deposits.Receipt, error) {
+	const query = `--sql
+	SELECT r.*
+	FROM receipts r
+	WHERE r.account_id IN (
+			SELECT a.id
+			FROM accounts a
+			JOIN pots p ON p.id = a.pot_id
+			WHERE p.deposit_id = $1
+		)
+		OR r.suspense_deposit_id = $1
+	ORDER BY r.created_at, r.id
+	`
+
+	rows := []ReceiptRow{}
+	err := store.db.SelectContext(ctx, &rows, query, depositId.String())
+	if err != nil {
+		return nil, err
+	}
+
+	receipts := []*deposits.Receipt{}
+	for _, row := range rows {
+		receipt, err := createDomainReceipt(row)
+		if err != nil {
+			return nil, err
+		}
+		receipts = append(receipts, receipt)
+	}
+
+	return receipts, nil
+}
+
+// attachReceipts adds the receipts to the accounts they're on, or the deposit's suspense receipts if they're not on one
+func attachReceipts(deposit *deposits.Deposit, receipts []*deposits.Receipt) error {
+	accounts := map[deposits.AccountId]*deposits.Account{}
+	for _, pot := range deposit.Pots {
+		for _, account := range pot.Accounts {
+			accounts[account.Id] = account
+		}
+	}
+
+	for _, receipt := range receipts {
+		if receipt.IsUnallocated() {
+			deposit.SuspenseReceipts = append(deposit.SuspenseReceipts, receipt)
+			continue
+		}
+
+		account, ok := accounts[receipt.AccountId]
+		if !ok {
+			return deposits.ErrAccountNotInPot
+		}
+		account.Receipts = append(account.Receipts, receipt)
+	}
+
+	return nil
+}
+
+// LockDeposit holds the deposit's row lock until the transaction ends, other transactions locking or updating it wait
+// for it, and read its status as it's committed once they get it
+func (store Store) LockDeposit(ctx context.Context, depositId deposits.DepositId) (*deposits.Deposit, error) {
 	const query = `--sql
 	SELECT *
 	FROM deposits
 	WHERE id=$1
+	FOR UPDATE
 	`
 
 	row := DepositRow{}
-	err := store.db.Get(&row, query, depositId.String())
+	err := store.db.GetContext(ctx, &row, query, depositId.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, deposits.ErrDepositNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	deposit, err := deposits.ParseDeposit(row.Id)
+	deposit, err := deposits.ParseDeposit(row.Id, row.Status)
+	if err != nil {
+		return nil, err
+	}
+	deposit.InvestorId, err = investors.ParseInvestorId(row.InvestorId)
 	if err != nil {
 		return nil, err
 	}
+	deposit.Version = row.Version
 
 	return deposit, nil
 }
 
+// ListDepositSummaries lists up to limit deposits matching the filter, newest first starting after its cursor, with
+// their accounts totalled for each currency
+func (store Store) ListDepositSummaries(ctx context.Context, filter deposits.DepositFilter, limit int) ([]*deposits.DepositSummary, error) {
+	// Define query separately for easy editting
+	// The page of deposits is picked first, so the limit counts deposits rather than their totals
+	const query = `--sql
+	WITH page AS (
+		SELECT d.id, d.investor_id, d.status, d.created_at
+		FROM deposits d
+		WHERE ($1::VARCHAR IS NULL OR d.investor_id = $1)
+			AND (cardinality($2::VARCHAR[]) = 0 OR d.status = ANY($2))
+			AND ($3::TIMESTAMPTZ IS NULL OR d.created_at >= $3)
+			AND ($4::TIMESTAMPTZ IS NULL OR d.created_at < $4)
+			AND ($5::TIMESTAMPTZ IS NULL OR (d.created_at, d.id) < ($5, $6))
+		ORDER BY d.created_at DESC, d.id DESC
+		LIMIT $7
+	)
+	SELECT
+		page.id,
+		page.investor_id,
+		page.status,
+		page.created_at,
+		totals.currency,
+		totals.nominal_amount,
+		totals.allocated_amount,
+		totals.funded_percentage
+	FROM page
+	LEFT JOIN LATERAL (
+		SELECT
+			a.currency,
+			SUM(a.nominal_amount)::BIGINT AS nominal_amount,
+			SUM(a.total_allocated_amount)::BIGINT AS allocated_amount,
+			CASE
+				WHEN SUM(a.nominal_amount) = 0 THEN 100
+				ELSE ROUND(100.0 * SUM(a.total_allocated_amount + a.pending_relief_amount) / SUM(a.nominal_amount), 2)
+			END::FLOAT8 AS funded_percentage
+		FROM pots p
+		JOIN accounts a ON a.pot_id = p.id
+		WHERE p.deposit_id = page.id
+		GROUP BY a.currency
+	) totals ON true
+	ORDER BY page.created_at DESC, page.id DESC, totals.currency
+	`
+
+	investorId := sql.NullString{String: filter.InvestorId.String(), Valid: filter.InvestorId != ""}
+	statuses := make([]string, 0, len(filter.Statuses))
+	for _, status := range filter.Statuses {
+		statuses = append(statuses, status.String())
+	}
+	createdFrom := sql.NullTime{Time: filter.CreatedFrom, Valid: !filter.CreatedFrom.IsZero()}
+	createdTo := sql.NullTime{Time: filter.CreatedTo, Valid: !filter.CreatedTo.IsZero()}
+	afterCreatedAt, afterId := sql.NullTime{}, sql.NullString{}
+	if filter.After != nil {
+		afterCreatedAt = sql.NullTime{Time: filter.After.CreatedAt, Valid: true}
+		afterId = sql.NullString{String: filter.After.Id.String(), Valid: true}
+	}
+
+	// Execute query
+	rows := []DepositSummaryRow{}
+	err := store.db.SelectContext(ctx, &rows, query, investorId, pq.Array(statuses), createdFrom, createdTo, afterCreatedAt, afterId, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return createDomainDepositSummaries(rows)
+}
+
+// createDomainDepositSummaries groups the rows for each deposit's currencies into one summary
+func createDomainDepositSummaries(rows []DepositSummaryRow) ([]*deposits.DepositSummary, error) {
+	summaries := []*deposits.DepositSummary{}
+	var summary *deposits.DepositSummary
+	for _, row := range rows {
+		// Rows for a deposit are together
+		if summary == nil || summary.Id.String() != row.Id {
+			deposit, err := deposits.ParseDeposit(row.Id, row.Status)
+			if err != nil {
+				return nil, err
+			}
+			investorId, err := investors.ParseInvestorId(row.InvestorId)
+			if err != nil {
+				return nil, err
+			}
+
+			summary = &deposits.DepositSummary{
+				Id:         deposit.Id,
+				InvestorId: investorId,
+				Status:     deposit.Status,
+				CreatedAt:  row.CreatedAt,
+				Totals:     []deposits.DepositTotal{},
+			}
+			summaries = append(summaries, summary)
+		}
+
+		// Deposits without accounts have no totals
+		if !row.Currency.Valid {
+			continue
+		}
+		nominalAmount, err := deposits.NewMoney(row.NominalAmount.Int64, row.Currency.String)
+		if err != nil {
+			return nil, err
+		}
+		allocatedAmount, err := deposits.NewMoney(row.AllocatedAmount.Int64, row.Currency.String)
+		if err != nil {
+			return nil, err
+		}
+		summary.Totals = append(summary.Totals, deposits.DepositTotal{
+			NominalAmount:    nominalAmount,
+			AllocatedAmount:  allocatedAmount,
+			FundedPercentage: row.FundedPercentage.Float64,
+		})
+	}
+
+	return summaries, nil
+}
+
 func (store Store) SaveDeposit(ctx context.Context, investorId investors.InvestorId, deposit deposits.Deposit) error {
 	// Define query separately for easy editting
 	const query = `--sql
-	INSERT INTO deposits (id, investor_id)
-	VALUES (:id, :investor_id)
+	INSERT INTO deposits (id, investor_id, status, version)
+	VALUES (:id, :investor_id, :status, :version)
 	`
 
 	// Create Row
 	row := DepositRow{
 		Id:         deposit.Id.String(),
 		InvestorId: investorId.String(),
+		Status:     deposit.Status.String(),
+		Version:    deposit.Version,
 	}
 
 	// Execute query
@@ -156,11 +534,58 @@ func (store Store) SaveDeposit(ctx context.Context, investorId investors.Investo
 		query,
 		row,
 	)
+	if err != nil {
+		return saveFailed(err)
+	}
+
+	return store.publishDepositEvents(ctx, deposit)
+}
+
+func (store Store) UpdateDepositStatus(ctx context.Context, deposit deposits.Deposit) error {
+	// Define query separately for easy editting
+	const query = `--sql
+	UPDATE deposits
+	SET status=:status, version=version + 1
+	WHERE id=:id AND version=:version
+	`
+
+	// Create Row
+	row := DepositRow{
+		Id:      deposit.Id.String(),
+		Status:  deposit.Status.String(),
+		Version: deposit.Version,
+	}
+
+	// Execute query
+	result, err := store.db.NamedExecContext(
+		ctx,
+		query,
+		row,
+	)
+	if err != nil {
+		return saveFailed(err)
+	}
+
+	// No rows means the version moved on since the deposit was read
+	updated, err := result.RowsAffected()
 	if err != nil {
 		return errors.Join(ErrSaveFailed, err)
 	}
+	if updated == 0 {
+		return deposits.ErrConcurrentModification
+	}
 
-	return nil
+	return store.publishDepositEvents(ctx, deposit)
+}
+
+// publishDepositEvents saves the deposit's status changes to the outbox
+func (store Store) publishDepositEvents(ctx context.Context, deposit deposits.Deposit) error {
+	messages, err := deposits.DepositOutboxMessages(deposit, time.Now())
+	if err != nil {
+		return errors.Join(ErrSaveFailed, err)
+	}
+
+	return store.saveOutboxMessages(ctx, messages)
 }
 
 type PotRow struct {
@@ -190,55 +615,83 @@ func (store Store) SavePot(ctx context.Context, depositId deposits.DepositId, po
 		row,
 	)
 	if err != nil {
-		return errors.Join(ErrSaveFailed, err)
+		return saveFailed(err)
 	}
 
 	return nil
 }
 
-type AccountRow struct {
-	Id                   string `db:"id"`
-	PotId                string `db:"pot_id"`
-	WrapperType          int    `db:"wrapper_type"`
-	NominalAmount        int64  `db:"nominal_amount"`
-	TotalAllocatedAmount int64  `db:"total_allocated_amount"`
-}
-
-func (store Store) GetAccount(ctx context.Context, accountId deposits.AccountId) (*deposits.Account, error) {
+func (store Store) UpdatePot(ctx context.Context, pot deposits.Pot) error {
+	// Define query separately for easy editting
 	const query = `--sql
-	SELECT *
-	FROM accounts
-	WHERE id=$1
+	UPDATE pots
+	SET name=:name
+	WHERE id=:id
 	`
 
-	row := AccountRow{}
-	err := store.db.Get(&row, query, accountId.String())
+	// Create Row
+	row := PotRow{
+		Id:   pot.Id.String(),
+		Name: pot.Name.String(),
+	}
+
+	// Execute query
+	_, err := store.db.NamedExecContext(
+		ctx,
+		query,
+		row,
+	)
 	if err != nil {
-		return nil, err
+		return saveFailed(err)
 	}
 
-	deposit, err := deposits.ParseAccount(row.Id, row.WrapperType, row.NominalAmount, row.TotalAllocatedAmount)
+	return nil
+}
+
+type AccountRow struct {
+	Id                   string    `db:"id"`
+	PotId                string    `db:"pot_id"`
+	WrapperType          string    `db:"wrapper_type"`
+	Currency             string    `db:"currency"`
+	NominalAmount        int64     `db:"nominal_amount"`
+	TotalAllocatedAmount int64     `db:"total_allocated_amount"`
+	PendingReliefAmount  int64     `db:"pending_relief_amount"`
+	Version              int64     `db:"version"`
+	CreatedAt            time.Time `db:"created_at"`
+}
+
+// GetAccount restores the account from its latest snapshot and the events after it
+func (store Store) GetAccount(ctx context.Context, accountId deposits.AccountId) (*deposits.Account, error) {
+	accounts, err := store.restoreAccounts(ctx, []string{accountId.String()})
 	if err != nil {
 		return nil, err
 	}
 
-	return deposit, nil
+	account, ok := accounts[accountId]
+	if !ok {
+		return nil, deposits.ErrAccountNotFound
+	}
+
+	return account, nil
 }
 
 func (store Store) SaveAccount(ctx context.Context, potId deposits.PotId, account deposits.Account) error {
 	// Define query separately for easy editting
 	const query = `--sql
-	INSERT INTO accounts (id, pot_id, wrapper_type, nominal_amount, total_allocated_amount)
-	VALUES (:id, :pot_id, :wrapper_type, :nominal_amount, :total_allocated_amount)
+	INSERT INTO accounts (id, pot_id, wrapper_type, currency, nominal_amount, total_allocated_amount, pending_relief_amount, version)
+	VALUES (:id, :pot_id, :wrapper_type, :currency, :nominal_amount, :total_allocated_amount, :pending_relief_amount, :version)
 	`
 
 	// Create Row
 	row := AccountRow{
 		Id:                   account.Id.String(),
 		PotId:                potId.String(),
-		WrapperType:          account.WrapperType.Int(),
+		WrapperType:          account.WrapperType.String(),
+		Currency:             account.NominalAmount.Currency.String(),
 		NominalAmount:        account.NominalAmount.Int64(),
 		TotalAllocatedAmount: account.TotalAllocatedAmount.Int64(),
+		PendingReliefAmount:  account.PendingReliefAmount.Int64(),
+		Version:              account.LatestVersion(),
 	}
 
 	// Execute query
@@ -248,61 +701,90 @@ func (store Store) SaveAccount(ctx context.Context, potId deposits.PotId, accoun
 		row,
 	)
 	if err != nil {
-		return errors.Join(ErrSaveFailed, err)
+		return saveFailed(err)
 	}
 
-	return nil
+	return store.appendAccountEvents(ctx, account)
 }
 
+// UpdateAccount appends the account's events to its stream, and projects its balances onto the accounts table
 func (store Store) UpdateAccount(ctx context.Context, account deposits.Account) error {
 	// Define query separately for easy editting
 	const query = `--sql
 	UPDATE accounts
 	SET wrapper_type=:wrapper_type,
 		nominal_amount=:nominal_amount,
-		total_allocated_amount=:total_allocated_amount
+		total_allocated_amount=:total_allocated_amount,
+		pending_relief_amount=:pending_relief_amount,
+		version=:latest_version
 	WHERE id=:id
+		AND version=:version
 	`
 
 	// Create Row
-	row := AccountRow{
-		Id:                   account.Id.String(),
-		WrapperType:          account.WrapperType.Int(),
-		NominalAmount:        account.NominalAmount.Int64(),
-		TotalAllocatedAmount: account.TotalAllocatedAmount.Int64(),
+	row := struct {
+		AccountRow
+		LatestVersion int64 `db:"latest_version"`
+	}{
+		AccountRow: AccountRow{
+			Id:                   account.Id.String(),
+			WrapperType:          account.WrapperType.String(),
+			Currency:             account.NominalAmount.Currency.String(),
+			NominalAmount:        account.NominalAmount.Int64(),
+			TotalAllocatedAmount: account.TotalAllocatedAmount.Int64(),
+			PendingReliefAmount:  account.PendingReliefAmount.Int64(),
+			Version:              account.Version,
+		},
+		LatestVersion: account.LatestVersion(),
 	}
 
 	// Execute query
-	_, err := store.db.NamedExecContext(
+	result, err := store.db.NamedExecContext(
 		ctx,
 		query,
 		row,
 	)
 	if err != nil {
-		return errors.Join(ErrSaveFailed, err)
+		return saveFailed(err)
 	}
 
-	return nil
-}
+	// No rows means the version moved on since the account was read
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return errors.Join(ErrSaveFailed, err)
+	}
+	if updated == 0 {
+		return deposits.ErrConcurrentModification
+	}
 
-type ReceiptRow struct {
-	Id              string `db:"id"`
-	AccountId       string `db:"account_id"`
-	AllocatedAmount int64  `db:"allocated_amount"`
+	return store.appendAccountEvents(ctx, account)
 }
 
-func (store Store) SaveReceipt(ctx context.Context, accountId deposits.AccountId, receipt deposits.Receipt) error {
+// ProjectAccount overwrites the account's balances on the accounts table, accounts updated to a later version while
+// it was being restored are left as they are
+func (store Store) ProjectAccount(ctx context.Context, account deposits.Account) error {
 	// Define query separately for easy editting
 	const query = `--sql
-	INSERT INTO receipts (id, account_id, allocated_amount)
-	VALUES (:id, :account_id, :allocated_amount)
+	UPDATE accounts
+	SET wrapper_type=:wrapper_type,
+		currency=:currency,
+		nominal_amount=:nominal_amount,
+		total_allocated_amount=:total_allocated_amount,
+		pending_relief_amount=:pending_relief_amount,
+		version=:version
+	WHERE id=:id
+		AND version <= :version
 	`
 
 	// Create Row
-	row := ReceiptRow{
-		Id:              receipt.Id.String(),
-		AccountId:       accountId.String(),
-		AllocatedAmount: receipt.AllocatedAmount.Int64(),
+	row := AccountRow{
+		Id:                   account.Id.String(),
+		WrapperType:          account.WrapperType.String(),
+		Currency:             account.NominalAmount.Currency.String(),
+		NominalAmount:        account.NominalAmount.Int64(),
+		TotalAllocatedAmount: account.TotalAllocatedAmount.Int64(),
+		PendingReliefAmount:  account.PendingReliefAmount.Int64(),
+		Version:              account.LatestVersion(),
 	}
 
 	// Execute query
@@ -317,3 +799,1456 @@ func (store Store) SaveReceipt(ctx context.Context, accountId deposits.AccountId
 
 	return nil
 }
+
+func (store Store) ListAccountIds(ctx context.Context) ([]deposits.AccountId, error) {
+	const query = `--sql
+	SELECT id
+	FROM accounts
+	WHERE pot_id IS NOT NULL
+	ORDER BY created_at, id
+	`
+
+	ids := []string{}
+	err := store.db.SelectContext(ctx, &ids, query)
+	if err != nil {
+		return nil, err
+	}
+
+	accountIds := []deposits.AccountId{}
+	for _, id := range ids {
+		accountId, err := deposits.ParseAccountId(id)
+		if err != nil {
+			return nil, err
+		}
+		accountIds = append(accountIds, accountId)
+	}
+
+	return accountIds, nil
+}
+
+// RemoveAccount appends the account's removal to its stream and takes it out of its pot, the account and its stream are
+// kept as a record of it
+func (store Store) RemoveAccount(ctx context.Context, account deposits.Account) error {
+	// Define query separately for easy editting
+	const query = `--sql
+	UPDATE accounts
+	SET pot_id=NULL,
+		version=:latest_version
+	WHERE id=:id
+		AND version=:version
+	`
+
+	// Create Row
+	row := struct {
+		Id            string `db:"id"`
+		Version       int64  `db:"version"`
+		LatestVersion int64  `db:"latest_version"`
+	}{
+		Id:            account.Id.String(),
+		Version:       account.Version,
+		LatestVersion: account.LatestVersion(),
+	}
+
+	// Execute query
+	result, err := store.db.NamedExecContext(
+		ctx,
+		query,
+		row,
+	)
+	if err != nil {
+		return saveFailed(err)
+	}
+
+	// No rows means the version moved on since the account was read
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return errors.Join(ErrSaveFailed, err)
+	}
+	if updated == 0 {
+		return deposits.ErrConcurrentModification
+	}
+
+	return store.appendAccountEvents(ctx, account)
+}
+
+func (store Store) AccountHasReceipts(ctx context.Context, accountId deposits.AccountId) (bool, error) {
+	const query = `--sql
+	SELECT EXISTS (
+		SELECT 1
+		FROM receipts
+		WHERE account_id=$1
+	)
+	`
+
+	hasReceipts := false
+	err := store.db.GetContext(ctx, &hasReceipts, query, accountId.String())
+	if err != nil {
+		return false, err
+	}
+
+	return hasReceipts, nil
+}
+
+type AccountEventRow struct {
+	AccountId string    `db:"account_id"`
+	Sequence  int64     `db:"sequence"`
+	Type      string    `db:"type"`
+	Payload   string    `db:"payload"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// AccountEventPayload holds what's in every type of account event, each type leaves out what it doesn't have
+type AccountEventPayload struct {
+	WrapperType          string `json:"wrapper_type,omitempty"`
+	Currency             string `json:"currency"`
+	NominalAmount        int64  `json:"nominal_amount,omitempty"`
+	TotalAllocatedAmount int64  `json:"total_allocated_amount,omitempty"`
+	PendingReliefAmount  int64  `json:"pending_relief_amount,omitempty"`
+	ReceiptId            string `json:"receipt_id,omitempty"`
+	Amount               int64  `json:"amount,omitempty"`
+	Relief               int64  `json:"relief,omitempty"`
+}
+
+type AccountSnapshotRow struct {
+	AccountId            string    `db:"account_id"`
+	Version              int64     `db:"version"`
+	WrapperType          string    `db:"wrapper_type"`
+	Currency             string    `db:"currency"`
+	NominalAmount        int64     `db:"nominal_amount"`
+	TotalAllocatedAmount int64     `db:"total_allocated_amount"`
+	PendingReliefAmount  int64     `db:"pending_relief_amount"`
+	CreatedAt            time.Time `db:"created_at"`
+}
+
+func createAccountEventRow(accountId deposits.AccountId, event deposits.RecordedEvent) (AccountEventRow, error) {
+	payload := AccountEventPayload{}
+	switch event := event.Event.(type) {
+	case deposits.AccountOpened:
+		payload.WrapperType = event.WrapperType.String()
+		payload.Currency = event.NominalAmount.Currency.String()
+		payload.NominalAmount = event.NominalAmount.Int64()
+		payload.TotalAllocatedAmount = event.TotalAllocatedAmount.Int64()
+		payload.PendingReliefAmount = event.PendingReliefAmount.Int64()
+	case deposits.NominalChanged:
+		payload.Currency = event.NominalAmount.Currency.String()
+		payload.NominalAmount = event.NominalAmount.Int64()
+	case deposits.ReceiptAllocated:
+		payload.Currency = event.Amount.Currency.String()
+		payload.ReceiptId = event.ReceiptId.String()
+		payload.Amount = event.Amount.Int64()
+		payload.Relief = event.Relief.Int64()
+	case deposits.ReceiptReversed:
+		payload.Currency = event.Amount.Currency.String()
+		payload.ReceiptId = event.ReceiptId.String()
+		payload.Amount = event.Amount.Int64()
+	case deposits.ReliefApplied:
+		payload.Currency = event.Amount.Currency.String()
+		payload.ReceiptId = event.ReceiptId.String()
+		payload.Amount = event.Amount.Int64()
+	case deposits.ReliefReleased:
+		payload.Currency = event.Amount.Currency.String()
+		payload.Amount = event.Amount.Int64()
+	case deposits.AccountRemoved:
+	default:
+		return AccountEventRow{}, deposits.ErrInvalidAccountEvent
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return AccountEventRow{}, err
+	}
+
+	return AccountEventRow{
+		AccountId: accountId.String(),
+		Sequence:  event.Sequence,
+		Type:      event.Event.Type().String(),
+		Payload:   string(data),
+	}, nil
+}
+
+func createDomainAccountEvent(row AccountEventRow) (deposits.RecordedEvent, error) {
+	// Removals don't carry anything
+	if deposits.AccountEventType(row.Type) == deposits.AccountEventTypeAccountRemoved {
+		return deposits.RecordedEvent{Sequence: row.Sequence, Event: deposits.AccountRemoved{}}, nil
+	}
+
+	payload := AccountEventPayload{}
+	err := json.Unmarshal([]byte(row.Payload), &payload)
+	if err != nil {
+		return deposits.RecordedEvent{}, err
+	}
+
+	// Every amount in an event is in the account's currency
+	money := func(amount int64) (deposits.Money, error) {
+		return deposits.NewMoney(amount, payload.Currency)
+	}
+	amount, err := money(payload.Amount)
+	if err != nil {
+		return deposits.RecordedEvent{}, err
+	}
+
+	var event deposits.AccountEvent
+	switch deposits.AccountEventType(row.Type) {
+	case deposits.AccountEventTypeAccountOpened:
+		wrapperType, err := deposits.ParseWrapperTypeCode(payload.WrapperType)
+		if err != nil {
+			return deposits.RecordedEvent{}, err
+		}
+		nominal, err := money(payload.NominalAmount)
+		if err != nil {
+			return deposits.RecordedEvent{}, err
+		}
+		total, err := money(payload.TotalAllocatedAmount)
+		if err != nil {
+			return deposits.RecordedEvent{}, err
+		}
+		pending, err := money(payload.PendingReliefAmount)
+		if err != nil {
+			return deposits.RecordedEvent{}, err
+		}
+		event = deposits.AccountOpened{
+			WrapperType:          wrapperType,
+			NominalAmount:        deposits.NominalAmount{Money: nominal},
+			TotalAllocatedAmount: deposits.TotalAllocatedAmount{Money: total},
+			PendingReliefAmount:  deposits.TotalAllocatedAmount{Money: pending},
+		}
+	case deposits.AccountEventTypeNominalChanged:
+		nominal, err := money(payload.NominalAmount)
+		if err != nil {
+			return deposits.RecordedEvent{}, err
+		}
+		event = deposits.NominalChanged{NominalAmount: deposits.NominalAmount{Money: nominal}}
+	case deposits.AccountEventTypeReceiptAllocated:
+		relief, err := money(payload.Relief)
+		if err != nil {
+			return deposits.RecordedEvent{}, err
+		}
+		event = deposits.ReceiptAllocated{
+			ReceiptId: deposits.ReceiptId(payload.ReceiptId),
+			Amount:    deposits.AllocatedAmount{Money: amount},
+			Relief:    deposits.AllocatedAmount{Money: relief},
+		}
+	case deposits.AccountEventTypeReceiptReversed:
+		event = deposits.ReceiptReversed{
+			ReceiptId: deposits.ReceiptId(payload.ReceiptId),
+			Amount:    deposits.AllocatedAmount{Money: amount},
+		}
+	case deposits.AccountEventTypeReliefApplied:
+		event = deposits.ReliefApplied{
+			ReceiptId: deposits.ReceiptId(payload.ReceiptId),
+			Amount:    deposits.AllocatedAmount{Money: amount},
+		}
+	case deposits.AccountEventTypeReliefReleased:
+		event = deposits.ReliefReleased{Amount: deposits.AllocatedAmount{Money: amount}}
+	default:
+		return deposits.RecordedEvent{}, deposits.ErrInvalidAccountEvent
+	}
+
+	return deposits.RecordedEvent{
+		Sequence: row.Sequence,
+		Event:    event,
+	}, nil
+}
+
+func createDomainAccountSnapshot(row AccountSnapshotRow) (*deposits.Account, error) {
+	accountId, err := deposits.ParseAccountId(row.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	wrapperType, err := deposits.ParseWrapperTypeCode(row.WrapperType)
+	if err != nil {
+		return nil, err
+	}
+	nominal, err := deposits.NewMoney(row.NominalAmount, row.Currency)
+	if err != nil {
+		return nil, err
+	}
+	total, err := deposits.NewMoney(row.TotalAllocatedAmount, row.Currency)
+	if err != nil {
+		return nil, err
+	}
+	pending, err := deposits.NewMoney(row.PendingReliefAmount, row.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deposits.Account{
+		Id:                   accountId,
+		WrapperType:          wrapperType,
+		NominalAmount:        deposits.NominalAmount{Money: nominal},
+		TotalAllocatedAmount: deposits.TotalAllocatedAmount{Money: total},
+		PendingReliefAmount:  deposits.TotalAllocatedAmount{Money: pending},
+		Version:              row.Version,
+	}, nil
+}
+
+// appendAccountEvents saves the account's events to the end of its stream, with a snapshot if one's due
+func (store Store) appendAccountEvents(ctx context.Context, account deposits.Account) error {
+	// Define query separately for easy editting
+	const query = `--sql
+	INSERT INTO account_events (account_id, sequence, type, payload)
+	VALUES (:account_id, :sequence, :type, :payload)
+	`
+
+	for _, event := range account.Events {
+		// Create Row
+		row, err := createAccountEventRow(account.Id, event)
+		if err != nil {
+			return errors.Join(ErrSaveFailed, err)
+		}
+
+		// Execute query
+		_, err = store.db.NamedExecContext(
+			ctx,
+			query,
+			row,
+		)
+
+		// Someone else has already taken the sequence
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation && pqErr.Constraint == accountEventsConstraint {
+			return deposits.ErrConcurrentModification
+		}
+		if err != nil {
+			return saveFailed(err)
+		}
+	}
+
+	// Publish the events
+	messages, err := deposits.AccountOutboxMessages(account, time.Now())
+	if err != nil {
+		return errors.Join(ErrSaveFailed, err)
+	}
+	err = store.saveOutboxMessages(ctx, messages)
+	if err != nil {
+		return err
+	}
+
+	if !account.SnapshotDue() {
+		return nil
+	}
+
+	return store.saveAccountSnapshot(ctx, account)
+}
+
+func (store Store) saveAccountSnapshot(ctx context.Context, account deposits.Account) error {
+	// Define query separately for easy editting
+	const query = `--sql
+	INSERT INTO account_snapshots (account_id, version, wrapper_type, currency, nominal_amount, total_allocated_amount, pending_relief_amount)
+	VALUES (:account_id, :version, :wrapper_type, :currency, :nominal_amount, :total_allocated_amount, :pending_relief_amount)
+	`
+
+	// Create Row
+	row := AccountSnapshotRow{
+		AccountId:            account.Id.String(),
+		Version:              account.LatestVersion(),
+		WrapperType:          account.WrapperType.String(),
+		Currency:             account.NominalAmount.Currency.String(),
+		NominalAmount:        account.NominalAmount.Int64(),
+		TotalAllocatedAmount: account.TotalAllocatedAmount.Int64(),
+		PendingReliefAmount:  account.PendingReliefAmount.Int64(),
+	}
+
+	// Execute query
+	_, err := store.db.NamedExecContext(
+		ctx,
+		query,
+		row,
+	)
+	if err != nil {
+		return saveFailed(err)
+	}
+
+	return nil
+}
+
+// ReplaceAccountSnapshots deletes the account's snapshots, which may have been taken of balances that drifted, and
+// snapshots it at its latest version
+func (store Store) ReplaceAccountSnapshots(ctx context.Context, account deposits.Account) error {
+	// Define query separately for easy editting
+	const query = `--sql
+	DELETE FROM account_snapshots
+	WHERE account_id=$1
+	`
+
+	// Execute query
+	_, err := store.db.ExecContext(
+		ctx,
+		query,
+		account.Id.String(),
+	)
+	if err != nil {
+		return saveFailed(err)
+	}
+
+	return store.saveAccountSnapshot(ctx, account)
+}
+
+// restoreAccounts restores each of the accounts from its latest snapshot and the events after it, accounts without a
+// stream are left out
+func (store Store) restoreAccounts(ctx context.Context, accountIds []string) (map[deposits.AccountId]*deposits.Account, error) {
+	const snapshotsQuery = `--sql
+	SELECT DISTINCT ON (account_id) *
+	FROM account_snapshots
+	WHERE account_id = ANY($1)
+	ORDER BY account_id, version DESC
+	`
+	const eventsQuery = `--sql
+	SELECT e.*
+	FROM account_events e
+	LEFT JOIN LATERAL (
+		SELECT MAX(s.version) AS version
+		FROM account_snapshots s
+		WHERE s.account_id = e.account_id
+	) latest ON true
+	WHERE e.account_id = ANY($1)
+		AND e.sequence > COALESCE(latest.version, 0)
+	ORDER BY e.account_id, e.sequence
+	`
+
+	snapshotRows := []AccountSnapshotRow{}
+	err := store.db.SelectContext(ctx, &snapshotRows, snapshotsQuery, pq.Array(accountIds))
+	if err != nil {
+		return nil, err
+	}
+	snapshots := map[deposits.AccountId]*deposits.Account{}
+	for _, row := range snapshotRows {
+		snapshot, err := createDomainAccountSnapshot(row)
+		if err != nil {
+			return nil, err
+		}
+		snapshots[snapshot.Id] = snapshot
+	}
+
+	eventRows := []AccountEventRow{}
+	err = store.db.SelectContext(ctx, &eventRows, eventsQuery, pq.Array(accountIds))
+	if err != nil {
+		return nil, err
+	}
+	events := map[deposits.AccountId][]deposits.RecordedEvent{}
+	for _, row := range eventRows {
+		event, err := createDomainAccountEvent(row)
+		if err != nil {
+			return nil, err
+		}
+		accountId := deposits.AccountId(row.AccountId)
+		events[accountId] = append(events[accountId], event)
+	}
+
+	accounts := map[deposits.AccountId]*deposits.Account{}
+	for _, id := range accountIds {
+		accountId := deposits.AccountId(id)
+		if snapshots[accountId] == nil && events[accountId] == nil {
+			continue
+		}
+
+		account, err := deposits.RestoreAccount(accountId, snapshots[accountId], events[accountId])
+		if err != nil {
+			return nil, err
+		}
+		accounts[accountId] = account
+	}
+
+	return accounts, nil
+}
+
+// GetAccountEvents returns the account's whole stream, oldest first
+func (store Store) GetAccountEvents(ctx context.Context, accountId deposits.AccountId) ([]deposits.RecordedEvent, error) {
+	const query = `--sql
+	SELECT *
+	FROM account_events
+	WHERE account_id=$1
+	ORDER BY sequence
+	`
+
+	rows := []AccountEventRow{}
+	err := store.db.SelectContext(ctx, &rows, query, accountId.String())
+	if err != nil {
+		return nil, err
+	}
+
+	events := []deposits.RecordedEvent{}
+	for _, row := range rows {
+		event, err := createDomainAccountEvent(row)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+type ReceiptRow struct {
+	Id              string         `db:"id"`
+	AccountId       sql.NullString `db:"account_id"`
+	Currency        string         `db:"currency"`
+	AllocatedAmount int64          `db:"allocated_amount"`
+	IdempotencyKey  sql.NullString `db:"idempotency_key"`
+	// DepositReceiptId is set if the receipt was allocated from a deposit receipt
+	DepositReceiptId  sql.NullString `db:"deposit_receipt_id"`
+	OverflowOf        sql.NullString `db:"overflow_of"`
+	SuspenseDepositId sql.NullString `db:"suspense_deposit_id"`
+	AccountReference  sql.NullString `db:"account_reference"`
+	UnallocatedAmount int64          `db:"unallocated_amount"`
+	AllocatedFrom     sql.NullString `db:"allocated_from"`
+	Version           int64          `db:"version"`
+	CreatedAt         time.Time      `db:"created_at"`
+	ReceivedAt        time.Time      `db:"received_at"`
+}
+
+func (store Store) SaveReceipt(ctx context.Context, accountId deposits.AccountId, receipt deposits.Receipt) error {
+	// Define query separately for easy editting
+	const query = `--sql
+	INSERT INTO receipts (id, account_id, currency, allocated_amount, idempotency_key, deposit_receipt_id, overflow_of, suspense_deposit_id, account_reference, unallocated_amount, allocated_from, version, received_at)
+	VALUES (:id, :account_id, :currency, :allocated_amount, :idempotency_key, :deposit_receipt_id, :overflow_of, :suspense_deposit_id, :account_reference, :unallocated_amount, :allocated_from, :version, :received_at)
+	`
+
+	// Create Row
+	row := ReceiptRow{
+		Id:                receipt.Id.String(),
+		AccountId:         sql.NullString{String: accountId.String(), Valid: accountId != ""},
+		Currency:          receipt.AllocatedAmount.Currency.String(),
+		AllocatedAmount:   receipt.AllocatedAmount.Int64(),
+		IdempotencyKey:    sql.NullString{String: receipt.IdempotencyKey.String(), Valid: receipt.IdempotencyKey != ""},
+		DepositReceiptId:  sql.NullString{String: receipt.DepositReceiptId.String(), Valid: receipt.DepositReceiptId != ""},
+		OverflowOf:        sql.NullString{String: receipt.OverflowOf.String(), Valid: receipt.OverflowOf != ""},
+		SuspenseDepositId: sql.NullString{String: receipt.SuspenseDepositId.String(), Valid: receipt.SuspenseDepositId != ""},
+		AccountReference:  sql.NullString{String: receipt.AccountReference, Valid: receipt.AccountReference != ""},
+		UnallocatedAmount: receipt.UnallocatedAmount.Int64(),
+		AllocatedFrom:     sql.NullString{String: receipt.AllocatedFrom.String(), Valid: receipt.AllocatedFrom != ""},
+		Version:           receipt.Version,
+		ReceivedAt:        receipt.ReceivedAt,
+	}
+
+	// Execute query
+	_, err := store.db.NamedExecContext(
+		ctx,
+		query,
+		row,
+	)
+	if err != nil {
+		// Another receipt with the same key was saved first
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation && pqErr.Constraint == receiptIdempotencyKeyConstraint {
+			return errors.Join(deposits.ErrConcurrentModification, err)
+		}
+		return saveFailed(err)
+	}
+
+	return nil
+}
+
+func createDomainReceipt(row ReceiptRow) (*deposits.Receipt, error) {
+	receipt, err := deposits.ParseReceipt(row.Id, row.Currency, row.AllocatedAmount)
+	if err != nil {
+		return nil, err
+	}
+	if row.AccountId.Valid {
+		receipt.AccountId, err = deposits.ParseAccountId(row.AccountId.String)
+		if err != nil {
+			return nil, err
+		}
+	}
+	receipt.IdempotencyKey = deposits.IdempotencyKey(row.IdempotencyKey.String)
+	receipt.DepositReceiptId = deposits.DepositReceiptId(row.DepositReceiptId.String)
+	receipt.OverflowOf = deposits.ReceiptId(row.OverflowOf.String)
+	receipt.SuspenseDepositId = deposits.DepositId(row.SuspenseDepositId.String)
+	receipt.AccountReference = row.AccountReference.String
+	receipt.AllocatedFrom = deposits.ReceiptId(row.AllocatedFrom.String)
+	receipt.Version = row.Version
+	receipt.ReceivedAt = row.ReceivedAt
+
+	// Only receipts without an account have cash to allocate
+	if receipt.IsUnallocated() {
+		unallocated, err := deposits.NewMoney(row.UnallocatedAmount, row.Currency)
+		if err != nil {
+			return nil, err
+		}
+		receipt.UnallocatedAmount, err = deposits.NewAllocatedAmount(unallocated)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return receipt, nil
+}
+
+func (store Store) GetReceipt(ctx context.Context, receiptId deposits.ReceiptId) (*deposits.Receipt, error) {
+	const query = `--sql
+	SELECT *
+	FROM receipts
+	WHERE id=$1
+	`
+
+	row := ReceiptRow{}
+	err := store.db.GetContext(ctx, &row, query, receiptId.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, deposits.ErrReceiptNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return createDomainReceipt(row)
+}
+
+func (store Store) GetReceiptByIdempotencyKey(ctx context.Context, key deposits.IdempotencyKey) (*deposits.Receipt, error) {
+	const query = `--sql
+	SELECT *
+	FROM receipts
+	WHERE idempotency_key=$1
+	`
+
+	row := ReceiptRow{}
+	err := store.db.GetContext(ctx, &row, query, key.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, deposits.ErrReceiptNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return createDomainReceipt(row)
+}
+
+func (store Store) GetOverflowReceipt(ctx context.Context, receiptId deposits.ReceiptId) (*deposits.Receipt, error) {
+	const query = `--sql
+	SELECT *
+	FROM receipts
+	WHERE overflow_of=$1
+	`
+
+	row := ReceiptRow{}
+	err := store.db.GetContext(ctx, &row, query, receiptId.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, deposits.ErrReceiptNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return createDomainReceipt(row)
+}
+
+func (store Store) GetUnallocatedReceipts(ctx context.Context, depositId deposits.DepositId) ([]*deposits.Receipt, error) {
+	const query = `--sql
+	SELECT *
+	FROM receipts
+	WHERE account_id IS NULL
+		AND unallocated_amount > 0
+		AND ($1 = '' OR suspense_deposit_id = $1)
+	ORDER BY created_at, id
+	`
+
+	rows := []ReceiptRow{}
+	err := store.db.SelectContext(ctx, &rows, query, depositId.String())
+	if err != nil {
+		return nil, err
+	}
+
+	receipts := []*deposits.Receipt{}
+	for _, row := range rows {
+		receipt, err := createDomainReceipt(row)
+		if err != nil {
+			return nil, err
+		}
+		receipts = append(receipts, receipt)
+	}
+
+	return receipts, nil
+}
+
+func (store Store) UpdateUnallocatedReceipt(ctx context.Context, receipt deposits.Receipt) error {
+	// Define query separately for easy editting
+	const query = `--sql
+	UPDATE receipts
+	SET unallocated_amount=:unallocated_amount, version=version + 1
+	WHERE id=:id AND version=:version AND account_id IS NULL
+	`
+
+	// Create Row
+	row := ReceiptRow{
+		Id:                receipt.Id.String(),
+		UnallocatedAmount: receipt.UnallocatedAmount.Int64(),
+		Version:           receipt.Version,
+	}
+
+	// Execute query
+	result, err := store.db.NamedExecContext(
+		ctx,
+		query,
+		row,
+	)
+	if err != nil {
+		return saveFailed(err)
+	}
+
+	// No rows means the version moved on since the receipt was read
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return errors.Join(ErrSaveFailed, err)
+	}
+	if updated == 0 {
+		return deposits.ErrConcurrentModification
+	}
+
+	return nil
+}
+
+type SuspenseAllocationRow struct {
+	Id                   string         `db:"id"`
+	UnallocatedReceiptId string         `db:"unallocated_receipt_id"`
+	ReceiptId            string         `db:"receipt_id"`
+	AccountId            string         `db:"account_id"`
+	Currency             string         `db:"currency"`
+	Amount               int64          `db:"amount"`
+	AllocatedBy          string         `db:"allocated_by"`
+	IdempotencyKey       sql.NullString `db:"idempotency_key"`
+	AllocatedAt          time.Time      `db:"allocated_at"`
+}
+
+func createDomainSuspenseAllocation(row SuspenseAllocationRow) (*deposits.SuspenseAllocation, error) {
+	return deposits.ParseSuspenseAllocation(
+		row.Id,
+		row.UnallocatedReceiptId,
+		row.ReceiptId,
+		row.AccountId,
+		row.Currency,
+		row.Amount,
+		row.AllocatedBy,
+		row.IdempotencyKey.String,
+		row.AllocatedAt,
+	)
+}
+
+func (store Store) SaveSuspenseAllocation(ctx context.Context, allocation deposits.SuspenseAllocation) error {
+	// Define query separately for easy editting
+	const query = `--sql
+	INSERT INTO suspense_allocations (id, unallocated_receipt_id, receipt_id, account_id, currency, amount, allocated_by, idempotency_key, allocated_at)
+	VALUES (:id, :unallocated_receipt_id, :receipt_id, :account_id, :currency, :amount, :allocated_by, :idempotency_key, :allocated_at)
+	`
+
+	// Create Row
+	row := SuspenseAllocationRow{
+		Id:                   allocation.Id.String(),
+		UnallocatedReceiptId: allocation.UnallocatedReceiptId.String(),
+		ReceiptId:            allocation.ReceiptId.String(),
+		AccountId:            allocation.AccountId.String(),
+		Currency:             allocation.Amount.Currency.String(),
+		Amount:               allocation.Amount.Int64(),
+		AllocatedBy:          allocation.AllocatedBy,
+		IdempotencyKey:       sql.NullString{String: allocation.IdempotencyKey.String(), Valid: allocation.IdempotencyKey != ""},
+		AllocatedAt:          allocation.AllocatedAt,
+	}
+
+	// Execute query
+	_, err := store.db.NamedExecContext(
+		ctx,
+		query,
+		row,
+	)
+	if err != nil {
+		// The key was used by someone else first, trying again finds their allocation
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+			return errors.Join(deposits.ErrConcurrentModification, err)
+		}
+		return saveFailed(err)
+	}
+
+	return nil
+}
+
+func (store Store) GetSuspenseAllocationByIdempotencyKey(ctx context.Context, key deposits.IdempotencyKey) (*deposits.SuspenseAllocation, error) {
+	const query = `--sql
+	SELECT *
+	FROM suspense_allocations
+	WHERE idempotency_key=$1
+	`
+
+	row := SuspenseAllocationRow{}
+	err := store.db.GetContext(ctx, &row, query, key.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, deposits.ErrSuspenseAllocationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return createDomainSuspenseAllocation(row)
+}
+
+func (store Store) GetSuspenseAllocations(ctx context.Context, receiptId deposits.ReceiptId) ([]*deposits.SuspenseAllocation, error) {
+	const query = `--sql
+	SELECT *
+	FROM suspense_allocations
+	WHERE unallocated_receipt_id=$1
+	ORDER BY allocated_at, id
+	`
+
+	rows := []SuspenseAllocationRow{}
+	err := store.db.SelectContext(ctx, &rows, query, receiptId.String())
+	if err != nil {
+		return nil, err
+	}
+
+	allocations := []*deposits.SuspenseAllocation{}
+	for _, row := range rows {
+		allocation, err := createDomainSuspenseAllocation(row)
+		if err != nil {
+			return nil, err
+		}
+		allocations = append(allocations, allocation)
+	}
+
+	return allocations, nil
+}
+
+type DepositReceiptRow struct {
+	Id              string         `db:"id"`
+	DepositId       string         `db:"deposit_id"`
+	Currency        string         `db:"currency"`
+	AllocatedAmount int64          `db:"allocated_amount"`
+	IdempotencyKey  sql.NullString `db:"idempotency_key"`
+	ReceivedAt      time.Time      `db:"received_at"`
+}
+
+func (store Store) SaveDepositReceipt(ctx context.Context, depositReceipt deposits.DepositReceipt) error {
+	// Define query separately for easy editting
+	const query = `--sql
+	INSERT INTO deposit_receipts (id, deposit_id, currency, allocated_amount, idempotency_key, received_at)
+	VALUES (:id, :deposit_id, :currency, :allocated_amount, :idempotency_key, :received_at)
+	`
+
+	// Create Row
+	row := DepositReceiptRow{
+		Id:              depositReceipt.Id.String(),
+		DepositId:       depositReceipt.DepositId.String(),
+		Currency:        depositReceipt.AllocatedAmount.Currency.String(),
+		AllocatedAmount: depositReceipt.AllocatedAmount.Int64(),
+		IdempotencyKey:  sql.NullString{String: depositReceipt.IdempotencyKey.String(), Valid: depositReceipt.IdempotencyKey != ""},
+		ReceivedAt:      depositReceipt.ReceivedAt,
+	}
+
+	// Execute query
+	_, err := store.db.NamedExecContext(
+		ctx,
+		query,
+		row,
+	)
+	if err != nil {
+		// Another deposit receipt with the same key was saved first
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+			return errors.Join(deposits.ErrConcurrentModification, err)
+		}
+		return saveFailed(err)
+	}
+
+	return nil
+}
+
+func (store Store) GetDepositReceiptByIdempotencyKey(ctx context.Context, key deposits.IdempotencyKey) (*deposits.DepositReceipt, error) {
+	const query = `--sql
+	SELECT *
+	FROM deposit_receipts
+	WHERE idempotency_key=$1
+	`
+	const receiptsQuery = `--sql
+	SELECT *
+	FROM receipts
+	WHERE deposit_receipt_id=$1
+	`
+
+	row := DepositReceiptRow{}
+	err := store.db.GetContext(ctx, &row, query, key.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, deposits.ErrDepositReceiptNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	depositReceipt, err := deposits.ParseDepositReceipt(row.Id, row.DepositId, row.Currency, row.AllocatedAmount, row.IdempotencyKey.String)
+	if err != nil {
+		return nil, err
+	}
+	depositReceipt.ReceivedAt = row.ReceivedAt
+
+	// Attach the child receipts
+	receiptRows := []ReceiptRow{}
+	err = store.db.SelectContext(ctx, &receiptRows, receiptsQuery, row.Id)
+	if err != nil {
+		return nil, err
+	}
+	for _, receiptRow := range receiptRows {
+		receipt, err := createDomainReceipt(receiptRow)
+		if err != nil {
+			return nil, err
+		}
+		depositReceipt.Receipts = append(depositReceipt.Receipts, receipt)
+	}
+
+	return depositReceipt, nil
+}
+
+type ReversalRow struct {
+	Id             string         `db:"id"`
+	ReceiptId      string         `db:"receipt_id"`
+	AccountId      sql.NullString `db:"account_id"`
+	Currency       string         `db:"currency"`
+	Amount         int64          `db:"amount"`
+	Reason         string         `db:"reason"`
+	IdempotencyKey sql.NullString `db:"idempotency_key"`
+	CreatedAt      time.Time      `db:"created_at"`
+}
+
+func createDomainReversal(row ReversalRow) (*deposits.Reversal, error) {
+	return deposits.ParseReversal(
+		row.Id,
+		row.ReceiptId,
+		row.AccountId.String,
+		row.Currency,
+		row.Amount,
+		row.Reason,
+		row.IdempotencyKey.String,
+		row.CreatedAt,
+	)
+}
+
+func (store Store) SaveReversal(ctx context.Context, reversal deposits.Reversal) error {
+	// Define query separately for easy editting
+	const query = `--sql
+	INSERT INTO receipt_reversals (id, receipt_id, account_id, currency, amount, reason, idempotency_key, created_at)
+	VALUES (:id, :receipt_id, :account_id, :currency, :amount, :reason, :idempotency_key, :created_at)
+	`
+
+	// Create Row
+	row := ReversalRow{
+		Id:             reversal.Id.String(),
+		ReceiptId:      reversal.ReceiptId.String(),
+		AccountId:      sql.NullString{String: reversal.AccountId.String(), Valid: reversal.AccountId != ""},
+		Currency:       reversal.Amount.Currency.String(),
+		Amount:         reversal.Amount.Int64(),
+		Reason:         reversal.Reason.String(),
+		IdempotencyKey: sql.NullString{String: reversal.IdempotencyKey.String(), Valid: reversal.IdempotencyKey != ""},
+		CreatedAt:      reversal.CreatedAt,
+	}
+
+	// Execute query
+	_, err := store.db.NamedExecContext(
+		ctx,
+		query,
+		row,
+	)
+	if err != nil {
+		// The receipt was reversed, or the key used, by someone else first
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+			return errors.Join(deposits.ErrConcurrentModification, err)
+		}
+		return saveFailed(err)
+	}
+
+	return nil
+}
+
+func (store Store) GetReversalByReceiptId(ctx context.Context, receiptId deposits.ReceiptId) (*deposits.Reversal, error) {
+	const query = `--sql
+	SELECT *
+	FROM receipt_reversals
+	WHERE receipt_id=$1
+	`
+
+	row := ReversalRow{}
+	err := store.db.GetContext(ctx, &row, query, receiptId.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, deposits.ErrReversalNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return createDomainReversal(row)
+}
+
+func (store Store) GetReversalByIdempotencyKey(ctx context.Context, key deposits.IdempotencyKey) (*deposits.Reversal, error) {
+	const query = `--sql
+	SELECT *
+	FROM receipt_reversals
+	WHERE idempotency_key=$1
+	`
+
+	row := ReversalRow{}
+	err := store.db.GetContext(ctx, &row, query, key.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, deposits.ErrReversalNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return createDomainReversal(row)
+}
+
+func (store Store) GetAccountInvestorId(ctx context.Context, accountId deposits.AccountId) (investors.InvestorId, error) {
+	const query = `--sql
+	SELECT d.investor_id
+	FROM accounts a
+	JOIN pots p ON p.id = a.pot_id
+	JOIN deposits d ON d.id = p.deposit_id
+	WHERE a.id = $1
+	`
+
+	var investorId string
+	err := store.db.GetContext(ctx, &investorId, query, accountId.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", deposits.ErrAccountNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return investors.ParseInvestorId(investorId)
+}
+
+func (store Store) GetAccountDepositId(ctx context.Context, accountId deposits.AccountId) (deposits.DepositId, error) {
+	const query = `--sql
+	SELECT p.deposit_id
+	FROM accounts a
+	JOIN pots p ON p.id = a.pot_id
+	WHERE a.id = $1
+	`
+
+	var depositId string
+	err := store.db.GetContext(ctx, &depositId, query, accountId.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", deposits.ErrAccountNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return deposits.ParseDepositId(depositId)
+}
+
+type ISASubscriptionRow struct {
+	ReceiptId  string `db:"receipt_id"`
+	InvestorId string `db:"investor_id"`
+	AccountId  string `db:"account_id"`
+	TaxYear    int    `db:"tax_year"`
+	Currency   string `db:"currency"`
+	Amount     int64  `db:"amount"`
+}
+
+func (store Store) GetISAAllowance(ctx context.Context, investorId investors.InvestorId, taxYear deposits.TaxYear) (*deposits.ISAAllowance, error) {
+	const query = `--sql
+	SELECT COALESCE(SUM(amount), 0)
+	FROM isa_subscriptions
+	WHERE investor_id = $1
+		AND tax_year = $2
+		AND currency = $3
+	`
+
+	currency := deposits.ISAAnnualAllowance.Currency.String()
+
+	var usedAmount int64
+	err := store.db.GetContext(ctx, &usedAmount, query, investorId.String(), taxYear.Int(), currency)
+	if err != nil {
+		return nil, err
+	}
+
+	used, err := deposits.NewMoney(usedAmount, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return deposits.NewISAAllowance(investorId, taxYear, used)
+}
+
+// LockISAAllowance takes a lock on the investor's tax year before reading the allowance, held until the transaction
+// ends, so receipts to the investor's ISAs subscribe one at a time and each sees what the last used
+//
+// It's an advisory lock as there's no allowance row to lock until the first subscription's saved
+func (store Store) LockISAAllowance(ctx context.Context, investorId investors.InvestorId, taxYear deposits.TaxYear) (*deposits.ISAAllowance, error) {
+	const query = `--sql
+	SELECT pg_advisory_xact_lock(hashtext('isa_subscriptions'), hashtext($1 || '/' || $2::TEXT))
+	`
+
+	_, err := store.db.ExecContext(ctx, query, investorId.String(), taxYear.Int())
+	if err != nil {
+		return nil, err
+	}
+
+	return store.GetISAAllowance(ctx, investorId, taxYear)
+}
+
+func (store Store) SaveISASubscription(ctx context.Context, subscription deposits.ISASubscription) error {
+	// Define query separately for easy editting
+	const query = `--sql
+	INSERT INTO isa_subscriptions (receipt_id, investor_id, account_id, tax_year, currency, amount)
+	VALUES (:receipt_id, :investor_id, :account_id, :tax_year, :currency, :amount)
+	`
+
+	// Create Row
+	row := ISASubscriptionRow{
+		ReceiptId:  subscription.ReceiptId.String(),
+		InvestorId: subscription.InvestorId.String(),
+		AccountId:  subscription.AccountId.String(),
+		TaxYear:    subscription.TaxYear.Int(),
+		Currency:   subscription.Amount.Currency.String(),
+		Amount:     subscription.Amount.Int64(),
+	}
+
+	// Execute query
+	_, err := store.db.NamedExecContext(
+		ctx,
+		query,
+		row,
+	)
+	if err != nil {
+		return saveFailed(err)
+	}
+
+	return nil
+}
+
+func (store Store) DeleteISASubscription(ctx context.Context, receiptId deposits.ReceiptId) error {
+	// Define query separately for easy editting
+	const query = `--sql
+	DELETE FROM isa_subscriptions
+	WHERE receipt_id=$1
+	`
+
+	// Execute query
+	_, err := store.db.ExecContext(
+		ctx,
+		query,
+		receiptId.String(),
+	)
+	if err != nil {
+		return errors.Join(ErrSaveFailed, err)
+	}
+
+	return nil
+}
+
+type ReliefClaimRow struct {
+	Id              string         `db:"id"`
+	InvestorId      string         `db:"investor_id"`
+	AccountId       string         `db:"account_id"`
+	ReceiptId       string         `db:"receipt_id"`
+	Currency        string         `db:"currency"`
+	NetAmount       int64          `db:"net_amount"`
+	ReliefAmount    int64          `db:"relief_amount"`
+	Status          string         `db:"status"`
+	BatchId         sql.NullString `db:"batch_id"`
+	ReliefReceiptId sql.NullString `db:"relief_receipt_id"`
+	CreatedAt       time.Time      `db:"created_at"`
+}
+
+func createReliefClaimRow(claim deposits.ReliefClaim) ReliefClaimRow {
+	return ReliefClaimRow{
+		Id:              claim.Id.String(),
+		InvestorId:      claim.InvestorId.String(),
+		AccountId:       claim.AccountId.String(),
+		ReceiptId:       claim.ReceiptId.String(),
+		Currency:        claim.NetAmount.Currency.String(),
+		NetAmount:       claim.NetAmount.Int64(),
+		ReliefAmount:    claim.ReliefAmount.Int64(),
+		Status:          claim.Status.String(),
+		BatchId:         sql.NullString{String: claim.BatchId.String(), Valid: claim.BatchId != ""},
+		ReliefReceiptId: sql.NullString{String: claim.ReliefReceiptId.String(), Valid: claim.ReliefReceiptId != ""},
+		CreatedAt:       claim.CreatedAt,
+	}
+}
+
+func createDomainReliefClaims(rows []ReliefClaimRow) ([]*deposits.ReliefClaim, error) {
+	claims := []*deposits.ReliefClaim{}
+	for _, row := range rows {
+		claim, err := deposits.ParseReliefClaim(
+			row.Id,
+			row.InvestorId,
+			row.AccountId,
+			row.ReceiptId,
+			row.Currency,
+			row.NetAmount,
+			row.ReliefAmount,
+			row.Status,
+			row.BatchId.String,
+			row.ReliefReceiptId.String,
+			row.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		claims = append(claims, claim)
+	}
+
+	return claims, nil
+}
+
+func (store Store) SaveReliefClaim(ctx context.Context, claim deposits.ReliefClaim) error {
+	// Define query separately for easy editting
+	const query = `--sql
+	INSERT INTO relief_claims (id, investor_id, account_id, receipt_id, currency, net_amount, relief_amount, status, batch_id, relief_receipt_id, created_at)
+	VALUES (:id, :investor_id, :account_id, :receipt_id, :currency, :net_amount, :relief_amount, :status, :batch_id, :relief_receipt_id, :created_at)
+	`
+
+	// Execute query
+	_, err := store.db.NamedExecContext(
+		ctx,
+		query,
+		createReliefClaimRow(claim),
+	)
+	if err != nil {
+		return saveFailed(err)
+	}
+
+	return nil
+}
+
+func (store Store) UpdateReliefClaim(ctx context.Context, claim deposits.ReliefClaim) error {
+	// Define query separately for easy editting
+	const query = `--sql
+	UPDATE relief_claims
+	SET status=:status,
+		batch_id=:batch_id,
+		relief_receipt_id=:relief_receipt_id
+	WHERE id=:id
+	`
+
+	// Execute query
+	_, err := store.db.NamedExecContext(
+		ctx,
+		query,
+		createReliefClaimRow(claim),
+	)
+	if err != nil {
+		return saveFailed(err)
+	}
+
+	return nil
+}
+
+func (store Store) GetReliefClaimByReceiptId(ctx context.Context, receiptId deposits.ReceiptId) (*deposits.ReliefClaim, error) {
+	const query = `--sql
+	SELECT *
+	FROM relief_claims
+	WHERE receipt_id = $1
+	`
+
+	rows := []ReliefClaimRow{}
+	err := store.db.SelectContext(ctx, &rows, query, receiptId.String())
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, deposits.ErrReliefClaimNotFound
+	}
+
+	claims, err := createDomainReliefClaims(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return claims[0], nil
+}
+
+func (store Store) GetPendingReliefClaims(ctx context.Context, before time.Time) ([]*deposits.ReliefClaim, error) {
+	const query = `--sql
+	SELECT *
+	FROM relief_claims
+	WHERE status = $1
+		AND created_at < $2
+	ORDER BY created_at
+	FOR UPDATE SKIP LOCKED
+	`
+
+	rows := []ReliefClaimRow{}
+	err := store.db.SelectContext(ctx, &rows, query, deposits.ReliefClaimStatusPending.String(), before)
+	if err != nil {
+		return nil, err
+	}
+
+	return createDomainReliefClaims(rows)
+}
+
+type ClaimBatchRow struct {
+	Id        string    `db:"id"`
+	Period    time.Time `db:"period"`
+	Status    string    `db:"status"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func createClaimBatchRow(batch deposits.ClaimBatch) ClaimBatchRow {
+	return ClaimBatchRow{
+		Id:        batch.Id.String(),
+		Period:    time.Date(batch.Period.Year, batch.Period.Month, 1, 0, 0, 0, 0, time.UTC),
+		Status:    batch.Status.String(),
+		CreatedAt: batch.CreatedAt,
+	}
+}
+
+func (store Store) SaveClaimBatch(ctx context.Context, batch deposits.ClaimBatch) error {
+	// Define query separately for easy editting
+	const query = `--sql
+	INSERT INTO relief_claim_batches (id, period, status, created_at)
+	VALUES (:id, :period, :status, :created_at)
+	`
+
+	// Execute query
+	_, err := store.db.NamedExecContext(
+		ctx,
+		query,
+		createClaimBatchRow(batch),
+	)
+	if err != nil {
+		return saveFailed(err)
+	}
+
+	return nil
+}
+
+func (store Store) UpdateClaimBatch(ctx context.Context, batch deposits.ClaimBatch) error {
+	// Define query separately for easy editting
+	const query = `--sql
+	UPDATE relief_claim_batches
+	SET status=:status
+	WHERE id=:id
+	`
+
+	// Execute query
+	_, err := store.db.NamedExecContext(
+		ctx,
+		query,
+		createClaimBatchRow(batch),
+	)
+	if err != nil {
+		return saveFailed(err)
+	}
+
+	return nil
+}
+
+func (store Store) GetClaimBatch(ctx context.Context, batchId deposits.ClaimBatchId) (*deposits.ClaimBatch, error) {
+	const batchQuery = `--sql
+	SELECT *
+	FROM relief_claim_batches
+	WHERE id = $1
+	`
+	const claimsQuery = `--sql
+	SELECT *
+	FROM relief_claims
+	WHERE batch_id = $1
+	ORDER BY created_at
+	`
+
+	row := ClaimBatchRow{}
+	err := store.db.GetContext(ctx, &row, batchQuery, batchId.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, deposits.ErrClaimBatchNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := deposits.ParseClaimBatch(row.Id, row.Period, row.Status, row.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	// Attach the claims
+	claimRows := []ReliefClaimRow{}
+	err = store.db.SelectContext(ctx, &claimRows, claimsQuery, batchId.String())
+	if err != nil {
+		return nil, err
+	}
+	batch.Claims, err = createDomainReliefClaims(claimRows)
+	if err != nil {
+		return nil, err
+	}
+
+	return batch, nil
+}
+
+// LockInvestorWrapperTypes locks the investor's row, then gets the wrapper types of the accounts in the investor's
+// deposits, apart from the one excluded and those that were cancelled
+//
+// The lock's held until the transaction ends, so deposits created or amended for the investor at the same time are
+// checked one after the other, each seeing the accounts the last one gave them
+func (store Store) LockInvestorWrapperTypes(ctx context.Context, investorId investors.InvestorId, excluding deposits.DepositId) ([]deposits.WrapperType, error) {
+	// Define query separately for easy editting
+	const lockQuery = `--sql
+	SELECT id
+	FROM investors
+	WHERE id = $1
+	FOR UPDATE
+	`
+	const query = `--sql
+	SELECT DISTINCT a.wrapper_type
+	FROM accounts a
+	JOIN pots p ON p.id = a.pot_id
+	JOIN deposits d ON d.id = p.deposit_id
+	WHERE d.investor_id = $1
+		AND d.status <> $2
+		AND d.id <> $3
+	`
+
+	// Execute queries
+	var id string
+	err := store.db.GetContext(ctx, &id, lockQuery, investorId.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, investors.ErrInvestorNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	codes := []string{}
+	err = store.db.SelectContext(ctx, &codes, query, investorId.String(), deposits.DepositStatusCancelled.String(), excluding.String())
+	if err != nil {
+		return nil, err
+	}
+
+	wrapperTypes := make([]deposits.WrapperType, 0, len(codes))
+	for _, code := range codes {
+		wrapperType, err := deposits.ParseWrapperTypeCode(code)
+		if err != nil {
+			return nil, err
+		}
+		wrapperTypes = append(wrapperTypes, wrapperType)
+	}
+
+	return wrapperTypes, nil
+}
+
+// SaveJournal posts the journal with the ledger's store, on the same database or transaction as this Store
+func (store Store) SaveJournal(ctx context.Context, journal ledger.Journal) error {
+	return ledgerStore.NewStore(store.db).SaveJournal(ctx, journal)
+}
+
+func (store Store) SaveReceiptRejection(ctx context.Context, rejection deposits.ReceiptRejection) error {
+	message, err := deposits.ReceiptRejectionOutboxMessage(rejection)
+	if err != nil {
+		return err
+	}
+
+	return store.saveOutboxMessages(ctx, []outbox.Message{*message})
+}
+
+// saveOutboxMessages saves the messages with the outbox's store, on the same database or transaction as this Store, so
+// they're only published if everything else in it commits
+func (store Store) saveOutboxMessages(ctx context.Context, messages []outbox.Message) error {
+	messageStore := outboxStore.NewStore(store.db)
+	for _, message := range messages {
+		err := messageStore.SaveMessage(ctx, message)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -2,10 +2,17 @@ package deposits
 
 import (
 	"errors"
+	"slices"
+	"strings"
 
 	"github.com/google/uuid"
 )
 
+var (
+	ErrPotNotFound    = errors.New("pot not found in the deposit")
+	ErrInvalidPotName = errors.New("invalid pot name")
+)
+
 type Pot struct {
 	Id       PotId
 	Name     PotName
@@ -39,7 +46,12 @@ func (id PotId) String() string {
 
 type PotName string
 
+// NewPotName validates the pot's name, which can't be blank
 func NewPotName(name string) (PotName, error) {
+	if strings.TrimSpace(name) == "" {
+		return "", ErrInvalidPotName
+	}
+
 	return PotName(name), nil
 }
 
@@ -95,7 +107,40 @@ func (pot *Pot) AddAccount(account *Account) error {
 		}
 	}
 
+	// The wrapper decides if it can join the pot
+	policy, err := LookupWrapperPolicy(account.WrapperType)
+	if err != nil {
+		return err
+	}
+	err = policy.ValidateEligibility(*pot, *account)
+	if err != nil {
+		return err
+	}
+
 	// Append account to pot
 	pot.Accounts = append(pot.Accounts, account)
 	return nil
 }
+
+// Rename gives the pot a new name
+func (pot *Pot) Rename(name string) error {
+	potName, err := NewPotName(name)
+	if err != nil {
+		return err
+	}
+
+	pot.Name = potName
+	return nil
+}
+
+// RemoveAccount takes the account out of the pot, returning it
+func (pot *Pot) RemoveAccount(accountId AccountId) (*Account, error) {
+	for i, account := range pot.Accounts {
+		if account.Id == accountId {
+			pot.Accounts = slices.Delete(pot.Accounts, i, i+1)
+			return account, nil
+		}
+	}
+
+	return nil, ErrAccountNotInPot
+}
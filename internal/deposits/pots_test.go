@@ -15,6 +15,9 @@ func TestNewPot(t *testing.T) {
 		Id:   pot.Id,
 		Name: "abcdefg",
 	}, pot)
+
+	_, err = deposits.NewPot(" ")
+	require.ErrorIs(t, err, deposits.ErrInvalidPotName)
 }
 
 func TestParsePot(t *testing.T) {
@@ -33,7 +36,7 @@ func TestAddAccount(t *testing.T) {
 	pot, err := deposits.ParsePot(id, "Pot A")
 	require.NoError(t, err)
 
-	account, err := deposits.ParseAccount(uuid.NewString(), 1, 100, 0)
+	account, err := deposits.ParseAccount(uuid.NewString(), 1, "GBP", 100, 0)
 	require.NoError(t, err)
 
 	err = pot.AddAccount(account)
@@ -42,3 +45,36 @@ func TestAddAccount(t *testing.T) {
 	err = pot.AddAccount(account)
 	require.ErrorIs(t, err, deposits.ErrWrapperTypeExistsInPot)
 }
+
+func TestRenamePot(t *testing.T) {
+	pot, err := deposits.NewPot("Pot A")
+	require.NoError(t, err)
+
+	err = pot.Rename("Pot B")
+	require.NoError(t, err)
+	require.Equal(t, deposits.PotName("Pot B"), pot.Name)
+}
+
+func TestRemoveAccount(t *testing.T) {
+	pot, err := deposits.NewPot("Pot A")
+	require.NoError(t, err)
+
+	isa, err := deposits.NewAccount(deposits.WrapperTypeISA, gbp(100))
+	require.NoError(t, err)
+	gia, err := deposits.NewAccount(deposits.WrapperTypeGIA, gbp(100))
+	require.NoError(t, err)
+	require.NoError(t, pot.AddAccount(isa))
+	require.NoError(t, pot.AddAccount(gia))
+
+	removed, err := pot.RemoveAccount(isa.Id)
+	require.NoError(t, err)
+	require.Equal(t, isa, removed)
+	require.Equal(t, []*deposits.Account{gia}, pot.Accounts)
+
+	_, err = pot.RemoveAccount(isa.Id)
+	require.ErrorIs(t, err, deposits.ErrAccountNotInPot)
+
+	// The removed wrapper type can be added again
+	err = pot.AddAccount(isa)
+	require.NoError(t, err)
+}
@@ -2,22 +2,66 @@ package deposits
 
 import (
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 )
 
+var (
+	ErrInvalidIdempotencyKey = errors.New("invalid idempotency key given")
+	ErrIdempotencyKeyReused  = errors.New("idempotency key already used for a different receipt")
+	ErrReceiptNotFound       = errors.New("receipt not found")
+)
+
 type Receipt struct {
 	Id              ReceiptId
 	AccountId       AccountId
 	AllocatedAmount AllocatedAmount
+	IdempotencyKey  IdempotencyKey
+	// DepositReceiptId is set if the receipt was allocated from a DepositReceipt
+	DepositReceiptId DepositReceiptId
+	// OverflowOf is set on the excess of a receipt that was too big for its account, see Receipt.Overflow
+	OverflowOf ReceiptId
+	// SuspenseDepositId is set, instead of the AccountId, on overflow held in the deposit's suspense balance
+	SuspenseDepositId DepositId
+	// AccountReference is the reference an unallocated receipt arrived with
+	AccountReference string
+	// UnallocatedAmount is what's left of an unallocated receipt to allocate to accounts
+	UnallocatedAmount AllocatedAmount
+	// AllocatedFrom is set on receipts allocated from an unallocated receipt, see Receipt.AllocateTo
+	AllocatedFrom ReceiptId
+	// Version is the stored version the receipt was read at, updates to unallocated receipts only succeed if it's unchanged
+	Version int64
+	// ReceivedAt is when the payment arrived, which decides the tax year it uses ISA allowance in
+	ReceivedAt time.Time
+}
+
+// IdempotencyKey is the client or bank supplied reference for a payment, so redelivered payments are only received once
+type IdempotencyKey string
+
+// maxIdempotencyKeyLength is the longest key the receipts table will store
+const maxIdempotencyKeyLength = 255
+
+func NewIdempotencyKey(key string) (IdempotencyKey, error) {
+	if key == "" || len(key) > maxIdempotencyKeyLength {
+		return "", ErrInvalidIdempotencyKey
+	}
+
+	return IdempotencyKey(key), nil
 }
 
-type AllocatedAmount int64
+func (key IdempotencyKey) String() string {
+	return string(key)
+}
+
+type AllocatedAmount struct {
+	Money
+}
 
 type ReceiptId string
 
-// NewReceipt creates a new Receipt with a new Id
-func NewReceipt(allocatedAmount int64) (*Receipt, error) {
+// NewReceipt creates a new Receipt with a new Id, received now
+func NewReceipt(allocatedAmount Money) (*Receipt, error) {
 	id, err := newReceiptId()
 	if err != nil {
 		return nil, err
@@ -30,17 +74,22 @@ func NewReceipt(allocatedAmount int64) (*Receipt, error) {
 	return &Receipt{
 		Id:              id,
 		AllocatedAmount: amount,
+		ReceivedAt:      time.Now(),
 	}, nil
 }
 
 // ParseReceipt parses the given data into a Receipt type, ensuring it's valid data
-func ParseReceipt(id string, allocatedAmount int64) (*Receipt, error) {
+func ParseReceipt(id string, currency string, allocatedAmount int64) (*Receipt, error) {
 	receiptId, err := ParseReceiptId(id)
 	if err != nil {
 		return nil, err
 	}
 
-	receiptAllocatedAmount, err := NewAllocatedAmount(allocatedAmount)
+	amount, err := NewMoney(allocatedAmount, currency)
+	if err != nil {
+		return nil, err
+	}
+	receiptAllocatedAmount, err := NewAllocatedAmount(amount)
 	if err != nil {
 		return nil, err
 	}
@@ -53,16 +102,12 @@ func ParseReceipt(id string, allocatedAmount int64) (*Receipt, error) {
 	return receipt, nil
 }
 
-func NewAllocatedAmount(amount int64) (AllocatedAmount, error) {
-	if amount < 0 {
-		return 0, ErrAllocatedAmountNegative
+func NewAllocatedAmount(amount Money) (AllocatedAmount, error) {
+	if amount.IsNegative() {
+		return AllocatedAmount{}, ErrAllocatedAmountNegative
 	}
 
-	return AllocatedAmount(amount), nil
-}
-
-func (allocatedAmount AllocatedAmount) Int64() int64 {
-	return int64(allocatedAmount)
+	return AllocatedAmount{amount}, nil
 }
 
 func newReceiptId() (ReceiptId, error) {
@@ -1,18 +1,61 @@
 package deposits_test
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/iainvm/deposits/internal/deposits"
 	"github.com/stretchr/testify/require"
 )
 
 func TestNewReceipt(t *testing.T) {
+	before := time.Now()
 
-	receipt, err := deposits.NewReceipt(100)
+	receipt, err := deposits.NewReceipt(gbp(100))
 	require.NoError(t, err)
 	require.Equal(t, &deposits.Receipt{
 		Id:              receipt.Id,
-		AllocatedAmount: 100,
+		AllocatedAmount: deposits.AllocatedAmount{Money: gbp(100)},
+		ReceivedAt:      receipt.ReceivedAt,
 	}, receipt)
+	require.WithinRange(t, receipt.ReceivedAt, before, time.Now())
+}
+
+func TestNewIdempotencyKey(t *testing.T) {
+	testCases := []struct {
+		description   string
+		input         string
+		expectedError error
+		expectedValue deposits.IdempotencyKey
+	}{
+		{
+			description:   "passes for bank reference",
+			input:         "FP-20240506-000123",
+			expectedValue: deposits.IdempotencyKey("FP-20240506-000123"),
+		},
+		{
+			description:   "fails for blank key",
+			input:         "",
+			expectedError: deposits.ErrInvalidIdempotencyKey,
+		},
+		{
+			description:   "fails for long key",
+			input:         strings.Repeat("a", 256),
+			expectedError: deposits.ErrInvalidIdempotencyKey,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			actualValue, actualError := deposits.NewIdempotencyKey(testCase.input)
+			if testCase.expectedError != nil {
+				require.ErrorIs(t, actualError, testCase.expectedError)
+				return
+			}
+
+			require.NoError(t, actualError)
+			require.Equal(t, testCase.expectedValue, actualValue)
+		})
+	}
 }
@@ -0,0 +1,85 @@
+package deposits
+
+import (
+	"errors"
+	"time"
+)
+
+// rejectionReasons are the business rules a receipt can break, checked in order, with the reason published for each
+//
+// Anything else, like a missing account or a database failure, is the caller's to retry and isn't published
+var rejectionReasons = []struct {
+	err    error
+	reason string
+}{
+	{ErrDepositClosed, "DEPOSIT_CLOSED"},
+	{ErrNominalExceeded, "NOMINAL_EXCEEDED"},
+	{ErrAnnualAllowanceExceeded, "ANNUAL_ALLOWANCE_EXCEEDED"},
+	{ErrAllocationExceedsNominal, "ALLOCATION_EXCEEDS_NOMINAL"},
+	{ErrNoAccountsToAllocate, "NO_ACCOUNTS_TO_ALLOCATE"},
+	{ErrReceiptAlreadyAllocated, "RECEIPT_ALREADY_ALLOCATED"},
+	{ErrAllocationExceedsUnallocated, "ALLOCATION_EXCEEDS_UNALLOCATED"},
+	{ErrIdempotencyKeyReused, "IDEMPOTENCY_KEY_REUSED"},
+	{ErrCurrencyMismatch, "CURRENCY_MISMATCH"},
+}
+
+// ReceiptRejection is a payment that wasn't received because it broke a business rule, published so whoever's waiting
+// on it can chase it up
+type ReceiptRejection struct {
+	// ReceiptId is blank for deposit receipts and allocations, which hadn't created a receipt yet
+	ReceiptId        ReceiptId
+	DepositReceiptId DepositReceiptId
+	// AccountId is the account the payment was for, DepositId is set instead for deposit receipts
+	AccountId AccountId
+	DepositId DepositId
+	// AllocatedFrom is the unallocated receipt an allocation was from
+	AllocatedFrom  ReceiptId
+	IdempotencyKey IdempotencyKey
+	Amount         AllocatedAmount
+	// Reason is the business rule broken, e.g. "NOMINAL_EXCEEDED"
+	Reason     string
+	RejectedAt time.Time
+}
+
+// Reject returns the rejection of the receipt by the account, or nil if err isn't a business rule it broke
+func (receipt Receipt) Reject(accountId AccountId, err error, rejectedAt time.Time) *ReceiptRejection {
+	return newReceiptRejection(ReceiptRejection{
+		ReceiptId:      receipt.Id,
+		AccountId:      accountId,
+		IdempotencyKey: receipt.IdempotencyKey,
+		Amount:         receipt.AllocatedAmount,
+	}, err, rejectedAt)
+}
+
+// RejectAllocation returns the rejection of allocating the amount of the unallocated receipt to the account, with the
+// allocation's key, or nil if err isn't a business rule it broke
+func (receipt Receipt) RejectAllocation(accountId AccountId, amount Money, key IdempotencyKey, err error, rejectedAt time.Time) *ReceiptRejection {
+	return newReceiptRejection(ReceiptRejection{
+		AccountId:      accountId,
+		AllocatedFrom:  receipt.Id,
+		IdempotencyKey: key,
+		Amount:         AllocatedAmount{amount},
+	}, err, rejectedAt)
+}
+
+// Reject returns the rejection of the deposit receipt, or nil if err isn't a business rule it broke
+func (depositReceipt DepositReceipt) Reject(err error, rejectedAt time.Time) *ReceiptRejection {
+	return newReceiptRejection(ReceiptRejection{
+		DepositReceiptId: depositReceipt.Id,
+		DepositId:        depositReceipt.DepositId,
+		IdempotencyKey:   depositReceipt.IdempotencyKey,
+		Amount:           depositReceipt.AllocatedAmount,
+	}, err, rejectedAt)
+}
+
+func newReceiptRejection(rejection ReceiptRejection, err error, rejectedAt time.Time) *ReceiptRejection {
+	for _, rejectionReason := range rejectionReasons {
+		if errors.Is(err, rejectionReason.err) {
+			rejection.Reason = rejectionReason.reason
+			rejection.RejectedAt = rejectedAt
+			return &rejection
+		}
+	}
+
+	return nil
+}
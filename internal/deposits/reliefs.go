@@ -0,0 +1,401 @@
+package deposits
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iainvm/deposits/internal/investors"
+)
+
+var (
+	ErrReliefNotPending          = errors.New("tax relief is not pending on account")
+	ErrReliefClaimNotPending     = errors.New("tax relief claim is not pending")
+	ErrReliefClaimNotClaimed     = errors.New("tax relief claim has not been claimed")
+	ErrReliefClaimBatchPaid      = errors.New("tax relief claim batch already paid")
+	ErrNoPendingReliefClaims     = errors.New("no pending tax relief claims")
+	ErrInvalidReliefClaimStatus  = errors.New("invalid tax relief claim status")
+	ErrInvalidClaimBatchStatus   = errors.New("invalid tax relief claim batch status")
+	ErrInvalidClaimPeriod        = errors.New("invalid tax relief claim period")
+	ErrReliefClaimAmountMismatch = errors.New("tax relief claim amounts don't match")
+	ErrReliefClaimNotFound       = errors.New("tax relief claim not found")
+	ErrClaimBatchNotFound        = errors.New("tax relief claim batch not found")
+	ErrReliefAlreadyClaimed      = errors.New("tax relief already claimed from HMRC")
+)
+
+// BasicRateRelief returns the basic rate tax relief, 25% of the net contribution, rounded to the nearest minor unit
+func BasicRateRelief(net AllocatedAmount) AllocatedAmount {
+	relief := net.Amount / 4
+	if net.Amount%4 >= 2 {
+		relief++
+	}
+
+	return AllocatedAmount{Money{Amount: relief, Currency: net.Currency}}
+}
+
+type ReliefClaimId string
+
+func newReliefClaimId() (ReliefClaimId, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return "", errors.Join(ErrIdGeneration, err)
+	}
+
+	return ReliefClaimId(id.String()), nil
+}
+
+func ParseReliefClaimId(id string) (ReliefClaimId, error) {
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return "", err
+	}
+
+	return ReliefClaimId(id), nil
+}
+
+func (id ReliefClaimId) String() string {
+	return string(id)
+}
+
+type ReliefClaimStatus string
+
+const (
+	// ReliefClaimStatusPending claims are waiting to be added to a batch
+	ReliefClaimStatusPending ReliefClaimStatus = "PENDING"
+	// ReliefClaimStatusClaimed claims have been exported in a batch to HMRC
+	ReliefClaimStatusClaimed ReliefClaimStatus = "CLAIMED"
+	// ReliefClaimStatusPaid claims have had their relief applied to the account
+	ReliefClaimStatusPaid ReliefClaimStatus = "PAID"
+	// ReliefClaimStatusCancelled claims were for a receipt that was reversed before they were claimed
+	ReliefClaimStatusCancelled ReliefClaimStatus = "CANCELLED"
+)
+
+func ParseReliefClaimStatus(status string) (ReliefClaimStatus, error) {
+	switch ReliefClaimStatus(status) {
+	case ReliefClaimStatusPending, ReliefClaimStatusClaimed, ReliefClaimStatusPaid, ReliefClaimStatusCancelled:
+		return ReliefClaimStatus(status), nil
+	}
+
+	return "", ErrInvalidReliefClaimStatus
+}
+
+func (status ReliefClaimStatus) String() string {
+	return string(status)
+}
+
+// ReliefClaim is the basic rate tax relief owed by HMRC for a relief at source contribution
+type ReliefClaim struct {
+	Id              ReliefClaimId
+	InvestorId      investors.InvestorId
+	AccountId       AccountId
+	ReceiptId       ReceiptId
+	NetAmount       AllocatedAmount
+	ReliefAmount    AllocatedAmount
+	Status          ReliefClaimStatus
+	BatchId         ClaimBatchId
+	ReliefReceiptId ReceiptId
+	CreatedAt       time.Time
+}
+
+// NewReliefClaim creates a pending ReliefClaim for the relief owed on the receipt
+func NewReliefClaim(investorId investors.InvestorId, accountId AccountId, receipt Receipt, relief AllocatedAmount, createdAt time.Time) (*ReliefClaim, error) {
+	id, err := newReliefClaimId()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReliefClaim{
+		Id:           id,
+		InvestorId:   investorId,
+		AccountId:    accountId,
+		ReceiptId:    receipt.Id,
+		NetAmount:    receipt.AllocatedAmount,
+		ReliefAmount: relief,
+		Status:       ReliefClaimStatusPending,
+		CreatedAt:    createdAt,
+	}, nil
+}
+
+// ParseReliefClaim parses the given data into a ReliefClaim type, ensuring it's valid data
+func ParseReliefClaim(id string, investorId string, accountId string, receiptId string, currency string, netAmount int64, reliefAmount int64, status string, batchId string, reliefReceiptId string, createdAt time.Time) (*ReliefClaim, error) {
+	claimId, err := ParseReliefClaimId(id)
+	if err != nil {
+		return nil, err
+	}
+
+	claimInvestorId, err := investors.ParseInvestorId(investorId)
+	if err != nil {
+		return nil, err
+	}
+
+	claimAccountId, err := ParseAccountId(accountId)
+	if err != nil {
+		return nil, err
+	}
+
+	claimReceiptId, err := ParseReceiptId(receiptId)
+	if err != nil {
+		return nil, err
+	}
+
+	net, err := NewMoney(netAmount, currency)
+	if err != nil {
+		return nil, err
+	}
+	claimNetAmount, err := NewAllocatedAmount(net)
+	if err != nil {
+		return nil, err
+	}
+
+	relief, err := NewMoney(reliefAmount, currency)
+	if err != nil {
+		return nil, err
+	}
+	claimReliefAmount, err := NewAllocatedAmount(relief)
+	if err != nil {
+		return nil, err
+	}
+
+	claimStatus, err := ParseReliefClaimStatus(status)
+	if err != nil {
+		return nil, err
+	}
+
+	claim := &ReliefClaim{
+		Id:           claimId,
+		InvestorId:   claimInvestorId,
+		AccountId:    claimAccountId,
+		ReceiptId:    claimReceiptId,
+		NetAmount:    claimNetAmount,
+		ReliefAmount: claimReliefAmount,
+		Status:       claimStatus,
+		CreatedAt:    createdAt,
+	}
+
+	// Batches and relief receipts are only set once the claim progresses
+	if batchId != "" {
+		claim.BatchId, err = ParseClaimBatchId(batchId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if reliefReceiptId != "" {
+		claim.ReliefReceiptId, err = ParseReceiptId(reliefReceiptId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return claim, nil
+}
+
+// GrossAmount is the contribution including the relief
+func (claim ReliefClaim) GrossAmount() (AllocatedAmount, error) {
+	gross, err := claim.NetAmount.Add(claim.ReliefAmount.Money)
+	if err != nil {
+		return AllocatedAmount{}, err
+	}
+
+	return AllocatedAmount{gross}, nil
+}
+
+// MarkPaid records the receipt the relief was applied to the account with
+func (claim *ReliefClaim) MarkPaid(reliefReceipt Receipt) error {
+	if claim.Status != ReliefClaimStatusClaimed {
+		return ErrReliefClaimNotClaimed
+	}
+	if reliefReceipt.AllocatedAmount != claim.ReliefAmount {
+		return ErrReliefClaimAmountMismatch
+	}
+
+	claim.Status = ReliefClaimStatusPaid
+	claim.ReliefReceiptId = reliefReceipt.Id
+	return nil
+}
+
+// Cancel stops the relief being claimed, which is only possible before it's been sent to HMRC
+func (claim *ReliefClaim) Cancel() error {
+	if claim.Status != ReliefClaimStatusPending {
+		return ErrReliefAlreadyClaimed
+	}
+
+	claim.Status = ReliefClaimStatusCancelled
+	return nil
+}
+
+// ClaimPeriod is the calendar month a batch of claims is made for
+type ClaimPeriod struct {
+	Year  int
+	Month time.Month
+}
+
+// ClaimPeriodOf returns the ClaimPeriod the given time falls in
+func ClaimPeriodOf(t time.Time) ClaimPeriod {
+	ukTime := t.In(ukLocation)
+	return ClaimPeriod{
+		Year:  ukTime.Year(),
+		Month: ukTime.Month(),
+	}
+}
+
+// ParseClaimPeriod parses a claim period in the "2024-05" format
+func ParseClaimPeriod(period string) (ClaimPeriod, error) {
+	start, err := time.ParseInLocation("2006-01", period, ukLocation)
+	if err != nil {
+		return ClaimPeriod{}, errors.Join(ErrInvalidClaimPeriod, err)
+	}
+
+	return ClaimPeriodOf(start), nil
+}
+
+// Start returns the first moment of the period
+func (period ClaimPeriod) Start() time.Time {
+	return time.Date(period.Year, period.Month, 1, 0, 0, 0, 0, ukLocation)
+}
+
+// End returns the first moment of the following period
+func (period ClaimPeriod) End() time.Time {
+	return period.Start().AddDate(0, 1, 0)
+}
+
+func (period ClaimPeriod) String() string {
+	return fmt.Sprintf("%04d-%02d", period.Year, int(period.Month))
+}
+
+type ClaimBatchId string
+
+func newClaimBatchId() (ClaimBatchId, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return "", errors.Join(ErrIdGeneration, err)
+	}
+
+	return ClaimBatchId(id.String()), nil
+}
+
+func ParseClaimBatchId(id string) (ClaimBatchId, error) {
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return "", err
+	}
+
+	return ClaimBatchId(id), nil
+}
+
+func (id ClaimBatchId) String() string {
+	return string(id)
+}
+
+type ClaimBatchStatus string
+
+const (
+	// ClaimBatchStatusSubmitted batches have been exported and are waiting on HMRC
+	ClaimBatchStatusSubmitted ClaimBatchStatus = "SUBMITTED"
+	// ClaimBatchStatusPaid batches have been paid by HMRC
+	ClaimBatchStatusPaid ClaimBatchStatus = "PAID"
+)
+
+func ParseClaimBatchStatus(status string) (ClaimBatchStatus, error) {
+	switch ClaimBatchStatus(status) {
+	case ClaimBatchStatusSubmitted, ClaimBatchStatusPaid:
+		return ClaimBatchStatus(status), nil
+	}
+
+	return "", ErrInvalidClaimBatchStatus
+}
+
+func (status ClaimBatchStatus) String() string {
+	return string(status)
+}
+
+// ClaimBatch is the monthly set of ReliefClaims made to HMRC
+type ClaimBatch struct {
+	Id        ClaimBatchId
+	Period    ClaimPeriod
+	Status    ClaimBatchStatus
+	Claims    []*ReliefClaim
+	CreatedAt time.Time
+}
+
+// NewClaimBatch creates a new submitted ClaimBatch for the period
+func NewClaimBatch(period ClaimPeriod, createdAt time.Time) (*ClaimBatch, error) {
+	id, err := newClaimBatchId()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ClaimBatch{
+		Id:        id,
+		Period:    period,
+		Status:    ClaimBatchStatusSubmitted,
+		CreatedAt: createdAt,
+	}, nil
+}
+
+// ParseClaimBatch parses the given data into a ClaimBatch type, ensuring it's valid data
+func ParseClaimBatch(id string, period time.Time, status string, createdAt time.Time) (*ClaimBatch, error) {
+	batchId, err := ParseClaimBatchId(id)
+	if err != nil {
+		return nil, err
+	}
+
+	batchStatus, err := ParseClaimBatchStatus(status)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ClaimBatch{
+		Id:        batchId,
+		Period:    ClaimPeriod{Year: period.Year(), Month: period.Month()},
+		Status:    batchStatus,
+		CreatedAt: createdAt,
+	}, nil
+}
+
+// AddClaim claims the pending ReliefClaim as part of the batch
+func (batch *ClaimBatch) AddClaim(claim *ReliefClaim) error {
+	if claim.Status != ReliefClaimStatusPending {
+		return ErrReliefClaimNotPending
+	}
+
+	claim.Status = ReliefClaimStatusClaimed
+	claim.BatchId = batch.Id
+	batch.Claims = append(batch.Claims, claim)
+	return nil
+}
+
+// clone copies the batch and its claims, so changes to them don't show up in the copy
+func (batch ClaimBatch) clone() ClaimBatch {
+	claims := []*ReliefClaim{}
+	for _, claim := range batch.Claims {
+		copied := *claim
+		claims = append(claims, &copied)
+	}
+	batch.Claims = claims
+	return batch
+}
+
+// MarkPaid records HMRC having paid the batch
+func (batch *ClaimBatch) MarkPaid() error {
+	if batch.Status == ClaimBatchStatusPaid {
+		return ErrReliefClaimBatchPaid
+	}
+
+	batch.Status = ClaimBatchStatusPaid
+	return nil
+}
+
+// TotalRelief is the sum of the relief claimed in the batch
+func (batch ClaimBatch) TotalRelief() (AllocatedAmount, error) {
+	var total Money
+	for _, claim := range batch.Claims {
+		var err error
+		total, err = total.Add(claim.ReliefAmount.Money)
+		if err != nil {
+			return AllocatedAmount{}, err
+		}
+	}
+
+	return AllocatedAmount{total}, nil
+}
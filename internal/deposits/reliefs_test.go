@@ -0,0 +1,188 @@
+package deposits_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iainvm/deposits/internal/deposits"
+	"github.com/iainvm/deposits/internal/investors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBasicRateRelief(t *testing.T) {
+	testCases := []struct {
+		description   string
+		input         deposits.AllocatedAmount
+		expectedValue deposits.AllocatedAmount
+	}{
+		{
+			description:   "25% of 80_00",
+			input:         deposits.AllocatedAmount{Money: gbp(80_00)},
+			expectedValue: deposits.AllocatedAmount{Money: gbp(20_00)},
+		},
+		{
+			description:   "rounds half up",
+			input:         deposits.AllocatedAmount{Money: gbp(2)},
+			expectedValue: deposits.AllocatedAmount{Money: gbp(1)},
+		},
+		{
+			description:   "rounds down",
+			input:         deposits.AllocatedAmount{Money: gbp(1)},
+			expectedValue: deposits.AllocatedAmount{Money: gbp(0)},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			require.Equal(t, testCase.expectedValue, deposits.BasicRateRelief(testCase.input))
+		})
+	}
+}
+
+func TestSIPPReliefAtSource(t *testing.T) {
+	account, err := deposits.ParseAccount(uuid.NewString(), deposits.WrapperTypeSIPP.Int(), "GBP", 100_00, 0)
+	require.NoError(t, err)
+
+	// Net receipt reserves the relief
+	receipt, err := deposits.NewReceipt(gbp(80_00))
+	require.NoError(t, err)
+	err = account.AddReceipt(receipt)
+	require.NoError(t, err)
+	require.Equal(t, deposits.TotalAllocatedAmount{Money: gbp(80_00)}, account.TotalAllocatedAmount)
+	require.Equal(t, deposits.TotalAllocatedAmount{Money: gbp(20_00)}, account.PendingReliefAmount)
+
+	// The gross contribution has used the nominal
+	receipt, err = deposits.NewReceipt(gbp(1))
+	require.NoError(t, err)
+	err = account.AddReceipt(receipt)
+	require.ErrorIs(t, err, deposits.ErrNominalExceeded)
+	require.Equal(t, deposits.TotalAllocatedAmount{Money: gbp(20_00)}, account.PendingReliefAmount)
+
+	// Relief moves from pending to allocated
+	relief, err := deposits.NewReceipt(gbp(20_00))
+	require.NoError(t, err)
+	err = account.ApplyTaxRelief(relief)
+	require.NoError(t, err)
+	require.Equal(t, deposits.TotalAllocatedAmount{Money: gbp(100_00)}, account.TotalAllocatedAmount)
+	require.Equal(t, deposits.TotalAllocatedAmount{Money: gbp(0)}, account.PendingReliefAmount)
+
+	// Relief can't be applied twice
+	err = account.ApplyTaxRelief(relief)
+	require.ErrorIs(t, err, deposits.ErrReliefNotPending)
+}
+
+func TestISANoRelief(t *testing.T) {
+	account, err := deposits.ParseAccount(uuid.NewString(), deposits.WrapperTypeISA.Int(), "GBP", 100_00, 0)
+	require.NoError(t, err)
+
+	receipt, err := deposits.NewReceipt(gbp(100_00))
+	require.NoError(t, err)
+	err = account.AddReceipt(receipt)
+	require.NoError(t, err)
+	require.Equal(t, deposits.TotalAllocatedAmount{Money: gbp(0)}, account.PendingReliefAmount)
+}
+
+func TestSetPendingReliefAmount(t *testing.T) {
+	account, err := deposits.ParseAccount(uuid.NewString(), deposits.WrapperTypeSIPP.Int(), "GBP", 100, 80)
+	require.NoError(t, err)
+
+	err = account.SetPendingReliefAmount(deposits.TotalAllocatedAmount{Money: gbp(20)})
+	require.NoError(t, err)
+
+	err = account.SetPendingReliefAmount(deposits.TotalAllocatedAmount{Money: gbp(21)})
+	require.ErrorIs(t, err, deposits.ErrNominalExceeded)
+	require.Equal(t, deposits.TotalAllocatedAmount{Money: gbp(20)}, account.PendingReliefAmount)
+
+	err = account.SetPendingReliefAmount(deposits.TotalAllocatedAmount{Money: gbp(-1)})
+	require.ErrorIs(t, err, deposits.ErrNegativeAmount)
+}
+
+func TestParseClaimPeriod(t *testing.T) {
+	period, err := deposits.ParseClaimPeriod("2024-05")
+	require.NoError(t, err)
+	require.Equal(t, deposits.ClaimPeriod{Year: 2024, Month: time.May}, period)
+	require.Equal(t, "2024-05", period.String())
+	require.Equal(t, deposits.ClaimPeriod{Year: 2024, Month: time.June}, deposits.ClaimPeriodOf(period.End()))
+
+	_, err = deposits.ParseClaimPeriod("May 2024")
+	require.ErrorIs(t, err, deposits.ErrInvalidClaimPeriod)
+}
+
+func TestClaimBatch(t *testing.T) {
+	investorId := investors.InvestorId(uuid.NewString())
+	accountId := deposits.AccountId(uuid.NewString())
+
+	receipt, err := deposits.NewReceipt(gbp(80_00))
+	require.NoError(t, err)
+
+	claim, err := deposits.NewReliefClaim(investorId, accountId, *receipt, deposits.BasicRateRelief(receipt.AllocatedAmount), time.Now())
+	require.NoError(t, err)
+	require.Equal(t, deposits.ReliefClaimStatusPending, claim.Status)
+	gross, err := claim.GrossAmount()
+	require.NoError(t, err)
+	require.Equal(t, deposits.AllocatedAmount{Money: gbp(100_00)}, gross)
+
+	batch, err := deposits.NewClaimBatch(deposits.ClaimPeriodOf(time.Now()), time.Now())
+	require.NoError(t, err)
+
+	// Claims can only be paid once they're claimed
+	relief, err := deposits.NewReceipt(gbp(20_00))
+	require.NoError(t, err)
+	err = claim.MarkPaid(*relief)
+	require.ErrorIs(t, err, deposits.ErrReliefClaimNotClaimed)
+
+	err = batch.AddClaim(claim)
+	require.NoError(t, err)
+	require.Equal(t, deposits.ReliefClaimStatusClaimed, claim.Status)
+	require.Equal(t, batch.Id, claim.BatchId)
+	total, err := batch.TotalRelief()
+	require.NoError(t, err)
+	require.Equal(t, deposits.AllocatedAmount{Money: gbp(20_00)}, total)
+
+	// Claims can only be in one batch
+	err = batch.AddClaim(claim)
+	require.ErrorIs(t, err, deposits.ErrReliefClaimNotPending)
+
+	err = batch.MarkPaid()
+	require.NoError(t, err)
+	err = batch.MarkPaid()
+	require.ErrorIs(t, err, deposits.ErrReliefClaimBatchPaid)
+
+	// Relief receipt must match the claim
+	wrongRelief, err := deposits.NewReceipt(gbp(1))
+	require.NoError(t, err)
+	err = claim.MarkPaid(*wrongRelief)
+	require.ErrorIs(t, err, deposits.ErrReliefClaimAmountMismatch)
+
+	err = claim.MarkPaid(*relief)
+	require.NoError(t, err)
+	require.Equal(t, deposits.ReliefClaimStatusPaid, claim.Status)
+	require.Equal(t, relief.Id, claim.ReliefReceiptId)
+}
+
+func TestReliefClaimCancel(t *testing.T) {
+	receipt, err := deposits.NewReceipt(gbp(80_00))
+	require.NoError(t, err)
+
+	claim, err := deposits.NewReliefClaim(investors.InvestorId(uuid.NewString()), deposits.AccountId(uuid.NewString()), *receipt, deposits.BasicRateRelief(receipt.AllocatedAmount), time.Now())
+	require.NoError(t, err)
+
+	err = claim.Cancel()
+	require.NoError(t, err)
+	require.Equal(t, deposits.ReliefClaimStatusCancelled, claim.Status)
+
+	// Cancelled claims can't be batched
+	batch, err := deposits.NewClaimBatch(deposits.ClaimPeriodOf(time.Now()), time.Now())
+	require.NoError(t, err)
+	err = batch.AddClaim(claim)
+	require.ErrorIs(t, err, deposits.ErrReliefClaimNotPending)
+
+	// Claimed relief can't be cancelled
+	claim, err = deposits.NewReliefClaim(investors.InvestorId(uuid.NewString()), deposits.AccountId(uuid.NewString()), *receipt, deposits.BasicRateRelief(receipt.AllocatedAmount), time.Now())
+	require.NoError(t, err)
+	err = batch.AddClaim(claim)
+	require.NoError(t, err)
+	err = claim.Cancel()
+	require.ErrorIs(t, err, deposits.ErrReliefAlreadyClaimed)
+}
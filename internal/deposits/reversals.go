@@ -0,0 +1,144 @@
+package deposits
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrReceiptAlreadyReversed = errors.New("receipt already reversed")
+	ErrReversalNotFound       = errors.New("reversal not found")
+	ErrInvalidReversalReason  = errors.New("invalid reversal reason given")
+	ErrReversingOverflow      = errors.New("overflow is reversed with the receipt it split from")
+)
+
+type ReversalId string
+
+func newReversalId() (ReversalId, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return "", errors.Join(ErrIdGeneration, err)
+	}
+
+	return ReversalId(id.String()), nil
+}
+
+func ParseReversalId(id string) (ReversalId, error) {
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return "", err
+	}
+
+	return ReversalId(id), nil
+}
+
+func (id ReversalId) String() string {
+	return string(id)
+}
+
+type ReversalReason string
+
+const (
+	// ReversalReasonBounced is a direct debit that was returned unpaid
+	ReversalReasonBounced ReversalReason = "BOUNCED"
+	// ReversalReasonRecalled is a payment the sending bank recalled
+	ReversalReasonRecalled ReversalReason = "RECALLED"
+)
+
+func ParseReversalReason(reason string) (ReversalReason, error) {
+	switch ReversalReason(reason) {
+	case ReversalReasonBounced, ReversalReasonRecalled:
+		return ReversalReason(reason), nil
+	}
+
+	return "", ErrInvalidReversalReason
+}
+
+func (reason ReversalReason) String() string {
+	return string(reason)
+}
+
+// Reversal undoes a receipt whose payment didn't arrive, taking its amount back off the account, or out of suspense for
+// overflow held there, when it hasn't got an account
+type Reversal struct {
+	Id             ReversalId
+	ReceiptId      ReceiptId
+	AccountId      AccountId
+	Amount         AllocatedAmount
+	Reason         ReversalReason
+	IdempotencyKey IdempotencyKey
+	CreatedAt      time.Time
+}
+
+// NewReversal creates a Reversal of the whole receipt
+func NewReversal(receipt Receipt, reason ReversalReason, createdAt time.Time) (*Reversal, error) {
+	id, err := newReversalId()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reversal{
+		Id:        id,
+		ReceiptId: receipt.Id,
+		AccountId: receipt.AccountId,
+		Amount:    receipt.AllocatedAmount,
+		Reason:    reason,
+		CreatedAt: createdAt,
+	}, nil
+}
+
+// ParseReversal parses the given data into a Reversal type, ensuring it's valid data
+func ParseReversal(id string, receiptId string, accountId string, currency string, amount int64, reason string, idempotencyKey string, createdAt time.Time) (*Reversal, error) {
+	reversalId, err := ParseReversalId(id)
+	if err != nil {
+		return nil, err
+	}
+
+	reversalReceiptId, err := ParseReceiptId(receiptId)
+	if err != nil {
+		return nil, err
+	}
+
+	var reversalAccountId AccountId
+	if accountId != "" {
+		reversalAccountId, err = ParseAccountId(accountId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	money, err := NewMoney(amount, currency)
+	if err != nil {
+		return nil, err
+	}
+	reversalAmount, err := NewAllocatedAmount(money)
+	if err != nil {
+		return nil, err
+	}
+
+	reversalReason, err := ParseReversalReason(reason)
+	if err != nil {
+		return nil, err
+	}
+
+	reversal := &Reversal{
+		Id:        reversalId,
+		ReceiptId: reversalReceiptId,
+		AccountId: reversalAccountId,
+		Amount:    reversalAmount,
+		Reason:    reversalReason,
+		CreatedAt: createdAt,
+	}
+
+	// Keys are optional
+	if idempotencyKey != "" {
+		reversal.IdempotencyKey, err = NewIdempotencyKey(idempotencyKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return reversal, nil
+}
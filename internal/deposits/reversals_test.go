@@ -0,0 +1,75 @@
+package deposits_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iainvm/deposits/internal/deposits"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReversalReason(t *testing.T) {
+	testCases := []struct {
+		description   string
+		input         string
+		expectedError error
+		expectedValue deposits.ReversalReason
+	}{
+		{
+			description:   "passes for BOUNCED",
+			input:         "BOUNCED",
+			expectedValue: deposits.ReversalReasonBounced,
+		},
+		{
+			description:   "passes for RECALLED",
+			input:         "RECALLED",
+			expectedValue: deposits.ReversalReasonRecalled,
+		},
+		{
+			description:   "fails for unknown reason",
+			input:         "UNSPECIFIED",
+			expectedError: deposits.ErrInvalidReversalReason,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			actualValue, actualError := deposits.ParseReversalReason(testCase.input)
+			if testCase.expectedError != nil {
+				require.ErrorIs(t, actualError, testCase.expectedError)
+				return
+			}
+
+			require.NoError(t, actualError)
+			require.Equal(t, testCase.expectedValue, actualValue)
+		})
+	}
+}
+
+func TestParseReversal(t *testing.T) {
+	id := uuid.NewString()
+	receiptId := uuid.NewString()
+	accountId := uuid.NewString()
+	createdAt := time.Now()
+
+	reversal, err := deposits.ParseReversal(id, receiptId, accountId, "GBP", 10_00, "BOUNCED", "BOUNCE-1", createdAt)
+	require.NoError(t, err)
+	require.Equal(t, &deposits.Reversal{
+		Id:             deposits.ReversalId(id),
+		ReceiptId:      deposits.ReceiptId(receiptId),
+		AccountId:      deposits.AccountId(accountId),
+		Amount:         deposits.AllocatedAmount{Money: gbp(10_00)},
+		Reason:         deposits.ReversalReasonBounced,
+		IdempotencyKey: "BOUNCE-1",
+		CreatedAt:      createdAt,
+	}, reversal)
+
+	// Overflow reversed out of suspense isn't on an account
+	reversal, err = deposits.ParseReversal(id, receiptId, "", "GBP", 10_00, "BOUNCED", "", createdAt)
+	require.NoError(t, err)
+	require.Empty(t, reversal.AccountId)
+
+	_, err = deposits.ParseReversal(id, receiptId, accountId, "GBP", 10_00, "LOST", "", createdAt)
+	require.ErrorIs(t, err, deposits.ErrInvalidReversalReason)
+}
@@ -2,58 +2,1020 @@ package deposits
 
 import (
 	"context"
+	"errors"
+	"slices"
+	"time"
 
 	"github.com/iainvm/deposits/internal/investors"
+	"github.com/iainvm/deposits/internal/ledger"
 )
 
 type Repository interface {
+	// WithinTx runs fn as a single unit of work, committing every change made through its Repository together, or none of them if fn fails
+	WithinTx(ctx context.Context, fn func(repository Repository) error) error
 	SaveDeposit(ctx context.Context, investorId investors.InvestorId, deposit Deposit) error
+	// LockDeposit returns the deposit without its pots, locking it until the transaction ends so its status can't change
+	// underneath the transaction
+	LockDeposit(ctx context.Context, depositId DepositId) (*Deposit, error)
+	ListDepositSummaries(ctx context.Context, filter DepositFilter, limit int) ([]*DepositSummary, error)
+	UpdateDepositStatus(ctx context.Context, deposit Deposit) error
 	SavePot(ctx context.Context, depositId DepositId, pot Pot) error
+	UpdatePot(ctx context.Context, pot Pot) error
 	SaveAccount(ctx context.Context, potId PotId, account Account) error
+	// RemoveAccount saves the account's removal to its stream and takes it out of its pot, the stream's kept
+	RemoveAccount(ctx context.Context, account Account) error
+	AccountHasReceipts(ctx context.Context, accountId AccountId) (bool, error)
 	SaveReceipt(ctx context.Context, accountId AccountId, receipt Receipt) error
+	GetReceipt(ctx context.Context, receiptId ReceiptId) (*Receipt, error)
+	GetReceiptByIdempotencyKey(ctx context.Context, key IdempotencyKey) (*Receipt, error)
+	GetOverflowReceipt(ctx context.Context, receiptId ReceiptId) (*Receipt, error)
+	GetUnallocatedReceipts(ctx context.Context, depositId DepositId) ([]*Receipt, error)
+	UpdateUnallocatedReceipt(ctx context.Context, receipt Receipt) error
+	SaveSuspenseAllocation(ctx context.Context, allocation SuspenseAllocation) error
+	GetSuspenseAllocationByIdempotencyKey(ctx context.Context, key IdempotencyKey) (*SuspenseAllocation, error)
+	GetSuspenseAllocations(ctx context.Context, receiptId ReceiptId) ([]*SuspenseAllocation, error)
+	SaveDepositReceipt(ctx context.Context, depositReceipt DepositReceipt) error
+	GetDepositReceiptByIdempotencyKey(ctx context.Context, key IdempotencyKey) (*DepositReceipt, error)
+	SaveReversal(ctx context.Context, reversal Reversal) error
+	GetReversalByReceiptId(ctx context.Context, receiptId ReceiptId) (*Reversal, error)
+	GetReversalByIdempotencyKey(ctx context.Context, key IdempotencyKey) (*Reversal, error)
 	GetFullDeposit(ctx context.Context, depositId DepositId) (*Deposit, error)
+	// GetDepositBalances returns the deposit with its pots and the balances of their accounts, but not their receipts
+	GetDepositBalances(ctx context.Context, depositId DepositId) (*Deposit, error)
 	GetAccount(ctx context.Context, accountId AccountId) (*Account, error)
 	UpdateAccount(ctx context.Context, account Account) error
+	GetAccountEvents(ctx context.Context, accountId AccountId) ([]RecordedEvent, error)
+	ListAccountIds(ctx context.Context) ([]AccountId, error)
+	// ProjectAccount overwrites the stored balances of the account with its own, unless it's moved on to a later version
+	ProjectAccount(ctx context.Context, account Account) error
+	// ReplaceAccountSnapshots deletes the account's snapshots and snapshots it as it is
+	ReplaceAccountSnapshots(ctx context.Context, account Account) error
+	GetAccountInvestorId(ctx context.Context, accountId AccountId) (investors.InvestorId, error)
+	GetAccountDepositId(ctx context.Context, accountId AccountId) (DepositId, error)
+	GetISAAllowance(ctx context.Context, investorId investors.InvestorId, taxYear TaxYear) (*ISAAllowance, error)
+	// LockISAAllowance returns the investor's ISA allowance for the tax year, locking it until the transaction ends so
+	// nothing else can subscribe to it
+	LockISAAllowance(ctx context.Context, investorId investors.InvestorId, taxYear TaxYear) (*ISAAllowance, error)
+	SaveISASubscription(ctx context.Context, subscription ISASubscription) error
+	DeleteISASubscription(ctx context.Context, receiptId ReceiptId) error
+	SaveReliefClaim(ctx context.Context, claim ReliefClaim) error
+	UpdateReliefClaim(ctx context.Context, claim ReliefClaim) error
+	GetReliefClaimByReceiptId(ctx context.Context, receiptId ReceiptId) (*ReliefClaim, error)
+	// GetPendingReliefClaims returns the pending claims made before the time, locking them until the transaction ends
+	// and skipping those locked by another, so concurrent exports can't put the same claim in two batches
+	GetPendingReliefClaims(ctx context.Context, before time.Time) ([]*ReliefClaim, error)
+	SaveClaimBatch(ctx context.Context, batch ClaimBatch) error
+	UpdateClaimBatch(ctx context.Context, batch ClaimBatch) error
+	GetClaimBatch(ctx context.Context, batchId ClaimBatchId) (*ClaimBatch, error)
+	// LockInvestorWrapperTypes returns the wrapper types of the investor's accounts in their deposits other than the one
+	// excluded, locking the investor until the transaction ends so no other deposit can give them more
+	LockInvestorWrapperTypes(ctx context.Context, investorId investors.InvestorId, excluding DepositId) ([]WrapperType, error)
+	// SaveJournal posts the journal to the ledger, which only commits if the journal balances
+	SaveJournal(ctx context.Context, journal ledger.Journal) error
+	// SaveReceiptRejection publishes the rejection through the outbox
+	SaveReceiptRejection(ctx context.Context, rejection ReceiptRejection) error
 }
 
+// InvestorLookup finds the investors deposits are created for
+type InvestorLookup interface {
+	Get(ctx context.Context, id investors.InvestorId) (*investors.Investor, error)
+}
+
+// maxConcurrentModificationAttempts is how many times a unit of work updating accounts is tried before giving up
+const maxConcurrentModificationAttempts = 5
+
 type Service struct {
-	repository Repository
+	repository     Repository
+	investorLookup InvestorLookup
 }
 
-func NewService(repository Repository) *Service {
+func NewService(repository Repository, investorLookup InvestorLookup) *Service {
 	return &Service{
-		repository: repository,
+		repository:     repository,
+		investorLookup: investorLookup,
+	}
+}
+
+// withinTxRetry runs fn in a transaction, running it again from the start if an account it updates was modified concurrently
+func (service *Service) withinTxRetry(ctx context.Context, fn func(repository Repository) error) error {
+	var err error
+	for attempt := 0; attempt < maxConcurrentModificationAttempts; attempt++ {
+		err = service.repository.WithinTx(ctx, fn)
+		if !errors.Is(err, ErrConcurrentModification) {
+			return err
+		}
 	}
+
+	return err
+}
+
+// AccountChange is an account as it was before and after a call changed it, both read in the call's transaction
+type AccountChange struct {
+	Before Account
+	After  Account
 }
 
-// ReceiveReceipt processes the receipt, validates it, and updates the attached account information
-func (service *Service) ReceiveReceipt(ctx context.Context, accountId AccountId, receipt *Receipt) error {
+// ReceiveReceipt processes the receipt, validates it, and updates the attached account information, returning how it
+// changed the account
+//
+// Receipts with an idempotency key are only received once, a repeat of the same payment returns the original receipt
+// without changing anything
+func (service *Service) ReceiveReceipt(ctx context.Context, accountId AccountId, receipt *Receipt) (*Receipt, []AccountChange, error) {
+	received := receipt
+	var changes []AccountChange
+	err := service.withinTxRetry(ctx, func(repository Repository) error {
+		// Start afresh if this is a retry
+		changes = nil
+
+		// Check if the payment has already been received
+		if receipt.IdempotencyKey != "" {
+			original, err := repository.GetReceiptByIdempotencyKey(ctx, receipt.IdempotencyKey)
+			switch {
+			case err == nil:
+				if original.AccountId != accountId || original.AllocatedAmount != receipt.AllocatedAmount {
+					return ErrIdempotencyKeyReused
+				}
+				received = original
+				return nil
+			case !errors.Is(err, ErrReceiptNotFound):
+				return err
+			}
+		}
+
+		// Get Account
+		account, err := repository.GetAccount(ctx, accountId)
+		if err != nil {
+			return err
+		}
 
-	// Get Account
-	account, err := service.repository.GetAccount(ctx, accountId)
+		change, err := receiveReceipt(ctx, repository, account, receipt)
+		if err != nil {
+			return err
+		}
+		changes = append(changes, change)
+		return nil
+	})
 	if err != nil {
-		return err
+		return nil, nil, service.publishRejection(ctx, receipt.Reject(accountId, err, time.Now()), err)
+	}
+
+	return received, changes, nil
+}
+
+// ReceiveReceiptWithOverflow processes the receipt like ReceiveReceipt, except the part above what a capped account can
+// take overflows to the GIA in the same pot, or is held in the deposit's suspense balance if the pot hasn't got a GIA
+//
+// The overflow is returned as a second receipt linked to the first, or nil if the whole receipt fit the account. An
+// account without any headroom doesn't get a receipt at all, the whole receipt goes where the overflow would, unsplit,
+// and is returned without an overflow
+func (service *Service) ReceiveReceiptWithOverflow(ctx context.Context, accountId AccountId, receipt *Receipt) (*Receipt, *Receipt, []AccountChange, error) {
+	amount := receipt.AllocatedAmount
+	received := receipt
+	var overflow *Receipt
+	var changes []AccountChange
+	err := service.withinTxRetry(ctx, func(repository Repository) error {
+		// Start afresh if this is a retry
+		receipt.AllocatedAmount = amount
+		overflow = nil
+		changes = nil
+
+		// Check if the payment has already been received
+		if receipt.IdempotencyKey != "" {
+			original, err := repository.GetReceiptByIdempotencyKey(ctx, receipt.IdempotencyKey)
+			switch {
+			case err == nil:
+				// Repeats are for the total of the original and its overflow
+				total := original.AllocatedAmount.Money
+				originalOverflow, err := repository.GetOverflowReceipt(ctx, original.Id)
+				switch {
+				case err == nil:
+					total, err = total.Add(originalOverflow.AllocatedAmount.Money)
+					if err != nil {
+						return err
+					}
+				case errors.Is(err, ErrReceiptNotFound):
+					originalOverflow = nil
+				default:
+					return err
+				}
+
+				if total != amount.Money {
+					return ErrIdempotencyKeyReused
+				}
+				if original.AccountId != accountId {
+					// Unless the whole receipt overflowed from the account
+					depositId, gia, err := overflowAccount(ctx, repository, accountId)
+					if err != nil {
+						return err
+					}
+					overflowed := original.SuspenseDepositId == depositId || (gia != nil && original.AccountId == gia.Id)
+					if !overflowed || originalOverflow != nil {
+						return ErrIdempotencyKeyReused
+					}
+				}
+				received = original
+				overflow = originalOverflow
+				return nil
+			case !errors.Is(err, ErrReceiptNotFound):
+				return err
+			}
+		}
+
+		// Get Account
+		account, err := repository.GetAccount(ctx, accountId)
+		if err != nil {
+			return err
+		}
+
+		// Split off what the account can't take
+		policy, err := LookupWrapperPolicy(account.WrapperType)
+		if err != nil {
+			return err
+		}
+		headroom, capped, err := policy.Headroom(*account)
+		if err != nil {
+			return err
+		}
+		if capped && headroom.IsZero() {
+			changes, err = receiveOverflow(ctx, repository, accountId, receipt)
+			return err
+		}
+		if capped {
+			overflow, err = receipt.Overflow(headroom)
+			if err != nil && !errors.Is(err, ErrOverflowNotNeeded) {
+				return err
+			}
+		}
+
+		change, err := receiveReceipt(ctx, repository, account, receipt)
+		if err != nil {
+			return err
+		}
+		changes = append(changes, change)
+		if overflow == nil {
+			return nil
+		}
+
+		overflowChanges, err := receiveOverflow(ctx, repository, accountId, overflow)
+		if err != nil {
+			return err
+		}
+		changes = append(changes, overflowChanges...)
+		return nil
+	})
+	if err != nil {
+		receipt.AllocatedAmount = amount
+		return nil, nil, nil, service.publishRejection(ctx, receipt.Reject(accountId, err, time.Now()), err)
+	}
+
+	return received, overflow, changes, nil
+}
+
+// overflowAccount returns the deposit the account's in, and the GIA in the account's pot that overflow from it goes to,
+// or nil if the pot hasn't got one
+func overflowAccount(ctx context.Context, repository Repository, accountId AccountId) (DepositId, *Account, error) {
+	depositId, err := repository.GetAccountDepositId(ctx, accountId)
+	if err != nil {
+		return "", nil, err
+	}
+	deposit, err := repository.GetFullDeposit(ctx, depositId)
+	if err != nil {
+		return "", nil, err
+	}
+	gia, err := deposit.OverflowAccount(accountId)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return depositId, gia, nil
+}
+
+// receiveOverflow receives the overflow from the account on the GIA in its pot, or holds it in the deposit's suspense
+// balance if the pot hasn't got one, returning how it changed the GIA
+func receiveOverflow(ctx context.Context, repository Repository, accountId AccountId, overflow *Receipt) ([]AccountChange, error) {
+	depositId, gia, err := overflowAccount(ctx, repository, accountId)
+	if err != nil {
+		return nil, err
+	}
+	if gia != nil {
+		change, err := receiveReceipt(ctx, repository, gia, overflow)
+		if err != nil {
+			return nil, err
+		}
+		return []AccountChange{change}, nil
+	}
+
+	overflow.HoldInSuspense(depositId)
+	err = repository.SaveReceipt(ctx, "", *overflow)
+	if err != nil {
+		return nil, err
+	}
+	return nil, postReceipt(ctx, repository, *overflow, ledger.AccountSuspense)
+}
+
+// receiveReceipt adds the receipt to the account, saving it with everything it affects, and returns how it changed the
+// account
+func receiveReceipt(ctx context.Context, repository Repository, account *Account, receipt *Receipt) (AccountChange, error) {
+	// Closed and cancelled deposits can't receive anything, the lock stops them being closed or cancelled until the
+	// receipt's saved
+	depositId, err := repository.GetAccountDepositId(ctx, account.Id)
+	if err != nil {
+		return AccountChange{}, err
+	}
+	deposit, err := repository.LockDeposit(ctx, depositId)
+	if err != nil {
+		return AccountChange{}, err
+	}
+	err = deposit.ValidateReceiving()
+	if err != nil {
+		return AccountChange{}, err
 	}
 
 	// Validate we can add the receipt to the account
+	before := account.clone()
 	err = account.AddReceipt(receipt)
 	if err != nil {
-		return err
+		return AccountChange{}, err
+	}
+
+	// ISA wrappers also have to fit in the investor's annual allowance
+	policy, err := LookupWrapperPolicy(account.WrapperType)
+	if err != nil {
+		return AccountChange{}, err
+	}
+	var subscription *ISASubscription
+	if policy.UsesISAAllowance() {
+		subscription, err = subscribeISAAllowance(ctx, repository, account.Id, *receipt)
+		if err != nil {
+			return AccountChange{}, err
+		}
+	}
+
+	// Relief at source wrappers claim tax relief on the receipt
+	var claim *ReliefClaim
+	relief := policy.TaxRelief(receipt.AllocatedAmount)
+	if !relief.IsZero() {
+		investorId, err := repository.GetAccountInvestorId(ctx, account.Id)
+		if err != nil {
+			return AccountChange{}, err
+		}
+
+		claim, err = NewReliefClaim(investorId, account.Id, *receipt, relief, time.Now())
+		if err != nil {
+			return AccountChange{}, err
+		}
 	}
 
 	// Save the receipt
-	err = service.repository.SaveReceipt(ctx, account.Id, *receipt)
+	err = repository.SaveReceipt(ctx, account.Id, *receipt)
+	if err != nil {
+		return AccountChange{}, err
+	}
+	err = postReceipt(ctx, repository, *receipt, investorLiability(account.WrapperType))
+	if err != nil {
+		return AccountChange{}, err
+	}
+
+	// Record the allowance used by the receipt
+	if subscription != nil {
+		err = repository.SaveISASubscription(ctx, *subscription)
+		if err != nil {
+			return AccountChange{}, err
+		}
+	}
+
+	// Record the relief to claim for the receipt
+	if claim != nil {
+		err = repository.SaveReliefClaim(ctx, *claim)
+		if err != nil {
+			return AccountChange{}, err
+		}
+	}
+
+	// Update the account
+	err = repository.UpdateAccount(ctx, *account)
+	if err != nil {
+		return AccountChange{}, err
+	}
+
+	err = updateFundingStatus(ctx, repository, depositId)
+	if err != nil {
+		return AccountChange{}, err
+	}
+
+	return AccountChange{Before: before, After: account.clone()}, nil
+}
+
+// updateFundingStatus moves the deposit between open, partially funded and funded to match its accounts, once they've
+// been updated
+//
+// Only the accounts' balances are read, so it doesn't get slower as the deposit receives more
+func updateFundingStatus(ctx context.Context, repository Repository, depositId DepositId) error {
+	deposit, err := repository.GetDepositBalances(ctx, depositId)
+	if err != nil {
+		return err
+	}
+
+	changed, err := deposit.UpdateFundingStatus()
+	if err != nil || !changed {
+		return err
+	}
+
+	return repository.UpdateDepositStatus(ctx, *deposit)
+}
+
+// publishRejection publishes the rejection, if the payment broke a business rule, returning the error it was rejected
+// with
+//
+// It's saved in a transaction of its own, as the payment's was rolled back
+func (service *Service) publishRejection(ctx context.Context, rejection *ReceiptRejection, err error) error {
+	if rejection == nil {
+		return err
+	}
+
+	saveErr := service.repository.WithinTx(ctx, func(repository Repository) error {
+		return repository.SaveReceiptRejection(ctx, *rejection)
+	})
+	if saveErr != nil {
+		return errors.Join(err, saveErr)
+	}
+
+	return err
+}
+
+// ReceiveUnallocatedReceipt records a payment that couldn't be matched to an account as unallocated cash, to be
+// allocated later with AllocateUnallocatedReceipt
+//
+// Receipts with an idempotency key are only received once, a repeat of the same payment returns the original receipt
+func (service *Service) ReceiveUnallocatedReceipt(ctx context.Context, receipt *Receipt) (*Receipt, error) {
+	received := receipt
+	err := service.withinTxRetry(ctx, func(repository Repository) error {
+		// Check if the payment has already been received
+		if receipt.IdempotencyKey != "" {
+			original, err := repository.GetReceiptByIdempotencyKey(ctx, receipt.IdempotencyKey)
+			switch {
+			case err == nil:
+				if !original.IsUnallocated() || original.AllocatedAmount != receipt.AllocatedAmount {
+					return ErrIdempotencyKeyReused
+				}
+				received = original
+				return nil
+			case !errors.Is(err, ErrReceiptNotFound):
+				return err
+			}
+		}
+
+		err := repository.SaveReceipt(ctx, "", *receipt)
+		if err != nil {
+			return err
+		}
+		return postReceipt(ctx, repository, *receipt, ledger.AccountSuspense)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return received, nil
+}
+
+// ListDeposits returns a page of deposit summaries, newest first, matching the filter
+func (service *Service) ListDeposits(ctx context.Context, filter DepositFilter) (*DepositPage, error) {
+	// Ask for one more than the page to know if there's another page after it
+	summaries, err := service.repository.ListDepositSummaries(ctx, filter, filter.PageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &DepositPage{
+		Deposits: summaries,
+	}
+	if len(summaries) > filter.PageSize {
+		page.Deposits = summaries[:filter.PageSize]
+		next := NewDepositCursor(*page.Deposits[filter.PageSize-1])
+		page.Next = &next
+	}
+
+	return page, nil
+}
+
+// ListUnallocatedReceipts returns the receipts with cash left to allocate, only those held in the deposit's suspense
+// balance if a deposit is given
+func (service *Service) ListUnallocatedReceipts(ctx context.Context, depositId DepositId) ([]*Receipt, error) {
+	return service.repository.GetUnallocatedReceipts(ctx, depositId)
+}
+
+// AllocateUnallocatedReceipt allocates some, or all, of an unallocated receipt's cash to the account, which receives it
+// as a new receipt validated like any other
+//
+// The returned SuspenseAllocation records who allocated it, and is kept with the others for the receipt. Allocations
+// with an idempotency key are only made once, a repeat of the same allocation returns the original
+func (service *Service) AllocateUnallocatedReceipt(ctx context.Context, receiptId ReceiptId, accountId AccountId, amount Money, allocatedBy string, key IdempotencyKey) (*SuspenseAllocation, error) {
+	var allocation *SuspenseAllocation
+	err := service.withinTxRetry(ctx, func(repository Repository) error {
+		// Check if the allocation has already been made
+		if key != "" {
+			original, err := repository.GetSuspenseAllocationByIdempotencyKey(ctx, key)
+			switch {
+			case err == nil:
+				if original.UnallocatedReceiptId != receiptId || original.AccountId != accountId || original.Amount.Money != amount {
+					return ErrIdempotencyKeyReused
+				}
+				allocation = original
+				return nil
+			case !errors.Is(err, ErrSuspenseAllocationNotFound):
+				return err
+			}
+		}
+
+		unallocated, err := repository.GetReceipt(ctx, receiptId)
+		if err != nil {
+			return err
+		}
+
+		// Take the amount off the unallocated cash
+		receipt, suspenseAllocation, err := unallocated.AllocateTo(accountId, amount, allocatedBy, time.Now())
+		if err != nil {
+			return err
+		}
+		err = repository.UpdateUnallocatedReceipt(ctx, *unallocated)
+		if err != nil {
+			return err
+		}
+
+		// And give it to the account
+		account, err := repository.GetAccount(ctx, accountId)
+		if err != nil {
+			return err
+		}
+		_, err = receiveReceipt(ctx, repository, account, receipt)
+		if err != nil {
+			return err
+		}
+
+		// Record who allocated it
+		suspenseAllocation.IdempotencyKey = key
+		err = repository.SaveSuspenseAllocation(ctx, *suspenseAllocation)
+		if err != nil {
+			return err
+		}
+
+		allocation = suspenseAllocation
+		return nil
+	})
 	if err != nil {
+		unallocated := Receipt{Id: receiptId}
+		return nil, service.publishRejection(ctx, unallocated.RejectAllocation(accountId, amount, key, err, time.Now()), err)
+	}
+
+	return allocation, nil
+}
+
+// ListSuspenseAllocations returns the allocations made from the unallocated receipt, oldest first
+func (service *Service) ListSuspenseAllocations(ctx context.Context, receiptId ReceiptId) ([]*SuspenseAllocation, error) {
+	return service.repository.GetSuspenseAllocations(ctx, receiptId)
+}
+
+// ReceiveDepositReceipt splits a payment for a whole deposit between its accounts using the rule, receiving a child
+// receipt for each account it's allocated to
+//
+// Deposit receipts with an idempotency key are only received once, a repeat of the same payment returns the original
+func (service *Service) ReceiveDepositReceipt(ctx context.Context, depositReceipt *DepositReceipt, rule AllocationRule) (*DepositReceipt, error) {
+	received := depositReceipt
+	err := service.withinTxRetry(ctx, func(repository Repository) error {
+		// Check if the payment has already been received
+		if depositReceipt.IdempotencyKey != "" {
+			original, err := repository.GetDepositReceiptByIdempotencyKey(ctx, depositReceipt.IdempotencyKey)
+			switch {
+			case err == nil:
+				if original.DepositId != depositReceipt.DepositId || original.AllocatedAmount != depositReceipt.AllocatedAmount {
+					return ErrIdempotencyKeyReused
+				}
+				received = original
+				return nil
+			case !errors.Is(err, ErrDepositReceiptNotFound):
+				return err
+			}
+		}
+
+		// The deposit's locked before the allowance, as receiving each share does, so they can't deadlock
+		_, err := repository.LockDeposit(ctx, depositReceipt.DepositId)
+		if err != nil {
+			return err
+		}
+		deposit, err := repository.GetFullDeposit(ctx, depositReceipt.DepositId)
+		if err != nil {
+			return err
+		}
+
+		// Split the payment, locking the allowance of the tax year it was received in so the ISAs' shares still fit
+		// once they're received
+		allowance, err := repository.LockISAAllowance(ctx, deposit.InvestorId, TaxYearOf(depositReceipt.ReceivedAt))
+		if err != nil {
+			return err
+		}
+		allocations, err := rule.Allocate(*deposit, depositReceipt.AllocatedAmount, allowance.Remaining())
+		if err != nil {
+			return err
+		}
+
+		err = repository.SaveDepositReceipt(ctx, *depositReceipt)
+		if err != nil {
+			return err
+		}
+
+		// Receive each account's part, starting afresh if this is a retry
+		depositReceipt.Receipts = nil
+		accounts := map[AccountId]*Account{}
+		for _, account := range depositAccounts(*deposit) {
+			accounts[account.Id] = account
+		}
+		for _, allocation := range allocations {
+			receipt, err := depositReceipt.AddReceipt(allocation)
+			if err != nil {
+				return err
+			}
+
+			_, err = receiveReceipt(ctx, repository, accounts[allocation.AccountId], receipt)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, service.publishRejection(ctx, depositReceipt.Reject(err, time.Now()), err)
+	}
+
+	return received, nil
+}
+
+// ReverseReceipt undoes a receipt whose payment bounced or was recalled, taking it back off the account
+//
+// A receipt can only be reversed once, though a repeat of a reversal with the same idempotency key returns the original reversal
+func (service *Service) ReverseReceipt(ctx context.Context, receiptId ReceiptId, reason ReversalReason, key IdempotencyKey) (*Reversal, error) {
+	var reversal *Reversal
+	err := service.withinTxRetry(ctx, func(repository Repository) error {
+		// Check if the reversal has already been made
+		if key != "" {
+			original, err := repository.GetReversalByIdempotencyKey(ctx, key)
+			switch {
+			case err == nil:
+				if original.ReceiptId != receiptId {
+					return ErrIdempotencyKeyReused
+				}
+				reversal = original
+				return nil
+			case !errors.Is(err, ErrReversalNotFound):
+				return err
+			}
+		}
+
+		receipt, err := repository.GetReceipt(ctx, receiptId)
+		if err != nil {
+			return err
+		}
+
+		// Receipts can only be reversed once
+		_, err = repository.GetReversalByReceiptId(ctx, receiptId)
+		switch {
+		case err == nil:
+			return ErrReceiptAlreadyReversed
+		case !errors.Is(err, ErrReversalNotFound):
+			return err
+		}
+
+		// Overflow is part of the payment it split from, which is reversed whole
+		if receipt.OverflowOf != "" {
+			return ErrReversingOverflow
+		}
+
+		// Suspense isn't on an account to take it off
+		if receipt.AccountId == "" {
+			return ErrReceiptNotOnAccount
+		}
+
+		reversal, err = reverseReceipt(ctx, repository, receipt, reason, key)
+		if err != nil {
+			return err
+		}
+
+		// Reverse the overflow split off the receipt with it
+		overflow, err := repository.GetOverflowReceipt(ctx, receipt.Id)
+		switch {
+		case errors.Is(err, ErrReceiptNotFound):
+			return nil
+		case err != nil:
+			return err
+		}
+		if overflow.IsUnallocated() {
+			return reverseHeldReceipt(ctx, repository, overflow, reason)
+		}
+		_, err = reverseReceipt(ctx, repository, overflow, reason, "")
 		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reversal, nil
+}
+
+// reverseReceipt takes the receipt off its account, saving the reversal with everything it affects
+func reverseReceipt(ctx context.Context, repository Repository, receipt *Receipt, reason ReversalReason, key IdempotencyKey) (*Reversal, error) {
+	// Take the receipt off the account
+	account, err := repository.GetAccount(ctx, receipt.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	err = account.ReverseReceipt(*receipt)
+	if err != nil {
+		return nil, err
+	}
+
+	// Relief due on the receipt is no longer owed
+	claim, err := repository.GetReliefClaimByReceiptId(ctx, receipt.Id)
+	switch {
+	case err == nil:
+		err = claim.Cancel()
+		if err != nil {
+			return nil, err
+		}
+		err = account.ReleaseTaxRelief(claim.ReliefAmount)
+		if err != nil {
+			return nil, err
+		}
+		err = repository.UpdateReliefClaim(ctx, *claim)
+		if err != nil {
+			return nil, err
+		}
+	case !errors.Is(err, ErrReliefClaimNotFound):
+		return nil, err
+	}
+
+	// A payment that never arrived doesn't use any ISA allowance
+	policy, err := LookupWrapperPolicy(account.WrapperType)
+	if err != nil {
+		return nil, err
+	}
+	if policy.UsesISAAllowance() {
+		err = repository.DeleteISASubscription(ctx, receipt.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Cash allocated from suspense goes back to it, where the reversal's journal returns it
+	if receipt.AllocatedFrom != "" {
+		unallocated, err := repository.GetReceipt(ctx, receipt.AllocatedFrom)
+		if err != nil {
+			return nil, err
+		}
+		err = unallocated.ReturnAllocation(*receipt)
+		if err != nil {
+			return nil, err
+		}
+		err = repository.UpdateUnallocatedReceipt(ctx, *unallocated)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Record the reversal
+	reversal, err := NewReversal(*receipt, reason, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	reversal.IdempotencyKey = key
+	err = repository.SaveReversal(ctx, *reversal)
+	if err != nil {
+		return nil, err
+	}
+	err = postReversal(ctx, repository, *reversal, *receipt, investorLiability(account.WrapperType))
+	if err != nil {
+		return nil, err
 	}
 
 	// Update the account
-	err = service.repository.UpdateAccount(ctx, *account)
+	err = repository.UpdateAccount(ctx, *account)
+	if err != nil {
+		return nil, err
+	}
+
+	// The deposit may no longer be funded
+	depositId, err := repository.GetAccountDepositId(ctx, account.Id)
+	if err != nil {
+		return nil, err
+	}
+	err = updateFundingStatus(ctx, repository, depositId)
+	if err != nil {
+		return nil, err
+	}
+
+	return reversal, nil
+}
+
+// reverseHeldReceipt takes a receipt held in suspense back out of it, saving the reversal and its journal
+func reverseHeldReceipt(ctx context.Context, repository Repository, receipt *Receipt, reason ReversalReason) error {
+	err := receipt.ReverseFromSuspense()
+	if err != nil {
+		return err
+	}
+	err = repository.UpdateUnallocatedReceipt(ctx, *receipt)
+	if err != nil {
+		return err
+	}
+
+	reversal, err := NewReversal(*receipt, reason, time.Now())
+	if err != nil {
+		return err
+	}
+	err = repository.SaveReversal(ctx, *reversal)
 	if err != nil {
 		return err
 	}
+	return postReversal(ctx, repository, *reversal, *receipt, ledger.AccountSuspense)
+}
+
+// subscribeISAAllowance checks the receipt fits in the account's investor's allowance for the tax year it was received in
+func subscribeISAAllowance(ctx context.Context, repository Repository, accountId AccountId, receipt Receipt) (*ISASubscription, error) {
+	investorId, err := repository.GetAccountInvestorId(ctx, accountId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Receipts to the investor's other ISAs wait for this one, so they can't both fit in what's left
+	allowance, err := repository.LockISAAllowance(ctx, investorId, TaxYearOf(receipt.ReceivedAt))
+	if err != nil {
+		return nil, err
+	}
+
+	return allowance.Subscribe(accountId, receipt)
+}
+
+// GetISAAllowance returns the investor's ISA allowance for the tax year
+func (service *Service) GetISAAllowance(ctx context.Context, investorId investors.InvestorId, taxYear TaxYear) (*ISAAllowance, error) {
+	allowance, err := service.repository.GetISAAllowance(ctx, investorId, taxYear)
+	if err != nil {
+		return nil, err
+	}
+
+	return allowance, nil
+}
+
+// ExportReliefClaims batches up every pending relief claim made before the end of the period
+//
+// Each claim's only ever exported in one batch, an export running at the same time as another gets the claims it
+// didn't
+func (service *Service) ExportReliefClaims(ctx context.Context, period ClaimPeriod) (*ClaimBatch, error) {
+	var batch *ClaimBatch
+	err := service.withinTxRetry(ctx, func(repository Repository) error {
+		claims, err := repository.GetPendingReliefClaims(ctx, period.End())
+		if err != nil {
+			return err
+		}
+		if len(claims) == 0 {
+			return ErrNoPendingReliefClaims
+		}
+
+		// Create the batch
+		batch, err = NewClaimBatch(period, time.Now())
+		if err != nil {
+			return err
+		}
+		for _, claim := range claims {
+			err = batch.AddClaim(claim)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Save the batch
+		err = repository.SaveClaimBatch(ctx, *batch)
+		if err != nil {
+			return err
+		}
+
+		// Update the claims
+		for _, claim := range batch.Claims {
+			err = repository.UpdateReliefClaim(ctx, *claim)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return batch, nil
+}
+
+// MarkClaimBatchPaid records HMRC paying the batch, applying each claim's relief to its account as a receipt, returning
+// the batch as it was and as it is now
+func (service *Service) MarkClaimBatchPaid(ctx context.Context, batchId ClaimBatchId) (*ClaimBatch, *ClaimBatch, error) {
+	var before ClaimBatch
+	var batch *ClaimBatch
+	err := service.withinTxRetry(ctx, func(repository Repository) error {
+		var err error
+		batch, err = repository.GetClaimBatch(ctx, batchId)
+		if err != nil {
+			return err
+		}
+		before = batch.clone()
+
+		err = batch.MarkPaid()
+		if err != nil {
+			return err
+		}
+
+		for _, claim := range batch.Claims {
+			account, err := repository.GetAccount(ctx, claim.AccountId)
+			if err != nil {
+				return err
+			}
+
+			// Relief is received as its own receipt
+			receipt, err := NewReceipt(claim.ReliefAmount.Money)
+			if err != nil {
+				return err
+			}
+			err = account.ApplyTaxRelief(receipt)
+			if err != nil {
+				return err
+			}
+			err = claim.MarkPaid(*receipt)
+			if err != nil {
+				return err
+			}
+
+			// Save the changes
+			err = repository.SaveReceipt(ctx, account.Id, *receipt)
+			if err != nil {
+				return err
+			}
+			err = postReceipt(ctx, repository, *receipt, investorLiability(account.WrapperType))
+			if err != nil {
+				return err
+			}
+			err = repository.UpdateAccount(ctx, *account)
+			if err != nil {
+				return err
+			}
+			err = repository.UpdateReliefClaim(ctx, *claim)
+			if err != nil {
+				return err
+			}
+		}
+
+		return repository.UpdateClaimBatch(ctx, *batch)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &before, batch, nil
+}
 
-	return nil
+// ListAccountIds returns the id of every account that hasn't been removed, in the order they were opened
+func (service *Service) ListAccountIds(ctx context.Context) ([]AccountId, error) {
+	return service.repository.ListAccountIds(ctx)
+}
+
+// ReplayAccount rebuilds the account from its whole event stream, ignoring snapshots, and overwrites the balances and
+// snapshots stored for it with the result, so they can't drift from what's happened to the account
+func (service *Service) ReplayAccount(ctx context.Context, accountId AccountId) (*Account, error) {
+	var account *Account
+	err := service.repository.WithinTx(ctx, func(repository Repository) error {
+		events, err := repository.GetAccountEvents(ctx, accountId)
+		if err != nil {
+			return err
+		}
+
+		account, err = RestoreAccount(accountId, nil, events)
+		if err != nil {
+			return err
+		}
+
+		err = repository.ProjectAccount(ctx, *account)
+		if err != nil {
+			return err
+		}
+
+		return repository.ReplaceAccountSnapshots(ctx, *account)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// GetAccount returns the account as it stands, without its receipts
+func (service *Service) GetAccount(ctx context.Context, id AccountId) (*Account, error) {
+	return service.repository.GetAccount(ctx, id)
 }
 
 // Get returns all data for a deposit
@@ -65,29 +1027,172 @@ func (service *Service) Get(ctx context.Context, id DepositId) (*Deposit, error)
 	return deposit, nil
 }
 
-// Create handles creating a deposits for an investor
+// Create handles creating a deposits for an investor, opening it to receive receipts
+//
+// The investor must exist and be eligible for every account, an EligibilityError lists every rule they fail
 func (service *Service) Create(ctx context.Context, investorId investors.InvestorId, deposit *Deposit) error {
-	// Save Deposit
-	err := service.repository.SaveDeposit(ctx, investorId, *deposit)
+	err := deposit.Open()
+	if err != nil {
+		return err
+	}
+	deposit.InvestorId = investorId
+
+	investor, err := service.investorLookup.Get(ctx, investorId)
 	if err != nil {
 		return err
 	}
 
-	// Save Pots
-	for _, pot := range deposit.Pots {
-		err := service.repository.SavePot(ctx, deposit.Id, *pot)
+	return service.withinTxRetry(ctx, func(repository Repository) error {
+		// The investor must be able to hold every account, alongside those in their other deposits
+		held, err := repository.LockInvestorWrapperTypes(ctx, investorId, deposit.Id)
+		if err != nil {
+			return err
+		}
+		err = deposit.ValidateInvestorEligibility(*investor, held, time.Now())
+		if err != nil {
+			return err
+		}
+
+		// Save Deposit
+		err = repository.SaveDeposit(ctx, investorId, *deposit)
+		if err != nil {
+			return err
+		}
+
+		// Save Pots
+		for _, pot := range deposit.Pots {
+			err := repository.SavePot(ctx, deposit.Id, *pot)
+			if err != nil {
+				return err
+			}
+
+			// Save Accounts
+			for _, account := range pot.Accounts {
+				err := repository.SaveAccount(ctx, pot.Id, *account)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+// UpdateDeposit applies the amendments to the deposit in order, all or none of them are saved, returning the deposit as
+// it was and as it is now
+//
+// Deposits can only be amended while they're receiving, and their status is updated to match the amended accounts
+func (service *Service) UpdateDeposit(ctx context.Context, id DepositId, amendments []Amendment) (*Deposit, *Deposit, error) {
+	var before, deposit *Deposit
+	err := service.withinTxRetry(ctx, func(repository Repository) error {
+		// Receipts wait for the amendments, so they're checked against the amended accounts
+		_, err := repository.LockDeposit(ctx, id)
+		if err != nil {
+			return err
+		}
+		before, deposit, err = getFullDepositTwice(ctx, repository, id)
+		if err != nil {
+			return err
+		}
+
+		err = deposit.ValidateReceiving()
 		if err != nil {
 			return err
 		}
 
-		// Save Accounts
-		for _, account := range pot.Accounts {
-			err := service.repository.SaveAccount(ctx, pot.Id, *account)
+		existing := deposit.AccountIds()
+		for _, amendment := range amendments {
+			err := amendment.amend(ctx, repository, deposit)
+			if err != nil {
+				return err
+			}
+		}
+
+		// The investor must be able to hold the accounts added, like they had to for those the deposit was created with
+		added := slices.DeleteFunc(deposit.AccountIds(), func(accountId AccountId) bool {
+			return slices.Contains(existing, accountId)
+		})
+		if len(added) > 0 {
+			investor, err := service.investorLookup.Get(ctx, deposit.InvestorId)
+			if err != nil {
+				return err
+			}
+			held, err := repository.LockInvestorWrapperTypes(ctx, deposit.InvestorId, deposit.Id)
+			if err != nil {
+				return err
+			}
+			err = deposit.ValidateAddedAccountsEligibility(*investor, held, added, time.Now())
 			if err != nil {
 				return err
 			}
 		}
+
+		// Nominal changes can fund, or unfund, the deposit
+		_, err = deposit.UpdateFundingStatus()
+		if err != nil {
+			return err
+		}
+
+		// Always saved, so amendments made concurrently from the same read of the deposit can't both succeed
+		return repository.UpdateDepositStatus(ctx, *deposit)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return before, deposit, nil
+}
+
+// CancelDeposit abandons a deposit that hasn't received anything, returning the deposit as it was and as it is now
+func (service *Service) CancelDeposit(ctx context.Context, id DepositId) (*Deposit, *Deposit, error) {
+	return service.transitionDeposit(ctx, id, (*Deposit).Cancel)
+}
+
+// CloseDeposit finishes a deposit that's received something, after which it can't receive anything more, returning the
+// deposit as it was and as it is now
+func (service *Service) CloseDeposit(ctx context.Context, id DepositId) (*Deposit, *Deposit, error) {
+	return service.transitionDeposit(ctx, id, (*Deposit).Close)
+}
+
+// transitionDeposit moves the deposit to a new status with the transition, saving it if the transition's allowed
+func (service *Service) transitionDeposit(ctx context.Context, id DepositId, transition func(deposit *Deposit) error) (*Deposit, *Deposit, error) {
+	var before, deposit *Deposit
+	err := service.withinTxRetry(ctx, func(repository Repository) error {
+		// Receipts in flight finish before the transition's checked, and later ones wait to see its result
+		_, err := repository.LockDeposit(ctx, id)
+		if err != nil {
+			return err
+		}
+		before, deposit, err = getFullDepositTwice(ctx, repository, id)
+		if err != nil {
+			return err
+		}
+
+		err = transition(deposit)
+		if err != nil {
+			return err
+		}
+
+		return repository.UpdateDepositStatus(ctx, *deposit)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return before, deposit, nil
+}
+
+// getFullDepositTwice reads the deposit twice, one copy to change and one to keep as it was before the change
+func getFullDepositTwice(ctx context.Context, repository Repository, id DepositId) (*Deposit, *Deposit, error) {
+	before, err := repository.GetFullDeposit(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	deposit, err := repository.GetFullDeposit(ctx, id)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return nil
+	return before, deposit, nil
 }
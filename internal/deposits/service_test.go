@@ -0,0 +1,2956 @@
+package deposits_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iainvm/deposits/internal/deposits"
+	"github.com/iainvm/deposits/internal/investors"
+	"github.com/iainvm/deposits/internal/ledger"
+	"github.com/iainvm/deposits/internal/outbox"
+	"github.com/stretchr/testify/require"
+)
+
+var errInjected = errors.New("injected failure")
+
+// memoryTables is the data held by a memoryRepository
+type memoryTables struct {
+	deposits map[deposits.DepositId]memoryDeposit
+	pots     map[deposits.PotId]memoryPot
+	accounts map[deposits.AccountId]deposits.Account
+	// events are each account's stream, accounts holds what's projected from them
+	events        map[deposits.AccountId][]deposits.RecordedEvent
+	snapshots     map[deposits.AccountId][]deposits.Account
+	accountPots   map[deposits.AccountId]deposits.PotId
+	receipts      map[deposits.ReceiptId]deposits.Receipt
+	subscriptions []deposits.ISASubscription
+	reliefClaims  map[deposits.ReliefClaimId]deposits.ReliefClaim
+	claimBatches  map[deposits.ClaimBatchId]deposits.ClaimBatch
+	reversals     map[deposits.ReversalId]deposits.Reversal
+	// depositReceipts are stored without their child receipts
+	depositReceipts map[deposits.DepositReceiptId]deposits.DepositReceipt
+	// order is the ids of pots and accounts in the order they were saved
+	order               []string
+	suspenseAllocations []deposits.SuspenseAllocation
+	journals            []ledger.Journal
+	outbox              []outbox.Message
+	// investorLocks counts the transactions that have locked each investor
+	investorLocks map[investors.InvestorId]int
+}
+
+// memoryDeposit is a deposit stored without its pots
+type memoryDeposit struct {
+	investorId investors.InvestorId
+	status     deposits.DepositStatus
+	version    int64
+	createdAt  time.Time
+}
+
+// memoryPot is a pot stored without its accounts
+type memoryPot struct {
+	depositId deposits.DepositId
+	pot       deposits.Pot
+}
+
+func (tables memoryTables) clone() memoryTables {
+	return memoryTables{
+		deposits:            maps.Clone(tables.deposits),
+		pots:                maps.Clone(tables.pots),
+		accounts:            maps.Clone(tables.accounts),
+		events:              maps.Clone(tables.events),
+		snapshots:           maps.Clone(tables.snapshots),
+		accountPots:         maps.Clone(tables.accountPots),
+		receipts:            maps.Clone(tables.receipts),
+		subscriptions:       slices.Clone(tables.subscriptions),
+		reliefClaims:        maps.Clone(tables.reliefClaims),
+		claimBatches:        maps.Clone(tables.claimBatches),
+		reversals:           maps.Clone(tables.reversals),
+		depositReceipts:     maps.Clone(tables.depositReceipts),
+		order:               slices.Clone(tables.order),
+		suspenseAllocations: slices.Clone(tables.suspenseAllocations),
+		journals:            slices.Clone(tables.journals),
+		outbox:              slices.Clone(tables.outbox),
+		investorLocks:       maps.Clone(tables.investorLocks),
+	}
+}
+
+// memoryRepository is an in memory deposits.Repository
+//
+// Transactions read and write a copy of the tables, with their writes applied to the shared tables when they commit.
+// Like the postgres Store, updating an account or a deposit's status fails with deposits.ErrConcurrentModification if
+// another transaction committed a change to it first.
+//
+// Methods the tests don't need are left to the embedded interface, and panic if called
+type memoryRepository struct {
+	deposits.Repository
+
+	mu       *sync.Mutex
+	tables   *memoryTables
+	failures map[string]error
+	// interleaved are run once, the next time the named method's called, to make changes part way through a transaction
+	interleaved map[string]func()
+	// tx is the transaction the repository is in, nil if it's not in one
+	tx *memoryTx
+}
+
+// memoryTx collects the writes made in a transaction
+type memoryTx struct {
+	writes []func(tables *memoryTables)
+	// versions are the account versions updated by the transaction, which must be unchanged when it commits
+	versions map[deposits.AccountId]int64
+	// depositVersions are the same for deposits
+	depositVersions map[deposits.DepositId]int64
+	// allowances are the ISA allowances locked by the transaction, which must be unused by others when it commits
+	allowances map[memoryAllowanceKey]deposits.Money
+	// investorLocks are the investors locked by the transaction, which mustn't be locked by another before it commits
+	investorLocks map[investors.InvestorId]int
+	// reliefClaims are the statuses of the relief claims locked by the transaction, which must be unchanged when it
+	// commits
+	reliefClaims map[deposits.ReliefClaimId]deposits.ReliefClaimStatus
+}
+
+// memoryAllowanceKey is an investor's tax year
+type memoryAllowanceKey struct {
+	investorId investors.InvestorId
+	taxYear    deposits.TaxYear
+}
+
+func newMemoryRepository() *memoryRepository {
+	return &memoryRepository{
+		mu: &sync.Mutex{},
+		tables: &memoryTables{
+			deposits:        map[deposits.DepositId]memoryDeposit{},
+			pots:            map[deposits.PotId]memoryPot{},
+			accounts:        map[deposits.AccountId]deposits.Account{},
+			events:          map[deposits.AccountId][]deposits.RecordedEvent{},
+			snapshots:       map[deposits.AccountId][]deposits.Account{},
+			accountPots:     map[deposits.AccountId]deposits.PotId{},
+			receipts:        map[deposits.ReceiptId]deposits.Receipt{},
+			reliefClaims:    map[deposits.ReliefClaimId]deposits.ReliefClaim{},
+			claimBatches:    map[deposits.ClaimBatchId]deposits.ClaimBatch{},
+			reversals:       map[deposits.ReversalId]deposits.Reversal{},
+			depositReceipts: map[deposits.DepositReceiptId]deposits.DepositReceipt{},
+			investorLocks:   map[investors.InvestorId]int{},
+		},
+		failures:    map[string]error{},
+		interleaved: map[string]func(){},
+	}
+}
+
+// failOn makes the named method fail with errInjected
+func (repository *memoryRepository) failOn(method string) {
+	repository.failures[method] = errInjected
+}
+
+// interleave runs fn the next time the named method's called, before the method does anything
+func (repository *memoryRepository) interleave(method string, fn func()) {
+	repository.interleaved[method] = fn
+}
+
+func (repository *memoryRepository) runInterleaved(method string) {
+	repository.mu.Lock()
+	fn, ok := repository.interleaved[method]
+	delete(repository.interleaved, method)
+	repository.mu.Unlock()
+
+	if ok {
+		fn()
+	}
+}
+
+// write applies the change to the repository's tables, and to the shared tables if the transaction commits
+func (repository *memoryRepository) write(change func(tables *memoryTables)) {
+	if repository.tx == nil {
+		repository.mu.Lock()
+		defer repository.mu.Unlock()
+	} else {
+		repository.tx.writes = append(repository.tx.writes, change)
+	}
+
+	change(repository.tables)
+}
+
+func (repository *memoryRepository) WithinTx(ctx context.Context, fn func(repository deposits.Repository) error) error {
+	// Already in a transaction, so join it
+	if repository.tx != nil {
+		return fn(repository)
+	}
+
+	repository.mu.Lock()
+	tables := repository.tables.clone()
+	repository.mu.Unlock()
+
+	tx := &memoryTx{
+		versions:        map[deposits.AccountId]int64{},
+		depositVersions: map[deposits.DepositId]int64{},
+		allowances:      map[memoryAllowanceKey]deposits.Money{},
+		investorLocks:   map[investors.InvestorId]int{},
+		reliefClaims:    map[deposits.ReliefClaimId]deposits.ReliefClaimStatus{},
+	}
+	err := fn(&memoryRepository{
+		Repository:  repository.Repository,
+		mu:          repository.mu,
+		tables:      &tables,
+		failures:    repository.failures,
+		interleaved: repository.interleaved,
+		tx:          tx,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Commit
+	repository.mu.Lock()
+	defer repository.mu.Unlock()
+	for accountId, version := range tx.versions {
+		if repository.tables.accounts[accountId].Version != version {
+			return deposits.ErrConcurrentModification
+		}
+	}
+	for depositId, version := range tx.depositVersions {
+		if repository.tables.deposits[depositId].version != version {
+			return deposits.ErrConcurrentModification
+		}
+	}
+	for investorId, locks := range tx.investorLocks {
+		if repository.tables.investorLocks[investorId] != locks {
+			return deposits.ErrConcurrentModification
+		}
+	}
+	for claimId, status := range tx.reliefClaims {
+		if repository.tables.reliefClaims[claimId].Status != status {
+			return deposits.ErrConcurrentModification
+		}
+	}
+	for key, used := range tx.allowances {
+		allowance, err := repository.tables.isaAllowance(key.investorId, key.taxYear)
+		if err != nil {
+			return err
+		}
+		if allowance.Used != used {
+			return deposits.ErrConcurrentModification
+		}
+	}
+	for _, change := range tx.writes {
+		change(repository.tables)
+	}
+
+	return nil
+}
+
+func (repository *memoryRepository) SaveDeposit(ctx context.Context, investorId investors.InvestorId, deposit deposits.Deposit) error {
+	repository.runInterleaved("SaveDeposit")
+	if err := repository.failures["SaveDeposit"]; err != nil {
+		return err
+	}
+
+	messages, err := deposits.DepositOutboxMessages(deposit, time.Now())
+	if err != nil {
+		return err
+	}
+
+	repository.write(func(tables *memoryTables) {
+		// Deposits are a second apart, so those saved later are always newer
+		createdAt := time.Unix(int64(len(tables.deposits)), 0).UTC()
+		tables.deposits[deposit.Id] = memoryDeposit{investorId: investorId, status: deposit.Status, version: deposit.Version, createdAt: createdAt}
+		tables.outbox = append(tables.outbox, messages...)
+	})
+	return nil
+}
+
+func (repository *memoryRepository) SavePot(ctx context.Context, depositId deposits.DepositId, pot deposits.Pot) error {
+	if err := repository.failures["SavePot"]; err != nil {
+		return err
+	}
+
+	pot.Accounts = nil
+	repository.write(func(tables *memoryTables) {
+		tables.pots[pot.Id] = memoryPot{depositId: depositId, pot: pot}
+		tables.order = append(tables.order, pot.Id.String())
+	})
+	return nil
+}
+
+func (repository *memoryRepository) SaveAccount(ctx context.Context, potId deposits.PotId, account deposits.Account) error {
+	if err := repository.failures["SaveAccount"]; err != nil {
+		return err
+	}
+
+	messages, err := deposits.AccountOutboxMessages(account, time.Now())
+	if err != nil {
+		return err
+	}
+
+	repository.write(func(tables *memoryTables) {
+		tables.accounts[account.Id] = appendEvents(tables, account)
+		tables.outbox = append(tables.outbox, messages...)
+		tables.accountPots[account.Id] = potId
+		tables.order = append(tables.order, account.Id.String())
+	})
+	return nil
+}
+
+func (repository *memoryRepository) UpdatePot(ctx context.Context, pot deposits.Pot) error {
+	if err := repository.failures["UpdatePot"]; err != nil {
+		return err
+	}
+
+	repository.write(func(tables *memoryTables) {
+		stored := tables.pots[pot.Id]
+		stored.pot.Name = pot.Name
+		tables.pots[pot.Id] = stored
+	})
+	return nil
+}
+
+// RemoveAccount keeps the account's stream, like the postgres Store, but drops it from the tables it's read from
+func (repository *memoryRepository) RemoveAccount(ctx context.Context, account deposits.Account) error {
+	if err := repository.failures["RemoveAccount"]; err != nil {
+		return err
+	}
+
+	if repository.tables.accounts[account.Id].Version != account.Version {
+		return deposits.ErrConcurrentModification
+	}
+	if repository.tx != nil {
+		repository.tx.versions[account.Id] = account.Version
+	}
+
+	messages, err := deposits.AccountOutboxMessages(account, time.Now())
+	if err != nil {
+		return err
+	}
+
+	repository.write(func(tables *memoryTables) {
+		appendEvents(tables, account)
+		tables.outbox = append(tables.outbox, messages...)
+		delete(tables.accounts, account.Id)
+		delete(tables.accountPots, account.Id)
+	})
+	return nil
+}
+
+func (repository *memoryRepository) AccountHasReceipts(ctx context.Context, accountId deposits.AccountId) (bool, error) {
+	for _, receipt := range repository.tables.receipts {
+		if receipt.AccountId == accountId {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// GetAccount folds the account's stream, like the postgres Store, so the tests check it matches the projection
+func (repository *memoryRepository) GetAccount(ctx context.Context, accountId deposits.AccountId) (*deposits.Account, error) {
+	account, err := deposits.RestoreAccount(accountId, nil, repository.tables.events[accountId])
+	if err != nil {
+		return nil, err
+	}
+
+	// Don't share receipts with other transactions
+	account.Receipts = slices.Clone(repository.tables.accounts[accountId].Receipts)
+	return account, nil
+}
+
+func (repository *memoryRepository) UpdateAccount(ctx context.Context, account deposits.Account) error {
+	if err := repository.failures["UpdateAccount"]; err != nil {
+		return err
+	}
+
+	if repository.tables.accounts[account.Id].Version != account.Version {
+		return deposits.ErrConcurrentModification
+	}
+	if repository.tx != nil {
+		repository.tx.versions[account.Id] = account.Version
+	}
+
+	messages, err := deposits.AccountOutboxMessages(account, time.Now())
+	if err != nil {
+		return err
+	}
+
+	repository.write(func(tables *memoryTables) {
+		tables.accounts[account.Id] = appendEvents(tables, account)
+		tables.outbox = append(tables.outbox, messages...)
+	})
+	return nil
+}
+
+// appendEvents adds the account's events to its stream, returning the account as it's projected once they're saved
+func appendEvents(tables *memoryTables, account deposits.Account) deposits.Account {
+	// Streams are shared with the tables transactions were cloned from
+	tables.events[account.Id] = append(slices.Clone(tables.events[account.Id]), account.Events...)
+
+	account.Version = account.LatestVersion()
+	account.Events = nil
+	return account
+}
+
+func (repository *memoryRepository) GetAccountEvents(ctx context.Context, accountId deposits.AccountId) ([]deposits.RecordedEvent, error) {
+	return slices.Clone(repository.tables.events[accountId]), nil
+}
+
+func (repository *memoryRepository) ListAccountIds(ctx context.Context) ([]deposits.AccountId, error) {
+	accountIds := []deposits.AccountId{}
+	for _, id := range repository.tables.order {
+		if _, ok := repository.tables.accounts[deposits.AccountId(id)]; ok {
+			accountIds = append(accountIds, deposits.AccountId(id))
+		}
+	}
+
+	return accountIds, nil
+}
+
+func (repository *memoryRepository) ProjectAccount(ctx context.Context, account deposits.Account) error {
+	if err := repository.failures["ProjectAccount"]; err != nil {
+		return err
+	}
+
+	repository.write(func(tables *memoryTables) {
+		stored := tables.accounts[account.Id]
+		if stored.Version > account.LatestVersion() {
+			return
+		}
+
+		account.Receipts = stored.Receipts
+		tables.accounts[account.Id] = account
+	})
+	return nil
+}
+
+func (repository *memoryRepository) ReplaceAccountSnapshots(ctx context.Context, account deposits.Account) error {
+	if err := repository.failures["ReplaceAccountSnapshots"]; err != nil {
+		return err
+	}
+
+	account.Version = account.LatestVersion()
+	account.Receipts = nil
+	account.Events = nil
+	repository.write(func(tables *memoryTables) {
+		tables.snapshots[account.Id] = []deposits.Account{account}
+	})
+	return nil
+}
+
+// LockDeposit stands in for the postgres row lock by failing the transaction if the deposit changes before it commits
+func (repository *memoryRepository) LockDeposit(ctx context.Context, depositId deposits.DepositId) (*deposits.Deposit, error) {
+	deposit, err := repository.getDeposit(depositId)
+	if err != nil {
+		return nil, err
+	}
+
+	if repository.tx != nil {
+		if _, locked := repository.tx.depositVersions[depositId]; !locked {
+			repository.tx.depositVersions[depositId] = deposit.Version
+		}
+	}
+
+	return deposit, nil
+}
+
+func (repository *memoryRepository) getDeposit(depositId deposits.DepositId) (*deposits.Deposit, error) {
+	stored, ok := repository.tables.deposits[depositId]
+	if !ok {
+		return nil, deposits.ErrDepositNotFound
+	}
+
+	deposit, err := deposits.ParseDeposit(depositId.String(), stored.status.String())
+	if err != nil {
+		return nil, err
+	}
+	deposit.InvestorId = stored.investorId
+	deposit.Version = stored.version
+
+	return deposit, nil
+}
+
+func (repository *memoryRepository) ListDepositSummaries(ctx context.Context, filter deposits.DepositFilter, limit int) ([]*deposits.DepositSummary, error) {
+	summaries := []*deposits.DepositSummary{}
+	for id, stored := range repository.tables.deposits {
+		switch {
+		case filter.InvestorId != "" && stored.investorId != filter.InvestorId:
+			continue
+		case len(filter.Statuses) > 0 && !slices.Contains(filter.Statuses, stored.status):
+			continue
+		case !filter.CreatedFrom.IsZero() && stored.createdAt.Before(filter.CreatedFrom):
+			continue
+		case !filter.CreatedTo.IsZero() && !stored.createdAt.Before(filter.CreatedTo):
+			continue
+		case filter.After != nil && compareDepositSummary(stored.createdAt, id, filter.After.CreatedAt, filter.After.Id) >= 0:
+			continue
+		}
+
+		deposit, err := repository.GetFullDeposit(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		summary := &deposits.DepositSummary{
+			Id:         id,
+			InvestorId: stored.investorId,
+			Status:     stored.status,
+			CreatedAt:  stored.createdAt,
+			Totals:     []deposits.DepositTotal{},
+		}
+
+		// Total each currency, in currency order like the store
+		currencies := []deposits.Currency{}
+		gross := map[deposits.Currency]int64{}
+		totals := map[deposits.Currency]*deposits.DepositTotal{}
+		for _, pot := range deposit.Pots {
+			for _, account := range pot.Accounts {
+				currency := account.NominalAmount.Currency
+				if _, ok := totals[currency]; !ok {
+					currencies = append(currencies, currency)
+					totals[currency] = &deposits.DepositTotal{
+						NominalAmount:   deposits.Money{Currency: currency},
+						AllocatedAmount: deposits.Money{Currency: currency},
+					}
+				}
+				totals[currency].NominalAmount.Amount += account.NominalAmount.Amount
+				totals[currency].AllocatedAmount.Amount += account.TotalAllocatedAmount.Amount
+				gross[currency] += account.TotalAllocatedAmount.Amount + account.PendingReliefAmount.Amount
+			}
+		}
+		slices.Sort(currencies)
+		for _, currency := range currencies {
+			total := totals[currency]
+			total.FundedPercentage = 100
+			if total.NominalAmount.Amount != 0 {
+				total.FundedPercentage = math.Round(10000*float64(gross[currency])/float64(total.NominalAmount.Amount)) / 100
+			}
+			summary.Totals = append(summary.Totals, *total)
+		}
+
+		summaries = append(summaries, summary)
+	}
+
+	// Newest first
+	slices.SortFunc(summaries, func(a, b *deposits.DepositSummary) int {
+		return -compareDepositSummary(a.CreatedAt, a.Id, b.CreatedAt, b.Id)
+	})
+	return summaries[:min(limit, len(summaries))], nil
+}
+
+// compareDepositSummary orders deposits by when they were created then id, like the store
+func compareDepositSummary(createdAt time.Time, id deposits.DepositId, otherCreatedAt time.Time, otherId deposits.DepositId) int {
+	if comparison := createdAt.Compare(otherCreatedAt); comparison != 0 {
+		return comparison
+	}
+	return strings.Compare(id.String(), otherId.String())
+}
+
+func (repository *memoryRepository) UpdateDepositStatus(ctx context.Context, deposit deposits.Deposit) error {
+	if err := repository.failures["UpdateDepositStatus"]; err != nil {
+		return err
+	}
+
+	stored := repository.tables.deposits[deposit.Id]
+	if stored.version != deposit.Version {
+		return deposits.ErrConcurrentModification
+	}
+	if repository.tx != nil {
+		repository.tx.depositVersions[deposit.Id] = deposit.Version
+	}
+
+	messages, err := deposits.DepositOutboxMessages(deposit, time.Now())
+	if err != nil {
+		return err
+	}
+
+	stored.status = deposit.Status
+	stored.version++
+	repository.write(func(tables *memoryTables) {
+		tables.deposits[deposit.Id] = stored
+		tables.outbox = append(tables.outbox, messages...)
+	})
+	return nil
+}
+
+func (repository *memoryRepository) SaveReceipt(ctx context.Context, accountId deposits.AccountId, receipt deposits.Receipt) error {
+	repository.runInterleaved("SaveReceipt")
+	if err := repository.failures["SaveReceipt"]; err != nil {
+		return err
+	}
+
+	repository.write(func(tables *memoryTables) {
+		receipt.AccountId = accountId
+		tables.receipts[receipt.Id] = receipt
+	})
+	return nil
+}
+
+func (repository *memoryRepository) GetReceiptByIdempotencyKey(ctx context.Context, key deposits.IdempotencyKey) (*deposits.Receipt, error) {
+	for _, receipt := range repository.tables.receipts {
+		if receipt.IdempotencyKey == key {
+			return &receipt, nil
+		}
+	}
+
+	return nil, deposits.ErrReceiptNotFound
+}
+
+func (repository *memoryRepository) GetAccountInvestorId(ctx context.Context, accountId deposits.AccountId) (investors.InvestorId, error) {
+	potId, ok := repository.tables.accountPots[accountId]
+	if !ok {
+		return "", deposits.ErrAccountNotFound
+	}
+	depositId := repository.tables.pots[potId].depositId
+	return repository.tables.deposits[depositId].investorId, nil
+}
+
+func (repository *memoryRepository) GetAccountDepositId(ctx context.Context, accountId deposits.AccountId) (deposits.DepositId, error) {
+	potId, ok := repository.tables.accountPots[accountId]
+	if !ok {
+		return "", deposits.ErrAccountNotFound
+	}
+	return repository.tables.pots[potId].depositId, nil
+}
+
+func (repository *memoryRepository) GetOverflowReceipt(ctx context.Context, receiptId deposits.ReceiptId) (*deposits.Receipt, error) {
+	for _, receipt := range repository.tables.receipts {
+		if receipt.OverflowOf == receiptId {
+			return &receipt, nil
+		}
+	}
+
+	return nil, deposits.ErrReceiptNotFound
+}
+
+func (repository *memoryRepository) GetUnallocatedReceipts(ctx context.Context, depositId deposits.DepositId) ([]*deposits.Receipt, error) {
+	receipts := []*deposits.Receipt{}
+	for _, receipt := range repository.tables.receipts {
+		if !receipt.IsUnallocated() || receipt.UnallocatedAmount.IsZero() {
+			continue
+		}
+		if depositId != "" && receipt.SuspenseDepositId != depositId {
+			continue
+		}
+
+		receipts = append(receipts, &receipt)
+	}
+	slices.SortFunc(receipts, func(a *deposits.Receipt, b *deposits.Receipt) int {
+		return strings.Compare(a.Id.String(), b.Id.String())
+	})
+
+	return receipts, nil
+}
+
+func (repository *memoryRepository) UpdateUnallocatedReceipt(ctx context.Context, receipt deposits.Receipt) error {
+	if err := repository.failures["UpdateUnallocatedReceipt"]; err != nil {
+		return err
+	}
+
+	stored, ok := repository.tables.receipts[receipt.Id]
+	if !ok || stored.Version != receipt.Version {
+		return deposits.ErrConcurrentModification
+	}
+
+	stored.UnallocatedAmount = receipt.UnallocatedAmount
+	stored.Version++
+	repository.write(func(tables *memoryTables) {
+		tables.receipts[stored.Id] = stored
+	})
+	return nil
+}
+
+func (repository *memoryRepository) SaveSuspenseAllocation(ctx context.Context, allocation deposits.SuspenseAllocation) error {
+	if err := repository.failures["SaveSuspenseAllocation"]; err != nil {
+		return err
+	}
+
+	// Keys are unique
+	if allocation.IdempotencyKey != "" {
+		_, err := repository.GetSuspenseAllocationByIdempotencyKey(ctx, allocation.IdempotencyKey)
+		if err == nil {
+			return deposits.ErrConcurrentModification
+		}
+	}
+
+	repository.write(func(tables *memoryTables) {
+		tables.suspenseAllocations = append(tables.suspenseAllocations, allocation)
+	})
+	return nil
+}
+
+func (repository *memoryRepository) GetSuspenseAllocationByIdempotencyKey(ctx context.Context, key deposits.IdempotencyKey) (*deposits.SuspenseAllocation, error) {
+	for _, allocation := range repository.tables.suspenseAllocations {
+		if allocation.IdempotencyKey == key {
+			return &allocation, nil
+		}
+	}
+
+	return nil, deposits.ErrSuspenseAllocationNotFound
+}
+
+func (repository *memoryRepository) GetSuspenseAllocations(ctx context.Context, receiptId deposits.ReceiptId) ([]*deposits.SuspenseAllocation, error) {
+	allocations := []*deposits.SuspenseAllocation{}
+	for _, allocation := range repository.tables.suspenseAllocations {
+		if allocation.UnallocatedReceiptId == receiptId {
+			allocations = append(allocations, &allocation)
+		}
+	}
+
+	return allocations, nil
+}
+
+func (repository *memoryRepository) GetISAAllowance(ctx context.Context, investorId investors.InvestorId, taxYear deposits.TaxYear) (*deposits.ISAAllowance, error) {
+	return repository.tables.isaAllowance(investorId, taxYear)
+}
+
+// LockISAAllowance stands in for the postgres advisory lock by failing the transaction if another subscribes to the
+// allowance before it commits
+func (repository *memoryRepository) LockISAAllowance(ctx context.Context, investorId investors.InvestorId, taxYear deposits.TaxYear) (*deposits.ISAAllowance, error) {
+	allowance, err := repository.tables.isaAllowance(investorId, taxYear)
+	if err != nil {
+		return nil, err
+	}
+
+	key := memoryAllowanceKey{investorId: investorId, taxYear: taxYear}
+	if _, ok := repository.tx.allowances[key]; !ok {
+		repository.tx.allowances[key] = allowance.Used
+	}
+	return allowance, nil
+}
+
+func (tables memoryTables) isaAllowance(investorId investors.InvestorId, taxYear deposits.TaxYear) (*deposits.ISAAllowance, error) {
+	used := deposits.Money{Currency: deposits.ISAAnnualAllowance.Currency}
+	for _, subscription := range tables.subscriptions {
+		if subscription.InvestorId != investorId || subscription.TaxYear != taxYear {
+			continue
+		}
+
+		var err error
+		used, err = used.Add(subscription.Amount.Money)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return deposits.NewISAAllowance(investorId, taxYear, used)
+}
+
+func (repository *memoryRepository) SaveISASubscription(ctx context.Context, subscription deposits.ISASubscription) error {
+	if err := repository.failures["SaveISASubscription"]; err != nil {
+		return err
+	}
+
+	repository.write(func(tables *memoryTables) {
+		tables.subscriptions = append(tables.subscriptions, subscription)
+	})
+	return nil
+}
+
+func (repository *memoryRepository) SaveReliefClaim(ctx context.Context, claim deposits.ReliefClaim) error {
+	if err := repository.failures["SaveReliefClaim"]; err != nil {
+		return err
+	}
+
+	repository.write(func(tables *memoryTables) {
+		tables.reliefClaims[claim.Id] = claim
+	})
+	return nil
+}
+
+func (repository *memoryRepository) DeleteISASubscription(ctx context.Context, receiptId deposits.ReceiptId) error {
+	repository.write(func(tables *memoryTables) {
+		tables.subscriptions = slices.DeleteFunc(slices.Clone(tables.subscriptions), func(subscription deposits.ISASubscription) bool {
+			return subscription.ReceiptId == receiptId
+		})
+	})
+	return nil
+}
+
+func (repository *memoryRepository) UpdateReliefClaim(ctx context.Context, claim deposits.ReliefClaim) error {
+	repository.write(func(tables *memoryTables) {
+		tables.reliefClaims[claim.Id] = claim
+	})
+	return nil
+}
+
+// GetPendingReliefClaims stands in for the postgres row locks by failing the transaction if another changes the claims
+// before it commits
+func (repository *memoryRepository) GetPendingReliefClaims(ctx context.Context, before time.Time) ([]*deposits.ReliefClaim, error) {
+	claims := []*deposits.ReliefClaim{}
+	for _, claim := range repository.tables.reliefClaims {
+		if claim.Status != deposits.ReliefClaimStatusPending || !claim.CreatedAt.Before(before) {
+			continue
+		}
+
+		if repository.tx != nil {
+			repository.tx.reliefClaims[claim.Id] = claim.Status
+		}
+		claims = append(claims, &claim)
+	}
+	slices.SortFunc(claims, func(claim *deposits.ReliefClaim, other *deposits.ReliefClaim) int {
+		return claim.CreatedAt.Compare(other.CreatedAt)
+	})
+
+	return claims, nil
+}
+
+func (repository *memoryRepository) SaveClaimBatch(ctx context.Context, batch deposits.ClaimBatch) error {
+	repository.runInterleaved("SaveClaimBatch")
+
+	repository.write(func(tables *memoryTables) {
+		tables.claimBatches[batch.Id] = batch
+	})
+	return nil
+}
+
+func (repository *memoryRepository) GetReliefClaimByReceiptId(ctx context.Context, receiptId deposits.ReceiptId) (*deposits.ReliefClaim, error) {
+	for _, claim := range repository.tables.reliefClaims {
+		if claim.ReceiptId == receiptId {
+			return &claim, nil
+		}
+	}
+
+	return nil, deposits.ErrReliefClaimNotFound
+}
+
+func (repository *memoryRepository) GetReceipt(ctx context.Context, receiptId deposits.ReceiptId) (*deposits.Receipt, error) {
+	receipt, ok := repository.tables.receipts[receiptId]
+	if !ok {
+		return nil, deposits.ErrReceiptNotFound
+	}
+
+	return &receipt, nil
+}
+
+func (repository *memoryRepository) SaveReversal(ctx context.Context, reversal deposits.Reversal) error {
+	if err := repository.failures["SaveReversal"]; err != nil {
+		return err
+	}
+
+	repository.write(func(tables *memoryTables) {
+		tables.reversals[reversal.Id] = reversal
+	})
+	return nil
+}
+
+func (repository *memoryRepository) GetReversalByReceiptId(ctx context.Context, receiptId deposits.ReceiptId) (*deposits.Reversal, error) {
+	for _, reversal := range repository.tables.reversals {
+		if reversal.ReceiptId == receiptId {
+			return &reversal, nil
+		}
+	}
+
+	return nil, deposits.ErrReversalNotFound
+}
+
+func (repository *memoryRepository) GetReversalByIdempotencyKey(ctx context.Context, key deposits.IdempotencyKey) (*deposits.Reversal, error) {
+	for _, reversal := range repository.tables.reversals {
+		if reversal.IdempotencyKey == key {
+			return &reversal, nil
+		}
+	}
+
+	return nil, deposits.ErrReversalNotFound
+}
+
+func (repository *memoryRepository) GetFullDeposit(ctx context.Context, depositId deposits.DepositId) (*deposits.Deposit, error) {
+	if err := repository.failures["GetFullDeposit"]; err != nil {
+		return nil, err
+	}
+
+	return repository.getFullDeposit(depositId)
+}
+
+func (repository *memoryRepository) GetDepositBalances(ctx context.Context, depositId deposits.DepositId) (*deposits.Deposit, error) {
+	deposit, err := repository.getFullDeposit(depositId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pot := range deposit.Pots {
+		for _, account := range pot.Accounts {
+			account.Receipts = nil
+		}
+	}
+	deposit.SuspenseReceipts = nil
+	return deposit, nil
+}
+
+func (repository *memoryRepository) getFullDeposit(depositId deposits.DepositId) (*deposits.Deposit, error) {
+	deposit, err := repository.getDeposit(depositId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pots are always saved before their accounts
+	pots := map[deposits.PotId]*deposits.Pot{}
+	for _, id := range repository.tables.order {
+		if stored, ok := repository.tables.pots[deposits.PotId(id)]; ok && stored.depositId == depositId {
+			pot := stored.pot
+			pots[pot.Id] = &pot
+			deposit.AddPot(&pot)
+		}
+
+		if stored, ok := repository.tables.accounts[deposits.AccountId(id)]; ok {
+			pot, ok := pots[repository.tables.accountPots[stored.Id]]
+			if !ok {
+				continue
+			}
+
+			account := stored
+			account.Receipts = slices.Clone(account.Receipts)
+			pot.Accounts = append(pot.Accounts, &account)
+		}
+	}
+
+	for _, receipt := range repository.tables.receipts {
+		if receipt.IsUnallocated() && receipt.SuspenseDepositId == depositId {
+			deposit.SuspenseReceipts = append(deposit.SuspenseReceipts, &receipt)
+		}
+	}
+
+	return deposit, nil
+}
+
+func (repository *memoryRepository) SaveDepositReceipt(ctx context.Context, depositReceipt deposits.DepositReceipt) error {
+	if err := repository.failures["SaveDepositReceipt"]; err != nil {
+		return err
+	}
+
+	depositReceipt.Receipts = nil
+	repository.write(func(tables *memoryTables) {
+		tables.depositReceipts[depositReceipt.Id] = depositReceipt
+	})
+	return nil
+}
+
+func (repository *memoryRepository) GetDepositReceiptByIdempotencyKey(ctx context.Context, key deposits.IdempotencyKey) (*deposits.DepositReceipt, error) {
+	for _, depositReceipt := range repository.tables.depositReceipts {
+		if depositReceipt.IdempotencyKey != key {
+			continue
+		}
+
+		for _, receipt := range repository.tables.receipts {
+			if receipt.DepositReceiptId == depositReceipt.Id {
+				depositReceipt.Receipts = append(depositReceipt.Receipts, &receipt)
+			}
+		}
+		return &depositReceipt, nil
+	}
+
+	return nil, deposits.ErrDepositReceiptNotFound
+}
+
+// LockInvestorWrapperTypes stands in for the postgres row lock by failing the transaction if another locks the investor
+// before it commits
+func (repository *memoryRepository) LockInvestorWrapperTypes(ctx context.Context, investorId investors.InvestorId, excluding deposits.DepositId) ([]deposits.WrapperType, error) {
+	if _, locked := repository.tx.investorLocks[investorId]; !locked {
+		repository.tx.investorLocks[investorId] = repository.tables.investorLocks[investorId]
+		repository.write(func(tables *memoryTables) {
+			tables.investorLocks[investorId]++
+		})
+	}
+
+	wrapperTypes := []deposits.WrapperType{}
+	for accountId, account := range repository.tables.accounts {
+		pot := repository.tables.pots[repository.tables.accountPots[accountId]]
+		deposit := repository.tables.deposits[pot.depositId]
+		if deposit.investorId == investorId && deposit.status != deposits.DepositStatusCancelled && pot.depositId != excluding {
+			wrapperTypes = append(wrapperTypes, account.WrapperType)
+		}
+	}
+
+	return wrapperTypes, nil
+}
+
+func (repository *memoryRepository) SaveReceiptRejection(ctx context.Context, rejection deposits.ReceiptRejection) error {
+	message, err := deposits.ReceiptRejectionOutboxMessage(rejection)
+	if err != nil {
+		return err
+	}
+
+	repository.write(func(tables *memoryTables) {
+		tables.outbox = append(tables.outbox, *message)
+	})
+	return nil
+}
+
+func (repository *memoryRepository) SaveJournal(ctx context.Context, journal ledger.Journal) error {
+	if err := repository.failures["SaveJournal"]; err != nil {
+		return err
+	}
+
+	// Like the database, don't commit journals that don't balance
+	err := journal.Validate()
+	if err != nil {
+		return err
+	}
+
+	repository.write(func(tables *memoryTables) {
+		tables.journals = append(tables.journals, journal)
+	})
+	return nil
+}
+
+// ledgerBalances totals the journals posted to each ledger account, in pence, debits positive
+func (repository *memoryRepository) ledgerBalances() map[ledger.AccountCode]int64 {
+	balances := map[ledger.AccountCode]int64{}
+	for _, journal := range repository.tables.journals {
+		for _, entry := range journal.Entries {
+			balances[entry.Account] += entry.Amount
+		}
+	}
+
+	return balances
+}
+
+// outboxTopics returns the topics of the messages in the outbox about the key, in the order they were saved
+func (repository *memoryRepository) outboxTopics(key string) []string {
+	topics := []string{}
+	for _, message := range repository.tables.outbox {
+		if message.Key == key {
+			topics = append(topics, message.Topic)
+		}
+	}
+
+	return topics
+}
+
+// memoryInvestors finds the investors it holds, with nil for those that don't exist, and an adult UK resident who's
+// eligible for everything for any other id
+type memoryInvestors map[investors.InvestorId]*investors.Investor
+
+func (memory memoryInvestors) Get(ctx context.Context, id investors.InvestorId) (*investors.Investor, error) {
+	if investor, ok := memory[id]; ok {
+		if investor == nil {
+			return nil, investors.ErrInvestorNotFound
+		}
+		return investor, nil
+	}
+
+	return newTestInvestor(id, "1990-01-31"), nil
+}
+
+// newTestInvestor creates a UK resident investor, born on the date, with everything they need to pass KYC
+func newTestInvestor(id investors.InvestorId, dateOfBirth string) *investors.Investor {
+	born, err := investors.ParseDateOfBirth(dateOfBirth)
+	if err != nil {
+		panic(err)
+	}
+	address, err := investors.NewAddress([]string{"10 Downing Street"}, "SW1A 2AA", "GB")
+	if err != nil {
+		panic(err)
+	}
+
+	return &investors.Investor{
+		Id:   id,
+		Name: "Test",
+		Profile: investors.Profile{
+			DateOfBirth:  born,
+			NINumber:     "AB123456C",
+			TaxResidency: investors.TaxResidencyUK,
+			Address:      address,
+		},
+	}
+}
+
+// newTestDeposit creates a deposit with a single pot holding accounts of the given wrapper types
+func newTestDeposit(t *testing.T, nominal deposits.Money, wrapperTypes ...deposits.WrapperType) *deposits.Deposit {
+	t.Helper()
+
+	deposit, err := deposits.NewDeposit()
+	require.NoError(t, err)
+
+	pot, err := deposits.NewPot("Pot A")
+	require.NoError(t, err)
+	for _, wrapperType := range wrapperTypes {
+		account, err := deposits.NewAccount(wrapperType, nominal)
+		require.NoError(t, err)
+		err = pot.AddAccount(account)
+		require.NoError(t, err)
+	}
+	deposit.AddPot(pot)
+
+	return deposit
+}
+
+func TestServiceCreate(t *testing.T) {
+	investorId := investors.InvestorId(uuid.NewString())
+
+	t.Run("saves everything", func(t *testing.T) {
+		repository := newMemoryRepository()
+		service := deposits.NewService(repository, memoryInvestors{})
+
+		deposit := newTestDeposit(t, gbp(100), deposits.WrapperTypeGIA, deposits.WrapperTypeISA)
+		err := service.Create(context.Background(), investorId, deposit)
+		require.NoError(t, err)
+
+		require.Len(t, repository.tables.deposits, 1)
+		require.Len(t, repository.tables.pots, 1)
+		require.Len(t, repository.tables.accounts, 2)
+	})
+
+	testCases := []struct {
+		description string
+		failOn      string
+	}{
+		{
+			description: "rolls back when saving the pot fails",
+			failOn:      "SavePot",
+		},
+		{
+			description: "rolls back when saving an account fails",
+			failOn:      "SaveAccount",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			repository := newMemoryRepository()
+			repository.failOn(testCase.failOn)
+			service := deposits.NewService(repository, memoryInvestors{})
+
+			deposit := newTestDeposit(t, gbp(100), deposits.WrapperTypeGIA, deposits.WrapperTypeISA)
+			err := service.Create(context.Background(), investorId, deposit)
+			require.ErrorIs(t, err, errInjected)
+
+			require.Empty(t, repository.tables.deposits)
+			require.Empty(t, repository.tables.pots)
+			require.Empty(t, repository.tables.accounts)
+		})
+	}
+}
+
+func TestServiceCreateEligibility(t *testing.T) {
+	childId := investors.InvestorId(uuid.NewString())
+	unknownId := investors.InvestorId(uuid.NewString())
+	investorLookup := memoryInvestors{
+		childId:   newTestInvestor(childId, "2015-01-31"),
+		unknownId: nil,
+	}
+
+	t.Run("one junior ISA per child", func(t *testing.T) {
+		repository := newMemoryRepository()
+		service := deposits.NewService(repository, investorLookup)
+
+		first := newTestDeposit(t, gbp(100), deposits.WrapperTypeJuniorISA)
+		err := service.Create(context.Background(), childId, first)
+		require.NoError(t, err)
+
+		second := newTestDeposit(t, gbp(100), deposits.WrapperTypeJuniorISA, deposits.WrapperTypeGIA)
+		err = service.Create(context.Background(), childId, second)
+		require.ErrorIs(t, err, deposits.ErrInvestorIneligible)
+		require.ErrorIs(t, err, deposits.ErrWrapperAlreadyHeld)
+		require.ErrorIs(t, err, deposits.ErrInvestorTooYoung)
+		require.Len(t, repository.tables.deposits, 1)
+
+		// Cancelling the first frees up the junior ISA
+		_, _, err = service.CancelDeposit(context.Background(), first.Id)
+		require.NoError(t, err)
+		third := newTestDeposit(t, gbp(100), deposits.WrapperTypeJuniorISA)
+		err = service.Create(context.Background(), childId, third)
+		require.NoError(t, err)
+	})
+
+	t.Run("concurrent deposits can't both give a child a junior ISA", func(t *testing.T) {
+		repository := newMemoryRepository()
+		service := deposits.NewService(repository, investorLookup)
+
+		// The other deposit's saved after this one checked what the child holds
+		other := newTestDeposit(t, gbp(100), deposits.WrapperTypeJuniorISA)
+		repository.interleave("SaveDeposit", func() {
+			err := service.Create(context.Background(), childId, other)
+			require.NoError(t, err)
+		})
+
+		err := service.Create(context.Background(), childId, newTestDeposit(t, gbp(100), deposits.WrapperTypeJuniorISA))
+		require.ErrorIs(t, err, deposits.ErrWrapperAlreadyHeld)
+		require.Len(t, repository.tables.deposits, 1)
+		require.Contains(t, repository.tables.deposits, other.Id)
+	})
+
+	t.Run("amendments can only add accounts the investor can hold", func(t *testing.T) {
+		repository := newMemoryRepository()
+		service := deposits.NewService(repository, investorLookup)
+
+		first := newTestDeposit(t, gbp(100), deposits.WrapperTypeJuniorISA)
+		err := service.Create(context.Background(), childId, first)
+		require.NoError(t, err)
+		second := newTestDeposit(t, gbp(100), deposits.WrapperTypeSIPP)
+		err = service.Create(context.Background(), childId, second)
+		require.NoError(t, err)
+
+		pot, err := deposits.NewPot("Pot B")
+		require.NoError(t, err)
+		junior, err := deposits.NewAccount(deposits.WrapperTypeJuniorISA, gbp(100))
+		require.NoError(t, err)
+		require.NoError(t, pot.AddAccount(junior))
+		isa, err := deposits.NewAccount(deposits.WrapperTypeISA, gbp(100))
+		require.NoError(t, err)
+
+		_, _, err = service.UpdateDeposit(context.Background(), second.Id, []deposits.Amendment{
+			deposits.AddPotAmendment{Pot: pot},
+			deposits.AddAccountAmendment{PotId: second.Pots[0].Id, Account: isa},
+		})
+		require.ErrorIs(t, err, deposits.ErrInvestorIneligible)
+		require.ErrorIs(t, err, deposits.ErrWrapperAlreadyHeld)
+		require.ErrorIs(t, err, deposits.ErrInvestorTooYoung)
+		require.NotContains(t, repository.tables.accounts, junior.Id)
+		require.NotContains(t, repository.tables.accounts, isa.Id)
+	})
+
+	t.Run("unknown investor", func(t *testing.T) {
+		repository := newMemoryRepository()
+		service := deposits.NewService(repository, investorLookup)
+
+		err := service.Create(context.Background(), unknownId, newTestDeposit(t, gbp(100), deposits.WrapperTypeGIA))
+		require.ErrorIs(t, err, investors.ErrInvestorNotFound)
+		require.Empty(t, repository.tables.deposits)
+	})
+}
+
+func TestServiceGet(t *testing.T) {
+	investorId := investors.InvestorId(uuid.NewString())
+
+	t.Run("returns the whole deposit", func(t *testing.T) {
+		repository := newMemoryRepository()
+		service := deposits.NewService(repository, memoryInvestors{})
+
+		deposit := newTestDeposit(t, gbp(100), deposits.WrapperTypeISA)
+		emptyPot, err := deposits.NewPot("Pot B")
+		require.NoError(t, err)
+		deposit.AddPot(emptyPot)
+		err = service.Create(context.Background(), investorId, deposit)
+		require.NoError(t, err)
+
+		// The ISA takes 100, with the rest held in suspense as there's no GIA
+		isa := deposit.Pots[0].Accounts[0]
+		receipt, err := deposits.NewReceipt(gbp(150))
+		require.NoError(t, err)
+		_, overflow, _, err := service.ReceiveReceiptWithOverflow(context.Background(), isa.Id, receipt)
+		require.NoError(t, err)
+
+		found, err := service.Get(context.Background(), deposit.Id)
+		require.NoError(t, err)
+		require.Equal(t, investorId, found.InvestorId)
+		require.Len(t, found.Pots, 2)
+		require.Empty(t, found.Pots[1].Accounts)
+		require.Len(t, found.Pots[0].Accounts[0].Receipts, 1)
+		require.Equal(t, receipt.Id, found.Pots[0].Accounts[0].Receipts[0].Id)
+		require.Len(t, found.SuspenseReceipts, 1)
+		require.Equal(t, overflow.Id, found.SuspenseReceipts[0].Id)
+	})
+
+	t.Run("unknown deposit", func(t *testing.T) {
+		service := deposits.NewService(newMemoryRepository(), memoryInvestors{})
+
+		_, err := service.Get(context.Background(), deposits.DepositId(uuid.NewString()))
+		require.ErrorIs(t, err, deposits.ErrDepositNotFound)
+	})
+}
+
+func TestServiceReceiveReceipt(t *testing.T) {
+	investorId := investors.InvestorId(uuid.NewString())
+
+	t.Run("saves everything", func(t *testing.T) {
+		repository := newMemoryRepository()
+		service := deposits.NewService(repository, memoryInvestors{})
+
+		deposit := newTestDeposit(t, gbp(100_00), deposits.WrapperTypeISA)
+		err := service.Create(context.Background(), investorId, deposit)
+		require.NoError(t, err)
+		account := deposit.Pots[0].Accounts[0]
+
+		receipt, err := deposits.NewReceipt(gbp(40_00))
+		require.NoError(t, err)
+		_, _, err = service.ReceiveReceipt(context.Background(), account.Id, receipt)
+		require.NoError(t, err)
+
+		require.Equal(t, account.Id, repository.tables.receipts[receipt.Id].AccountId)
+		require.Len(t, repository.tables.subscriptions, 1)
+		require.Equal(t, gbp(40_00), repository.tables.accounts[account.Id].TotalAllocatedAmount.Money)
+	})
+
+	t.Run("unknown account", func(t *testing.T) {
+		repository := newMemoryRepository()
+		service := deposits.NewService(repository, memoryInvestors{})
+
+		receipt, err := deposits.NewReceipt(gbp(40_00))
+		require.NoError(t, err)
+		_, _, err = service.ReceiveReceipt(context.Background(), deposits.AccountId(uuid.NewString()), receipt)
+		require.ErrorIs(t, err, deposits.ErrAccountNotFound)
+		require.Empty(t, repository.tables.receipts)
+	})
+
+	testCases := []struct {
+		description string
+		wrapperType deposits.WrapperType
+		failOn      string
+	}{
+		{
+			description: "rolls back when saving the ISA subscription fails",
+			wrapperType: deposits.WrapperTypeISA,
+			failOn:      "SaveISASubscription",
+		},
+		{
+			description: "rolls back when saving the relief claim fails",
+			wrapperType: deposits.WrapperTypeSIPP,
+			failOn:      "SaveReliefClaim",
+		},
+		{
+			description: "rolls back when updating the account fails",
+			wrapperType: deposits.WrapperTypeISA,
+			failOn:      "UpdateAccount",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			repository := newMemoryRepository()
+			service := deposits.NewService(repository, memoryInvestors{})
+
+			deposit := newTestDeposit(t, gbp(100_00), testCase.wrapperType)
+			err := service.Create(context.Background(), investorId, deposit)
+			require.NoError(t, err)
+			account := deposit.Pots[0].Accounts[0]
+
+			repository.failOn(testCase.failOn)
+			receipt, err := deposits.NewReceipt(gbp(40_00))
+			require.NoError(t, err)
+			_, _, err = service.ReceiveReceipt(context.Background(), account.Id, receipt)
+			require.ErrorIs(t, err, errInjected)
+
+			require.Empty(t, repository.tables.receipts)
+			require.Empty(t, repository.tables.subscriptions)
+			require.Empty(t, repository.tables.reliefClaims)
+			require.Equal(t, gbp(0), repository.tables.accounts[account.Id].TotalAllocatedAmount.Money)
+			require.Equal(t, gbp(0), repository.tables.accounts[account.Id].PendingReliefAmount.Money)
+		})
+	}
+}
+
+func TestServiceReceiveReceiptConcurrently(t *testing.T) {
+	investorId := investors.InvestorId(uuid.NewString())
+
+	repository := newMemoryRepository()
+	service := deposits.NewService(repository, memoryInvestors{})
+
+	deposit := newTestDeposit(t, gbp(100_00), deposits.WrapperTypeISA)
+	err := service.Create(context.Background(), investorId, deposit)
+	require.NoError(t, err)
+	account := deposit.Pots[0].Accounts[0]
+
+	// Fire more receipts than the nominal can take at the same account
+	const receiptCount = 50
+	errs := make([]error, receiptCount)
+	receipts := make([]*deposits.Receipt, receiptCount)
+	wg := sync.WaitGroup{}
+	for i := range receiptCount {
+		receipt, err := deposits.NewReceipt(gbp(10_00))
+		require.NoError(t, err)
+		receipts[i] = receipt
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _, errs[i] = service.ReceiveReceipt(context.Background(), account.Id, receipt)
+		}()
+	}
+	wg.Wait()
+
+	// Every receipt either made it in or was refused, nothing was half saved
+	allocated := gbp(0)
+	for i, err := range errs {
+		_, saved := repository.tables.receipts[receipts[i].Id]
+		if err != nil {
+			require.False(t, saved)
+			require.True(t, errors.Is(err, deposits.ErrNominalExceeded) || errors.Is(err, deposits.ErrConcurrentModification), err)
+			continue
+		}
+
+		require.True(t, saved)
+		allocated, err = allocated.Add(receipts[i].AllocatedAmount.Money)
+		require.NoError(t, err)
+	}
+
+	// The nominal cap held, and the account matches the receipts saved
+	stored := repository.tables.accounts[account.Id]
+	require.NotEqual(t, gbp(0), allocated)
+	require.Equal(t, allocated, stored.TotalAllocatedAmount.Money)
+	comparison, err := stored.TotalAllocatedAmount.Compare(stored.NominalAmount.Money)
+	require.NoError(t, err)
+	require.LessOrEqual(t, comparison, 0)
+	require.Len(t, repository.tables.subscriptions, len(repository.tables.receipts))
+}
+
+func TestServiceReceiveReceiptRetries(t *testing.T) {
+	investorId := investors.InvestorId(uuid.NewString())
+
+	repository := newMemoryRepository()
+	service := deposits.NewService(repository, memoryInvestors{})
+
+	deposit := newTestDeposit(t, gbp(100_00), deposits.WrapperTypeGIA)
+	err := service.Create(context.Background(), investorId, deposit)
+	require.NoError(t, err)
+	account := deposit.Pots[0].Accounts[0]
+
+	// The account always looks modified, so the service gives up
+	repository.failures["UpdateAccount"] = deposits.ErrConcurrentModification
+	receipt, err := deposits.NewReceipt(gbp(10_00))
+	require.NoError(t, err)
+	_, _, err = service.ReceiveReceipt(context.Background(), account.Id, receipt)
+	require.ErrorIs(t, err, deposits.ErrConcurrentModification)
+	require.Empty(t, repository.tables.receipts)
+}
+
+func TestServiceExportReliefClaimsConcurrently(t *testing.T) {
+	investorId := investors.InvestorId(uuid.NewString())
+
+	repository := newMemoryRepository()
+	service := deposits.NewService(repository, memoryInvestors{})
+
+	deposit := newTestDeposit(t, gbp(100_00), deposits.WrapperTypeSIPP)
+	err := service.Create(context.Background(), investorId, deposit)
+	require.NoError(t, err)
+	account := deposit.Pots[0].Accounts[0]
+
+	// Each receipt claims relief
+	for range 4 {
+		receipt, err := deposits.NewReceipt(gbp(10_00))
+		require.NoError(t, err)
+		_, _, err = service.ReceiveReceipt(context.Background(), account.Id, receipt)
+		require.NoError(t, err)
+	}
+	require.Len(t, repository.tables.reliefClaims, 4)
+
+	// Another export of the same period is made after this one's read the pending claims
+	period := deposits.ClaimPeriodOf(time.Now())
+	var other *deposits.ClaimBatch
+	repository.interleave("SaveClaimBatch", func() {
+		other, err = service.ExportReliefClaims(context.Background(), period)
+		require.NoError(t, err)
+	})
+
+	_, err = service.ExportReliefClaims(context.Background(), period)
+	require.ErrorIs(t, err, deposits.ErrNoPendingReliefClaims)
+
+	// Every claim is only in the other batch
+	require.Len(t, other.Claims, 4)
+	require.Len(t, repository.tables.claimBatches, 1)
+	for _, claim := range repository.tables.reliefClaims {
+		require.Equal(t, deposits.ReliefClaimStatusClaimed, claim.Status)
+		require.Equal(t, other.Id, claim.BatchId)
+	}
+}
+
+func TestServiceISAAllowance(t *testing.T) {
+	investorId := investors.InvestorId(uuid.NewString())
+
+	// receive sends an ISA receipt for the amount to the account
+	receive := func(t *testing.T, service *deposits.Service, accountId deposits.AccountId, amount deposits.Money, receivedAt time.Time) error {
+		receipt, err := deposits.NewReceipt(amount)
+		require.NoError(t, err)
+		receipt.ReceivedAt = receivedAt
+
+		_, _, err = service.ReceiveReceipt(context.Background(), accountId, receipt)
+		return err
+	}
+
+	t.Run("receipts use the allowance of the tax year they were received in", func(t *testing.T) {
+		repository := newMemoryRepository()
+		service := deposits.NewService(repository, memoryInvestors{})
+
+		deposit := newTestDeposit(t, gbp(40_000_00), deposits.WrapperTypeISA)
+		err := service.Create(context.Background(), investorId, deposit)
+		require.NoError(t, err)
+		isa := deposit.Pots[0].Accounts[0]
+
+		// Received on the last day of the tax year, but saved after it ended
+		thisYear := deposits.TaxYearOf(time.Now())
+		lastYear := thisYear - 1
+		err = receive(t, service, isa.Id, gbp(15_000_00), lastYear.End().Add(-time.Hour))
+		require.NoError(t, err)
+		err = receive(t, service, isa.Id, gbp(15_000_00), time.Now())
+		require.NoError(t, err)
+
+		require.Len(t, repository.tables.subscriptions, 2)
+		require.Equal(t, lastYear, repository.tables.subscriptions[0].TaxYear)
+		require.Equal(t, thisYear, repository.tables.subscriptions[1].TaxYear)
+	})
+
+	t.Run("receipts to the investor's other ISAs can't both fit", func(t *testing.T) {
+		repository := newMemoryRepository()
+		service := deposits.NewService(repository, memoryInvestors{})
+
+		first := newTestDeposit(t, gbp(20_000_00), deposits.WrapperTypeISA)
+		err := service.Create(context.Background(), investorId, first)
+		require.NoError(t, err)
+		second := newTestDeposit(t, gbp(20_000_00), deposits.WrapperTypeCashISA)
+		err = service.Create(context.Background(), investorId, second)
+		require.NoError(t, err)
+
+		// The cash ISA's receipt lands after the ISA's checked the allowance
+		repository.interleave("SaveReceipt", func() {
+			err := receive(t, service, second.Pots[0].Accounts[0].Id, gbp(15_000_00), time.Now())
+			require.NoError(t, err)
+		})
+
+		err = receive(t, service, first.Pots[0].Accounts[0].Id, gbp(15_000_00), time.Now())
+		require.ErrorIs(t, err, deposits.ErrAnnualAllowanceExceeded)
+
+		allowance, err := service.GetISAAllowance(context.Background(), investorId, deposits.TaxYearOf(time.Now()))
+		require.NoError(t, err)
+		require.Equal(t, gbp(15_000_00), allowance.Used)
+	})
+}
+
+func TestServiceReceiveReceiptIdempotency(t *testing.T) {
+	investorId := investors.InvestorId(uuid.NewString())
+
+	repository := newMemoryRepository()
+	service := deposits.NewService(repository, memoryInvestors{})
+
+	deposit := newTestDeposit(t, gbp(100_00), deposits.WrapperTypeISA, deposits.WrapperTypeGIA)
+	err := service.Create(context.Background(), investorId, deposit)
+	require.NoError(t, err)
+	account := deposit.Pots[0].Accounts[0]
+	otherAccount := deposit.Pots[0].Accounts[1]
+
+	newKeyedReceipt := func(amount deposits.Money) *deposits.Receipt {
+		receipt, err := deposits.NewReceipt(amount)
+		require.NoError(t, err)
+		receipt.IdempotencyKey = "BANK-REF-1"
+		return receipt
+	}
+
+	original, _, err := service.ReceiveReceipt(context.Background(), account.Id, newKeyedReceipt(gbp(10_00)))
+	require.NoError(t, err)
+
+	t.Run("same key and amount returns the original receipt", func(t *testing.T) {
+		received, changes, err := service.ReceiveReceipt(context.Background(), account.Id, newKeyedReceipt(gbp(10_00)))
+		require.NoError(t, err)
+		require.Equal(t, original.Id, received.Id)
+
+		// The payment was only counted once
+		require.Empty(t, changes)
+		require.Len(t, repository.tables.receipts, 1)
+		require.Len(t, repository.tables.subscriptions, 1)
+		require.Equal(t, gbp(10_00), repository.tables.accounts[account.Id].TotalAllocatedAmount.Money)
+	})
+
+	t.Run("same key and different amount is refused", func(t *testing.T) {
+		_, _, err := service.ReceiveReceipt(context.Background(), account.Id, newKeyedReceipt(gbp(20_00)))
+		require.ErrorIs(t, err, deposits.ErrIdempotencyKeyReused)
+		require.Len(t, repository.tables.receipts, 1)
+	})
+
+	t.Run("same key for a different account is refused", func(t *testing.T) {
+		_, _, err := service.ReceiveReceipt(context.Background(), otherAccount.Id, newKeyedReceipt(gbp(10_00)))
+		require.ErrorIs(t, err, deposits.ErrIdempotencyKeyReused)
+		require.Len(t, repository.tables.receipts, 1)
+	})
+
+	t.Run("receipts without a key are always received", func(t *testing.T) {
+		for range 2 {
+			receipt, err := deposits.NewReceipt(gbp(1_00))
+			require.NoError(t, err)
+			_, _, err = service.ReceiveReceipt(context.Background(), otherAccount.Id, receipt)
+			require.NoError(t, err)
+		}
+		require.Len(t, repository.tables.receipts, 3)
+	})
+}
+
+func TestServiceReverseReceipt(t *testing.T) {
+	investorId := investors.InvestorId(uuid.NewString())
+
+	// receive creates a deposit with an account of the wrapper type, and receives a payment into it
+	receive := func(t *testing.T, wrapperType deposits.WrapperType) (*memoryRepository, *deposits.Service, *deposits.Account, *deposits.Receipt) {
+		repository := newMemoryRepository()
+		service := deposits.NewService(repository, memoryInvestors{})
+
+		deposit := newTestDeposit(t, gbp(100_00), wrapperType)
+		err := service.Create(context.Background(), investorId, deposit)
+		require.NoError(t, err)
+		account := deposit.Pots[0].Accounts[0]
+
+		receipt, err := deposits.NewReceipt(gbp(40_00))
+		require.NoError(t, err)
+		receipt, _, err = service.ReceiveReceipt(context.Background(), account.Id, receipt)
+		require.NoError(t, err)
+
+		return repository, service, account, receipt
+	}
+
+	t.Run("takes the receipt off the account", func(t *testing.T) {
+		repository, service, account, receipt := receive(t, deposits.WrapperTypeISA)
+
+		reversal, err := service.ReverseReceipt(context.Background(), receipt.Id, deposits.ReversalReasonBounced, "")
+		require.NoError(t, err)
+		require.Equal(t, receipt.Id, reversal.ReceiptId)
+		require.Equal(t, account.Id, reversal.AccountId)
+		require.Equal(t, receipt.AllocatedAmount, reversal.Amount)
+
+		require.Len(t, repository.tables.reversals, 1)
+		require.Equal(t, gbp(0), repository.tables.accounts[account.Id].TotalAllocatedAmount.Money)
+		// The bounced payment gives back the ISA allowance it used
+		require.Empty(t, repository.tables.subscriptions)
+	})
+
+	t.Run("refuses to reverse twice", func(t *testing.T) {
+		repository, service, account, receipt := receive(t, deposits.WrapperTypeGIA)
+
+		_, err := service.ReverseReceipt(context.Background(), receipt.Id, deposits.ReversalReasonBounced, "")
+		require.NoError(t, err)
+
+		_, err = service.ReverseReceipt(context.Background(), receipt.Id, deposits.ReversalReasonRecalled, "")
+		require.ErrorIs(t, err, deposits.ErrReceiptAlreadyReversed)
+		require.Len(t, repository.tables.reversals, 1)
+		require.Equal(t, gbp(0), repository.tables.accounts[account.Id].TotalAllocatedAmount.Money)
+	})
+
+	t.Run("repeat with the same key returns the original reversal", func(t *testing.T) {
+		repository, service, account, receipt := receive(t, deposits.WrapperTypeGIA)
+
+		original, err := service.ReverseReceipt(context.Background(), receipt.Id, deposits.ReversalReasonBounced, "BOUNCE-1")
+		require.NoError(t, err)
+
+		repeat, err := service.ReverseReceipt(context.Background(), receipt.Id, deposits.ReversalReasonBounced, "BOUNCE-1")
+		require.NoError(t, err)
+		require.Equal(t, original.Id, repeat.Id)
+		require.Len(t, repository.tables.reversals, 1)
+		require.Equal(t, gbp(0), repository.tables.accounts[account.Id].TotalAllocatedAmount.Money)
+
+		// The key can't be used for another receipt
+		other, err := deposits.NewReceipt(gbp(1_00))
+		require.NoError(t, err)
+		other, _, err = service.ReceiveReceipt(context.Background(), account.Id, other)
+		require.NoError(t, err)
+		_, err = service.ReverseReceipt(context.Background(), other.Id, deposits.ReversalReasonBounced, "BOUNCE-1")
+		require.ErrorIs(t, err, deposits.ErrIdempotencyKeyReused)
+	})
+
+	t.Run("cancels pending relief", func(t *testing.T) {
+		repository, service, account, receipt := receive(t, deposits.WrapperTypeSIPP)
+
+		_, err := service.ReverseReceipt(context.Background(), receipt.Id, deposits.ReversalReasonRecalled, "")
+		require.NoError(t, err)
+
+		stored := repository.tables.accounts[account.Id]
+		require.Equal(t, gbp(0), stored.TotalAllocatedAmount.Money)
+		require.Equal(t, gbp(0), stored.PendingReliefAmount.Money)
+		for _, claim := range repository.tables.reliefClaims {
+			require.Equal(t, deposits.ReliefClaimStatusCancelled, claim.Status)
+		}
+	})
+
+	t.Run("refuses once relief has been claimed", func(t *testing.T) {
+		repository, service, account, receipt := receive(t, deposits.WrapperTypeSIPP)
+		for id, claim := range repository.tables.reliefClaims {
+			claim.Status = deposits.ReliefClaimStatusClaimed
+			repository.tables.reliefClaims[id] = claim
+		}
+
+		_, err := service.ReverseReceipt(context.Background(), receipt.Id, deposits.ReversalReasonRecalled, "")
+		require.ErrorIs(t, err, deposits.ErrReliefAlreadyClaimed)
+		require.Empty(t, repository.tables.reversals)
+		require.Equal(t, gbp(40_00), repository.tables.accounts[account.Id].TotalAllocatedAmount.Money)
+	})
+
+	t.Run("rolls back when saving the reversal fails", func(t *testing.T) {
+		repository, service, account, receipt := receive(t, deposits.WrapperTypeISA)
+		repository.failOn("SaveReversal")
+
+		_, err := service.ReverseReceipt(context.Background(), receipt.Id, deposits.ReversalReasonBounced, "")
+		require.ErrorIs(t, err, errInjected)
+		require.Len(t, repository.tables.subscriptions, 1)
+		require.Equal(t, gbp(40_00), repository.tables.accounts[account.Id].TotalAllocatedAmount.Money)
+	})
+
+	t.Run("unknown receipt", func(t *testing.T) {
+		_, service, _, _ := receive(t, deposits.WrapperTypeGIA)
+
+		_, err := service.ReverseReceipt(context.Background(), deposits.ReceiptId(uuid.NewString()), deposits.ReversalReasonBounced, "")
+		require.ErrorIs(t, err, deposits.ErrReceiptNotFound)
+	})
+}
+
+func TestServiceReceiveDepositReceipt(t *testing.T) {
+	investorId := investors.InvestorId(uuid.NewString())
+
+	// create saves a deposit with an ISA, SIPP and GIA in one pot
+	create := func(t *testing.T) (*memoryRepository, *deposits.Service, *deposits.Deposit) {
+		repository := newMemoryRepository()
+		service := deposits.NewService(repository, memoryInvestors{})
+
+		deposit := newTestDeposit(t, gbp(100_00), deposits.WrapperTypeISA, deposits.WrapperTypeSIPP, deposits.WrapperTypeGIA)
+		err := service.Create(context.Background(), investorId, deposit)
+		require.NoError(t, err)
+
+		return repository, service, deposit
+	}
+
+	t.Run("receives a child receipt for each account", func(t *testing.T) {
+		repository, service, deposit := create(t)
+		isa, sipp, gia := deposit.Pots[0].Accounts[0], deposit.Pots[0].Accounts[1], deposit.Pots[0].Accounts[2]
+
+		depositReceipt, err := deposits.NewDepositReceipt(deposit.Id, gbp(250_00))
+		require.NoError(t, err)
+		received, err := service.ReceiveDepositReceipt(context.Background(), depositReceipt, deposits.WaterfallAllocation{Order: deposits.DefaultWaterfallOrder})
+		require.NoError(t, err)
+
+		require.Len(t, received.Receipts, 3)
+		require.Len(t, repository.tables.receipts, 3)
+		require.Len(t, repository.tables.depositReceipts, 1)
+		for _, receipt := range received.Receipts {
+			require.Equal(t, received.Id, repository.tables.receipts[receipt.Id].DepositReceiptId)
+		}
+
+		require.Equal(t, gbp(100_00), repository.tables.accounts[isa.Id].TotalAllocatedAmount.Money)
+		require.Equal(t, gbp(80_00), repository.tables.accounts[sipp.Id].TotalAllocatedAmount.Money)
+		require.Equal(t, gbp(20_00), repository.tables.accounts[sipp.Id].PendingReliefAmount.Money)
+		require.Equal(t, gbp(70_00), repository.tables.accounts[gia.Id].TotalAllocatedAmount.Money)
+		require.Len(t, repository.tables.subscriptions, 1)
+		require.Len(t, repository.tables.reliefClaims, 1)
+	})
+
+	t.Run("waterfalls past the ISA once the allowance is used", func(t *testing.T) {
+		repository, service, deposit := create(t)
+		isa, sipp, gia := deposit.Pots[0].Accounts[0], deposit.Pots[0].Accounts[1], deposit.Pots[0].Accounts[2]
+
+		// Another ISA has used all but 40.00 of the allowance
+		repository.tables.subscriptions = append(repository.tables.subscriptions, deposits.ISASubscription{
+			ReceiptId:  deposits.ReceiptId(uuid.NewString()),
+			InvestorId: investorId,
+			AccountId:  deposits.AccountId(uuid.NewString()),
+			TaxYear:    deposits.TaxYearOf(time.Now()),
+			Amount:     deposits.AllocatedAmount{Money: gbp(19_960_00)},
+		})
+
+		depositReceipt, err := deposits.NewDepositReceipt(deposit.Id, gbp(250_00))
+		require.NoError(t, err)
+		_, err = service.ReceiveDepositReceipt(context.Background(), depositReceipt, deposits.WaterfallAllocation{Order: deposits.DefaultWaterfallOrder})
+		require.NoError(t, err)
+
+		require.Equal(t, gbp(40_00), repository.tables.accounts[isa.Id].TotalAllocatedAmount.Money)
+		require.Equal(t, gbp(80_00), repository.tables.accounts[sipp.Id].TotalAllocatedAmount.Money)
+		require.Equal(t, gbp(130_00), repository.tables.accounts[gia.Id].TotalAllocatedAmount.Money)
+	})
+
+	t.Run("uses the allowance of the tax year it was received in", func(t *testing.T) {
+		repository, service, deposit := create(t)
+		isa := deposit.Pots[0].Accounts[0]
+
+		// This tax year's allowance is used up, but the payment arrived before it started
+		thisYear := deposits.TaxYearOf(time.Now())
+		lastYear := thisYear - 1
+		repository.tables.subscriptions = append(repository.tables.subscriptions, deposits.ISASubscription{
+			ReceiptId:  deposits.ReceiptId(uuid.NewString()),
+			InvestorId: investorId,
+			AccountId:  deposits.AccountId(uuid.NewString()),
+			TaxYear:    thisYear,
+			Amount:     deposits.AllocatedAmount{Money: deposits.ISAAnnualAllowance},
+		})
+
+		depositReceipt, err := deposits.NewDepositReceipt(deposit.Id, gbp(250_00))
+		require.NoError(t, err)
+		depositReceipt.ReceivedAt = lastYear.End().Add(-time.Hour)
+		received, err := service.ReceiveDepositReceipt(context.Background(), depositReceipt, deposits.WaterfallAllocation{Order: deposits.DefaultWaterfallOrder})
+		require.NoError(t, err)
+
+		require.Equal(t, gbp(100_00), repository.tables.accounts[isa.Id].TotalAllocatedAmount.Money)
+		require.Equal(t, lastYear, repository.tables.subscriptions[1].TaxYear)
+		for _, receipt := range received.Receipts {
+			require.Equal(t, depositReceipt.ReceivedAt, receipt.ReceivedAt)
+		}
+	})
+
+	t.Run("rolls back every account when one fails", func(t *testing.T) {
+		repository, service, deposit := create(t)
+		repository.failOn("SaveReliefClaim")
+
+		depositReceipt, err := deposits.NewDepositReceipt(deposit.Id, gbp(250_00))
+		require.NoError(t, err)
+		_, err = service.ReceiveDepositReceipt(context.Background(), depositReceipt, deposits.WaterfallAllocation{Order: deposits.DefaultWaterfallOrder})
+		require.ErrorIs(t, err, errInjected)
+
+		require.Empty(t, repository.tables.receipts)
+		require.Empty(t, repository.tables.depositReceipts)
+		require.Empty(t, repository.tables.subscriptions)
+		for _, account := range repository.tables.accounts {
+			require.Equal(t, gbp(0), account.TotalAllocatedAmount.Money)
+		}
+	})
+
+	t.Run("refuses receipts the accounts can't take", func(t *testing.T) {
+		repository, service, deposit := create(t)
+
+		depositReceipt, err := deposits.NewDepositReceipt(deposit.Id, gbp(100_01))
+		require.NoError(t, err)
+		_, err = service.ReceiveDepositReceipt(context.Background(), depositReceipt, deposits.WaterfallAllocation{Order: []deposits.WrapperType{deposits.WrapperTypeISA}})
+		require.ErrorIs(t, err, deposits.ErrAllocationExceedsNominal)
+		require.Empty(t, repository.tables.receipts)
+	})
+
+	t.Run("repeat with the same key returns the original", func(t *testing.T) {
+		repository, service, deposit := create(t)
+
+		depositReceipt, err := deposits.NewDepositReceipt(deposit.Id, gbp(90_00))
+		require.NoError(t, err)
+		depositReceipt.IdempotencyKey = "BANK-REF-1"
+		original, err := service.ReceiveDepositReceipt(context.Background(), depositReceipt, deposits.ProportionalAllocation{})
+		require.NoError(t, err)
+
+		repeat, err := deposits.NewDepositReceipt(deposit.Id, gbp(90_00))
+		require.NoError(t, err)
+		repeat.IdempotencyKey = "BANK-REF-1"
+		received, err := service.ReceiveDepositReceipt(context.Background(), repeat, deposits.ProportionalAllocation{})
+		require.NoError(t, err)
+		require.Equal(t, original.Id, received.Id)
+		require.Len(t, received.Receipts, 3)
+		require.Len(t, repository.tables.receipts, 3)
+
+		different, err := deposits.NewDepositReceipt(deposit.Id, gbp(1_00))
+		require.NoError(t, err)
+		different.IdempotencyKey = "BANK-REF-1"
+		_, err = service.ReceiveDepositReceipt(context.Background(), different, deposits.ProportionalAllocation{})
+		require.ErrorIs(t, err, deposits.ErrIdempotencyKeyReused)
+	})
+}
+
+func TestServiceReceiveReceiptWithOverflow(t *testing.T) {
+	investorId := investors.InvestorId(uuid.NewString())
+
+	// create saves a deposit with one pot of the wrapper types
+	create := func(t *testing.T, wrapperTypes ...deposits.WrapperType) (*memoryRepository, *deposits.Service, *deposits.Deposit) {
+		repository := newMemoryRepository()
+		service := deposits.NewService(repository, memoryInvestors{})
+
+		deposit := newTestDeposit(t, gbp(100_00), wrapperTypes...)
+		err := service.Create(context.Background(), investorId, deposit)
+		require.NoError(t, err)
+
+		return repository, service, deposit
+	}
+
+	t.Run("overflows to the pot's GIA", func(t *testing.T) {
+		repository, service, deposit := create(t, deposits.WrapperTypeISA, deposits.WrapperTypeGIA)
+		isa, gia := deposit.Pots[0].Accounts[0], deposit.Pots[0].Accounts[1]
+
+		receipt, err := deposits.NewReceipt(gbp(150_00))
+		require.NoError(t, err)
+		received, overflow, changes, err := service.ReceiveReceiptWithOverflow(context.Background(), isa.Id, receipt)
+		require.NoError(t, err)
+
+		require.Equal(t, gbp(100_00), received.AllocatedAmount.Money)
+		require.Equal(t, gbp(50_00), overflow.AllocatedAmount.Money)
+		require.Equal(t, received.Id, overflow.OverflowOf)
+		require.Equal(t, gia.Id, overflow.AccountId)
+		require.Equal(t, gbp(100_00), repository.tables.accounts[isa.Id].TotalAllocatedAmount.Money)
+		require.Equal(t, gbp(50_00), repository.tables.accounts[gia.Id].TotalAllocatedAmount.Money)
+		require.Len(t, repository.tables.receipts, 2)
+		require.Equal(t, gbp(100_00), repository.tables.subscriptions[0].Amount.Money)
+
+		// Both accounts' changes are returned
+		require.Len(t, changes, 2)
+		require.Equal(t, isa.Id, changes[0].Before.Id)
+		require.Equal(t, gbp(0), changes[0].Before.TotalAllocatedAmount.Money)
+		require.Empty(t, changes[0].Before.Receipts)
+		require.Equal(t, gbp(100_00), changes[0].After.TotalAllocatedAmount.Money)
+		require.Equal(t, gia.Id, changes[1].Before.Id)
+		require.Equal(t, gbp(0), changes[1].Before.TotalAllocatedAmount.Money)
+		require.Equal(t, gbp(50_00), changes[1].After.TotalAllocatedAmount.Money)
+		require.Len(t, changes[1].After.Receipts, 1)
+	})
+
+	t.Run("holds overflow in suspense without a GIA", func(t *testing.T) {
+		repository, service, deposit := create(t, deposits.WrapperTypeSIPP)
+		sipp := deposit.Pots[0].Accounts[0]
+
+		receipt, err := deposits.NewReceipt(gbp(100_00))
+		require.NoError(t, err)
+		received, overflow, _, err := service.ReceiveReceiptWithOverflow(context.Background(), sipp.Id, receipt)
+		require.NoError(t, err)
+
+		// Room is left for the relief on the SIPP's part
+		require.Equal(t, gbp(80_00), received.AllocatedAmount.Money)
+		require.Equal(t, gbp(20_00), overflow.AllocatedAmount.Money)
+		require.Equal(t, received.Id, overflow.OverflowOf)
+		require.Empty(t, overflow.AccountId)
+		require.Equal(t, deposit.Id, overflow.SuspenseDepositId)
+		require.Equal(t, *overflow, repository.tables.receipts[overflow.Id])
+
+		// Overflow's only reversed with the receipt it split from
+		_, err = service.ReverseReceipt(context.Background(), overflow.Id, deposits.ReversalReasonBounced, "")
+		require.ErrorIs(t, err, deposits.ErrReversingOverflow)
+	})
+
+	t.Run("overflows the whole receipt without headroom", func(t *testing.T) {
+		repository, service, deposit := create(t, deposits.WrapperTypeISA, deposits.WrapperTypeGIA)
+		isa, gia := deposit.Pots[0].Accounts[0], deposit.Pots[0].Accounts[1]
+
+		full, err := deposits.NewReceipt(gbp(100_00))
+		require.NoError(t, err)
+		_, _, err = service.ReceiveReceipt(context.Background(), isa.Id, full)
+		require.NoError(t, err)
+		events := len(repository.tables.events[isa.Id])
+
+		receipt, err := deposits.NewReceipt(gbp(30_00))
+		require.NoError(t, err)
+		receipt.IdempotencyKey = "BANK-REF-1"
+		received, overflow, changes, err := service.ReceiveReceiptWithOverflow(context.Background(), isa.Id, receipt)
+		require.NoError(t, err)
+
+		// The ISA doesn't get a receipt for nothing
+		require.Nil(t, overflow)
+		require.Equal(t, gia.Id, received.AccountId)
+		require.Equal(t, gbp(30_00), received.AllocatedAmount.Money)
+		require.Len(t, repository.tables.events[isa.Id], events)
+		require.Len(t, repository.tables.receipts, 2)
+		require.Len(t, changes, 1)
+		require.Equal(t, gia.Id, changes[0].After.Id)
+
+		repeat, err := deposits.NewReceipt(gbp(30_00))
+		require.NoError(t, err)
+		repeat.IdempotencyKey = "BANK-REF-1"
+		repeated, _, _, err := service.ReceiveReceiptWithOverflow(context.Background(), isa.Id, repeat)
+		require.NoError(t, err)
+		require.Equal(t, received.Id, repeated.Id)
+		require.Len(t, repository.tables.receipts, 2)
+	})
+
+	t.Run("reverses the overflow with its receipt", func(t *testing.T) {
+		repository, service, deposit := create(t, deposits.WrapperTypeISA, deposits.WrapperTypeGIA)
+		isa, gia := deposit.Pots[0].Accounts[0], deposit.Pots[0].Accounts[1]
+
+		receipt, err := deposits.NewReceipt(gbp(150_00))
+		require.NoError(t, err)
+		received, overflow, _, err := service.ReceiveReceiptWithOverflow(context.Background(), isa.Id, receipt)
+		require.NoError(t, err)
+		_, err = service.ReverseReceipt(context.Background(), received.Id, deposits.ReversalReasonBounced, "")
+		require.NoError(t, err)
+
+		reversal, err := repository.GetReversalByReceiptId(context.Background(), overflow.Id)
+		require.NoError(t, err)
+		require.Equal(t, gia.Id, reversal.AccountId)
+		require.Equal(t, gbp(0), repository.tables.accounts[isa.Id].TotalAllocatedAmount.Money)
+		require.Equal(t, gbp(0), repository.tables.accounts[gia.Id].TotalAllocatedAmount.Money)
+		for account, balance := range repository.ledgerBalances() {
+			require.Zero(t, balance, account)
+		}
+	})
+
+	t.Run("reverses overflow held in suspense with its receipt", func(t *testing.T) {
+		repository, service, deposit := create(t, deposits.WrapperTypeISA)
+		isa := deposit.Pots[0].Accounts[0]
+
+		receipt, err := deposits.NewReceipt(gbp(150_00))
+		require.NoError(t, err)
+		received, overflow, _, err := service.ReceiveReceiptWithOverflow(context.Background(), isa.Id, receipt)
+		require.NoError(t, err)
+		_, err = service.ReverseReceipt(context.Background(), received.Id, deposits.ReversalReasonBounced, "")
+		require.NoError(t, err)
+
+		reversal, err := repository.GetReversalByReceiptId(context.Background(), overflow.Id)
+		require.NoError(t, err)
+		require.Empty(t, reversal.AccountId)
+		require.Equal(t, gbp(0), repository.tables.receipts[overflow.Id].UnallocatedAmount.Money)
+		for account, balance := range repository.ledgerBalances() {
+			require.Zero(t, balance, account)
+		}
+	})
+
+	t.Run("can't reverse once overflow in suspense is allocated", func(t *testing.T) {
+		repository, service, deposit := create(t, deposits.WrapperTypeISA)
+		isa := deposit.Pots[0].Accounts[0]
+		other := newTestDeposit(t, gbp(100_00), deposits.WrapperTypeGIA)
+		require.NoError(t, service.Create(context.Background(), investorId, other))
+
+		receipt, err := deposits.NewReceipt(gbp(150_00))
+		require.NoError(t, err)
+		received, overflow, _, err := service.ReceiveReceiptWithOverflow(context.Background(), isa.Id, receipt)
+		require.NoError(t, err)
+		_, err = service.AllocateUnallocatedReceipt(context.Background(), overflow.Id, other.Pots[0].Accounts[0].Id, gbp(10_00), "ops@example.com", "")
+		require.NoError(t, err)
+
+		_, err = service.ReverseReceipt(context.Background(), received.Id, deposits.ReversalReasonBounced, "")
+		require.ErrorIs(t, err, deposits.ErrUnallocatedReceiptAllocated)
+		require.Empty(t, repository.tables.reversals)
+		require.Equal(t, gbp(100_00), repository.tables.accounts[isa.Id].TotalAllocatedAmount.Money)
+	})
+
+	t.Run("doesn't overflow receipts that fit", func(t *testing.T) {
+		repository, service, deposit := create(t, deposits.WrapperTypeISA, deposits.WrapperTypeGIA)
+		isa := deposit.Pots[0].Accounts[0]
+
+		receipt, err := deposits.NewReceipt(gbp(100_00))
+		require.NoError(t, err)
+		received, overflow, _, err := service.ReceiveReceiptWithOverflow(context.Background(), isa.Id, receipt)
+		require.NoError(t, err)
+
+		require.Nil(t, overflow)
+		require.Equal(t, gbp(100_00), received.AllocatedAmount.Money)
+		require.Len(t, repository.tables.receipts, 1)
+	})
+
+	t.Run("rolls back both receipts when one fails", func(t *testing.T) {
+		repository, service, deposit := create(t, deposits.WrapperTypeSIPP, deposits.WrapperTypeGIA)
+		sipp := deposit.Pots[0].Accounts[0]
+		repository.failOn("SaveReliefClaim")
+
+		receipt, err := deposits.NewReceipt(gbp(150_00))
+		require.NoError(t, err)
+		_, _, _, err = service.ReceiveReceiptWithOverflow(context.Background(), sipp.Id, receipt)
+		require.ErrorIs(t, err, errInjected)
+
+		require.Empty(t, repository.tables.receipts)
+		for _, account := range repository.tables.accounts {
+			require.Equal(t, gbp(0), account.TotalAllocatedAmount.Money)
+		}
+	})
+
+	t.Run("repeat with the same key returns the original split", func(t *testing.T) {
+		repository, service, deposit := create(t, deposits.WrapperTypeISA, deposits.WrapperTypeGIA)
+		isa := deposit.Pots[0].Accounts[0]
+
+		receipt, err := deposits.NewReceipt(gbp(150_00))
+		require.NoError(t, err)
+		receipt.IdempotencyKey = "BANK-REF-1"
+		original, originalOverflow, _, err := service.ReceiveReceiptWithOverflow(context.Background(), isa.Id, receipt)
+		require.NoError(t, err)
+
+		repeat, err := deposits.NewReceipt(gbp(150_00))
+		require.NoError(t, err)
+		repeat.IdempotencyKey = "BANK-REF-1"
+		received, overflow, _, err := service.ReceiveReceiptWithOverflow(context.Background(), isa.Id, repeat)
+		require.NoError(t, err)
+		require.Equal(t, original.Id, received.Id)
+		require.Equal(t, originalOverflow.Id, overflow.Id)
+		require.Len(t, repository.tables.receipts, 2)
+
+		different, err := deposits.NewReceipt(gbp(100_00))
+		require.NoError(t, err)
+		different.IdempotencyKey = "BANK-REF-1"
+		_, _, _, err = service.ReceiveReceiptWithOverflow(context.Background(), isa.Id, different)
+		require.ErrorIs(t, err, deposits.ErrIdempotencyKeyReused)
+	})
+}
+
+func TestServiceUnallocatedReceipts(t *testing.T) {
+	investorId := investors.InvestorId(uuid.NewString())
+
+	// create saves a deposit with an ISA and receives an unallocated receipt
+	create := func(t *testing.T) (*memoryRepository, *deposits.Service, *deposits.Account, *deposits.Receipt) {
+		repository := newMemoryRepository()
+		service := deposits.NewService(repository, memoryInvestors{})
+
+		deposit := newTestDeposit(t, gbp(100_00), deposits.WrapperTypeISA)
+		err := service.Create(context.Background(), investorId, deposit)
+		require.NoError(t, err)
+
+		receipt, err := deposits.NewUnallocatedReceipt(gbp(150_00), "UNKNOWN-REF")
+		require.NoError(t, err)
+		received, err := service.ReceiveUnallocatedReceipt(context.Background(), receipt)
+		require.NoError(t, err)
+
+		return repository, service, deposit.Pots[0].Accounts[0], received
+	}
+
+	t.Run("lists receipts with cash to allocate", func(t *testing.T) {
+		_, service, _, unallocated := create(t)
+
+		receipts, err := service.ListUnallocatedReceipts(context.Background(), "")
+		require.NoError(t, err)
+		require.Len(t, receipts, 1)
+		require.Equal(t, unallocated.Id, receipts[0].Id)
+		require.Equal(t, "UNKNOWN-REF", receipts[0].AccountReference)
+		require.Equal(t, gbp(150_00), receipts[0].UnallocatedAmount.Money)
+
+		// Only the deposit's suspense is listed for a deposit
+		receipts, err = service.ListUnallocatedReceipts(context.Background(), deposits.DepositId(uuid.NewString()))
+		require.NoError(t, err)
+		require.Empty(t, receipts)
+	})
+
+	t.Run("allocates in parts with an audit trail", func(t *testing.T) {
+		repository, service, account, unallocated := create(t)
+
+		first, err := service.AllocateUnallocatedReceipt(context.Background(), unallocated.Id, account.Id, gbp(60_00), "ops@example.com", "")
+		require.NoError(t, err)
+		second, err := service.AllocateUnallocatedReceipt(context.Background(), unallocated.Id, account.Id, gbp(40_00), "ops@example.com", "")
+		require.NoError(t, err)
+
+		require.Equal(t, gbp(100_00), repository.tables.accounts[account.Id].TotalAllocatedAmount.Money)
+		require.Equal(t, gbp(50_00), repository.tables.receipts[unallocated.Id].UnallocatedAmount.Money)
+		require.Equal(t, unallocated.Id, repository.tables.receipts[first.ReceiptId].AllocatedFrom)
+		require.Equal(t, account.Id, repository.tables.receipts[second.ReceiptId].AccountId)
+		require.Len(t, repository.tables.subscriptions, 2)
+
+		allocations, err := service.ListSuspenseAllocations(context.Background(), unallocated.Id)
+		require.NoError(t, err)
+		require.Equal(t, []*deposits.SuspenseAllocation{first, second}, allocations)
+		require.Equal(t, "ops@example.com", allocations[0].AllocatedBy)
+	})
+
+	t.Run("allocations with a key are only made once", func(t *testing.T) {
+		repository, service, account, unallocated := create(t)
+
+		original, err := service.AllocateUnallocatedReceipt(context.Background(), unallocated.Id, account.Id, gbp(60_00), "ops@example.com", "allocation-1")
+		require.NoError(t, err)
+		require.Equal(t, deposits.IdempotencyKey("allocation-1"), original.IdempotencyKey)
+
+		// A retry returns the original allocation
+		repeat, err := service.AllocateUnallocatedReceipt(context.Background(), unallocated.Id, account.Id, gbp(60_00), "ops@example.com", "allocation-1")
+		require.NoError(t, err)
+		require.Equal(t, original, repeat)
+		require.Len(t, repository.tables.suspenseAllocations, 1)
+		require.Equal(t, gbp(90_00), repository.tables.receipts[unallocated.Id].UnallocatedAmount.Money)
+		require.Equal(t, gbp(60_00), repository.tables.accounts[account.Id].TotalAllocatedAmount.Money)
+
+		// The key can't be used for a different allocation
+		_, err = service.AllocateUnallocatedReceipt(context.Background(), unallocated.Id, account.Id, gbp(30_00), "ops@example.com", "allocation-1")
+		require.ErrorIs(t, err, deposits.ErrIdempotencyKeyReused)
+		require.Len(t, repository.tables.suspenseAllocations, 1)
+	})
+
+	t.Run("validates the account takes the allocation", func(t *testing.T) {
+		repository, service, account, unallocated := create(t)
+
+		_, err := service.AllocateUnallocatedReceipt(context.Background(), unallocated.Id, account.Id, gbp(100_01), "ops@example.com", "")
+		require.ErrorIs(t, err, deposits.ErrNominalExceeded)
+
+		require.Equal(t, gbp(150_00), repository.tables.receipts[unallocated.Id].UnallocatedAmount.Money)
+		require.Len(t, repository.tables.receipts, 1)
+		require.Empty(t, repository.tables.suspenseAllocations)
+	})
+
+	t.Run("rolls back when the audit can't be saved", func(t *testing.T) {
+		repository, service, account, unallocated := create(t)
+		repository.failOn("SaveSuspenseAllocation")
+
+		_, err := service.AllocateUnallocatedReceipt(context.Background(), unallocated.Id, account.Id, gbp(10_00), "ops@example.com", "")
+		require.ErrorIs(t, err, errInjected)
+
+		require.Equal(t, gbp(150_00), repository.tables.receipts[unallocated.Id].UnallocatedAmount.Money)
+		require.Equal(t, gbp(0), repository.tables.accounts[account.Id].TotalAllocatedAmount.Money)
+	})
+
+	t.Run("can't allocate more than is left", func(t *testing.T) {
+		_, service, account, unallocated := create(t)
+
+		_, err := service.AllocateUnallocatedReceipt(context.Background(), unallocated.Id, account.Id, gbp(150_01), "ops@example.com", "")
+		require.ErrorIs(t, err, deposits.ErrAllocationExceedsUnallocated)
+	})
+
+	t.Run("can't allocate receipts on an account", func(t *testing.T) {
+		repository, service, account, _ := create(t)
+
+		receipt, err := deposits.NewReceipt(gbp(10_00))
+		require.NoError(t, err)
+		_, _, err = service.ReceiveReceipt(context.Background(), account.Id, receipt)
+		require.NoError(t, err)
+
+		_, err = service.AllocateUnallocatedReceipt(context.Background(), receipt.Id, account.Id, gbp(10_00), "ops@example.com", "")
+		require.ErrorIs(t, err, deposits.ErrReceiptAlreadyAllocated)
+		require.Equal(t, gbp(10_00), repository.tables.accounts[account.Id].TotalAllocatedAmount.Money)
+	})
+
+	t.Run("lists overflow held in suspense", func(t *testing.T) {
+		repository := newMemoryRepository()
+		service := deposits.NewService(repository, memoryInvestors{})
+		deposit := newTestDeposit(t, gbp(100_00), deposits.WrapperTypeISA)
+		err := service.Create(context.Background(), investorId, deposit)
+		require.NoError(t, err)
+
+		receipt, err := deposits.NewReceipt(gbp(120_00))
+		require.NoError(t, err)
+		_, overflow, _, err := service.ReceiveReceiptWithOverflow(context.Background(), deposit.Pots[0].Accounts[0].Id, receipt)
+		require.NoError(t, err)
+
+		receipts, err := service.ListUnallocatedReceipts(context.Background(), deposit.Id)
+		require.NoError(t, err)
+		require.Len(t, receipts, 1)
+		require.Equal(t, overflow.Id, receipts[0].Id)
+		require.Equal(t, gbp(20_00), receipts[0].UnallocatedAmount.Money)
+	})
+
+	t.Run("repeat with the same key returns the original", func(t *testing.T) {
+		repository := newMemoryRepository()
+		service := deposits.NewService(repository, memoryInvestors{})
+
+		receipt, err := deposits.NewUnallocatedReceipt(gbp(10_00), "UNKNOWN-REF")
+		require.NoError(t, err)
+		receipt.IdempotencyKey = "BANK-REF-1"
+		original, err := service.ReceiveUnallocatedReceipt(context.Background(), receipt)
+		require.NoError(t, err)
+
+		repeat, err := deposits.NewUnallocatedReceipt(gbp(10_00), "UNKNOWN-REF")
+		require.NoError(t, err)
+		repeat.IdempotencyKey = "BANK-REF-1"
+		received, err := service.ReceiveUnallocatedReceipt(context.Background(), repeat)
+		require.NoError(t, err)
+		require.Equal(t, original.Id, received.Id)
+		require.Len(t, repository.tables.receipts, 1)
+	})
+}
+
+func TestServiceDepositLifecycle(t *testing.T) {
+	investorId := investors.InvestorId(uuid.NewString())
+
+	// create saves a deposit with an ISA and a GIA
+	create := func(t *testing.T) (*memoryRepository, *deposits.Service, *deposits.Deposit) {
+		repository := newMemoryRepository()
+		service := deposits.NewService(repository, memoryInvestors{})
+
+		deposit := newTestDeposit(t, gbp(100_00), deposits.WrapperTypeISA, deposits.WrapperTypeGIA)
+		err := service.Create(context.Background(), investorId, deposit)
+		require.NoError(t, err)
+
+		return repository, service, deposit
+	}
+
+	// receive receives the amount on the account
+	receive := func(t *testing.T, service *deposits.Service, accountId deposits.AccountId, amount deposits.Money) error {
+		receipt, err := deposits.NewReceipt(amount)
+		require.NoError(t, err)
+		_, _, err = service.ReceiveReceipt(context.Background(), accountId, receipt)
+		return err
+	}
+
+	t.Run("create opens the deposit", func(t *testing.T) {
+		repository, _, deposit := create(t)
+		require.Equal(t, deposits.DepositStatusOpen, deposit.Status)
+		require.Equal(t, deposits.DepositStatusOpen, repository.tables.deposits[deposit.Id].status)
+	})
+
+	t.Run("receipts fund the deposit", func(t *testing.T) {
+		repository, service, deposit := create(t)
+		isa, gia := deposit.Pots[0].Accounts[0], deposit.Pots[0].Accounts[1]
+
+		require.NoError(t, receive(t, service, isa.Id, gbp(100_00)))
+		require.Equal(t, deposits.DepositStatusPartiallyFunded, repository.tables.deposits[deposit.Id].status)
+
+		require.NoError(t, receive(t, service, gia.Id, gbp(100_00)))
+		require.Equal(t, deposits.DepositStatusFunded, repository.tables.deposits[deposit.Id].status)
+	})
+
+	t.Run("funding only reads the accounts' balances", func(t *testing.T) {
+		repository, service, deposit := create(t)
+		isa := deposit.Pots[0].Accounts[0]
+
+		// Reading every receipt gets slower the more the deposit receives
+		repository.failOn("GetFullDeposit")
+		require.NoError(t, receive(t, service, isa.Id, gbp(100_00)))
+		require.Equal(t, deposits.DepositStatusPartiallyFunded, repository.tables.deposits[deposit.Id].status)
+	})
+
+	t.Run("reversals unfund the deposit", func(t *testing.T) {
+		repository, service, deposit := create(t)
+		isa := deposit.Pots[0].Accounts[0]
+
+		receipt, err := deposits.NewReceipt(gbp(10_00))
+		require.NoError(t, err)
+		_, _, err = service.ReceiveReceipt(context.Background(), isa.Id, receipt)
+		require.NoError(t, err)
+		_, err = service.ReverseReceipt(context.Background(), receipt.Id, deposits.ReversalReasonBounced, "")
+		require.NoError(t, err)
+
+		require.Equal(t, deposits.DepositStatusOpen, repository.tables.deposits[deposit.Id].status)
+	})
+
+	t.Run("closed deposits reject receipts", func(t *testing.T) {
+		repository, service, deposit := create(t)
+		isa := deposit.Pots[0].Accounts[0]
+
+		require.NoError(t, receive(t, service, isa.Id, gbp(10_00)))
+		_, closed, err := service.CloseDeposit(context.Background(), deposit.Id)
+		require.NoError(t, err)
+		require.Equal(t, deposits.DepositStatusClosed, closed.Status)
+
+		err = receive(t, service, isa.Id, gbp(10_00))
+		require.ErrorIs(t, err, deposits.ErrDepositClosed)
+		require.Equal(t, gbp(10_00), repository.tables.accounts[isa.Id].TotalAllocatedAmount.Money)
+	})
+
+	t.Run("cancelled deposits reject receipts", func(t *testing.T) {
+		repository, service, deposit := create(t)
+		isa := deposit.Pots[0].Accounts[0]
+
+		_, _, err := service.CancelDeposit(context.Background(), deposit.Id)
+		require.NoError(t, err)
+		require.Equal(t, deposits.DepositStatusCancelled, repository.tables.deposits[deposit.Id].status)
+
+		err = receive(t, service, isa.Id, gbp(10_00))
+		require.ErrorIs(t, err, deposits.ErrDepositClosed)
+
+		depositReceipt, err := deposits.NewDepositReceipt(deposit.Id, gbp(10_00))
+		require.NoError(t, err)
+		_, err = service.ReceiveDepositReceipt(context.Background(), depositReceipt, deposits.ProportionalAllocation{})
+		require.ErrorIs(t, err, deposits.ErrDepositClosed)
+		require.Empty(t, repository.tables.receipts)
+	})
+
+	t.Run("deposits closed while a receipt's in flight reject it", func(t *testing.T) {
+		repository, service, deposit := create(t)
+		isa, gia := deposit.Pots[0].Accounts[0], deposit.Pots[0].Accounts[1]
+		require.NoError(t, receive(t, service, gia.Id, gbp(10_00)))
+
+		// The deposit's closed after the receipt checked it was receiving
+		repository.interleave("SaveReceipt", func() {
+			_, _, err := service.CloseDeposit(context.Background(), deposit.Id)
+			require.NoError(t, err)
+		})
+
+		err := receive(t, service, isa.Id, gbp(10_00))
+		require.ErrorIs(t, err, deposits.ErrDepositClosed)
+		require.Equal(t, deposits.DepositStatusClosed, repository.tables.deposits[deposit.Id].status)
+		require.True(t, repository.tables.accounts[isa.Id].TotalAllocatedAmount.IsZero())
+	})
+
+	t.Run("can't cancel deposits that have received", func(t *testing.T) {
+		repository, service, deposit := create(t)
+
+		require.NoError(t, receive(t, service, deposit.Pots[0].Accounts[0].Id, gbp(10_00)))
+		_, _, err := service.CancelDeposit(context.Background(), deposit.Id)
+		require.ErrorIs(t, err, deposits.ErrInvalidDepositTransition)
+		require.Equal(t, deposits.DepositStatusPartiallyFunded, repository.tables.deposits[deposit.Id].status)
+	})
+}
+
+func TestServiceUpdateDeposit(t *testing.T) {
+	investorId := investors.InvestorId(uuid.NewString())
+
+	// create saves a deposit with an ISA and a GIA
+	create := func(t *testing.T) (*memoryRepository, *deposits.Service, *deposits.Deposit) {
+		repository := newMemoryRepository()
+		service := deposits.NewService(repository, memoryInvestors{})
+
+		deposit := newTestDeposit(t, gbp(100_00), deposits.WrapperTypeISA, deposits.WrapperTypeGIA)
+		err := service.Create(context.Background(), investorId, deposit)
+		require.NoError(t, err)
+
+		return repository, service, deposit
+	}
+
+	// receive receives the amount on the account
+	receive := func(t *testing.T, service *deposits.Service, accountId deposits.AccountId, amount deposits.Money) {
+		receipt, err := deposits.NewReceipt(amount)
+		require.NoError(t, err)
+		_, _, err = service.ReceiveReceipt(context.Background(), accountId, receipt)
+		require.NoError(t, err)
+	}
+
+	t.Run("amendments are saved", func(t *testing.T) {
+		repository, service, deposit := create(t)
+		pot, isa, gia := deposit.Pots[0], deposit.Pots[0].Accounts[0], deposit.Pots[0].Accounts[1]
+
+		newPot, err := deposits.NewPot("Pot B")
+		require.NoError(t, err)
+		newPotAccount, err := deposits.NewAccount(deposits.WrapperTypeGIA, gbp(50_00))
+		require.NoError(t, err)
+		require.NoError(t, newPot.AddAccount(newPotAccount))
+		sipp, err := deposits.NewAccount(deposits.WrapperTypeSIPP, gbp(20_00))
+		require.NoError(t, err)
+
+		_, updated, err := service.UpdateDeposit(context.Background(), deposit.Id, []deposits.Amendment{
+			deposits.AddPotAmendment{Pot: newPot},
+			deposits.RenamePotAmendment{PotId: pot.Id, Name: "Renamed"},
+			deposits.AddAccountAmendment{PotId: pot.Id, Account: sipp},
+			deposits.ChangeNominalAmendment{AccountId: isa.Id, NominalAmount: gbp(200_00)},
+			deposits.RemoveAccountAmendment{AccountId: gia.Id},
+		})
+		require.NoError(t, err)
+		require.Len(t, updated.Pots, 2)
+
+		stored, err := repository.GetFullDeposit(context.Background(), deposit.Id)
+		require.NoError(t, err)
+		require.Equal(t, deposits.PotName("Renamed"), stored.Pots[0].Name)
+		require.Equal(t, []deposits.AccountId{isa.Id, sipp.Id}, []deposits.AccountId{stored.Pots[0].Accounts[0].Id, stored.Pots[0].Accounts[1].Id})
+		require.Equal(t, gbp(200_00), stored.Pots[0].Accounts[0].NominalAmount.Money)
+		require.Equal(t, newPotAccount.Id, stored.Pots[1].Accounts[0].Id)
+
+		// The removed account's stream is kept, ending with its removal
+		events := repository.tables.events[gia.Id]
+		require.Equal(t, deposits.AccountRemoved{}, events[len(events)-1].Event)
+		_, err = repository.GetAccount(context.Background(), gia.Id)
+		require.ErrorIs(t, err, deposits.ErrAccountNotFound)
+		accountIds, err := service.ListAccountIds(context.Background())
+		require.NoError(t, err)
+		require.NotContains(t, accountIds, gia.Id)
+	})
+
+	t.Run("invariants are checked", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			amendment   func(t *testing.T, deposit *deposits.Deposit) deposits.Amendment
+			expected    error
+		}{
+			{
+				description: "wrapper type already in pot",
+				amendment: func(t *testing.T, deposit *deposits.Deposit) deposits.Amendment {
+					account, err := deposits.NewAccount(deposits.WrapperTypeISA, gbp(10_00))
+					require.NoError(t, err)
+					return deposits.AddAccountAmendment{PotId: deposit.Pots[0].Id, Account: account}
+				},
+				expected: deposits.ErrWrapperTypeExistsInPot,
+			},
+			{
+				description: "unknown pot",
+				amendment: func(t *testing.T, deposit *deposits.Deposit) deposits.Amendment {
+					return deposits.RenamePotAmendment{PotId: deposits.PotId(uuid.NewString()), Name: "Renamed"}
+				},
+				expected: deposits.ErrPotNotFound,
+			},
+			{
+				description: "unknown account",
+				amendment: func(t *testing.T, deposit *deposits.Deposit) deposits.Amendment {
+					return deposits.ChangeNominalAmendment{AccountId: deposits.AccountId(uuid.NewString()), NominalAmount: gbp(10_00)}
+				},
+				expected: deposits.ErrAccountNotInPot,
+			},
+			{
+				description: "ISA nominal below allocated",
+				amendment: func(t *testing.T, deposit *deposits.Deposit) deposits.Amendment {
+					return deposits.ChangeNominalAmendment{AccountId: deposit.Pots[0].Accounts[0].Id, NominalAmount: gbp(40_00)}
+				},
+				expected: deposits.ErrNominalBelowAllocated,
+			},
+			{
+				description: "account with receipts",
+				amendment: func(t *testing.T, deposit *deposits.Deposit) deposits.Amendment {
+					return deposits.RemoveAccountAmendment{AccountId: deposit.Pots[0].Accounts[0].Id}
+				},
+				expected: deposits.ErrAccountHasReceipts,
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.description, func(t *testing.T) {
+				repository, service, deposit := create(t)
+				isa := deposit.Pots[0].Accounts[0]
+				receive(t, service, isa.Id, gbp(50_00))
+
+				// Earlier amendments are rolled back with the failing one
+				_, _, err := service.UpdateDeposit(context.Background(), deposit.Id, []deposits.Amendment{
+					deposits.RenamePotAmendment{PotId: deposit.Pots[0].Id, Name: "Renamed"},
+					testCase.amendment(t, deposit),
+				})
+				require.ErrorIs(t, err, testCase.expected)
+				require.Equal(t, deposits.PotName("Pot A"), repository.tables.pots[deposit.Pots[0].Id].pot.Name)
+				require.Len(t, repository.tables.accounts, 2)
+			})
+		}
+	})
+
+	t.Run("nominal changes update the funding status", func(t *testing.T) {
+		repository, service, deposit := create(t)
+		isa, gia := deposit.Pots[0].Accounts[0], deposit.Pots[0].Accounts[1]
+		receive(t, service, isa.Id, gbp(100_00))
+		receive(t, service, gia.Id, gbp(50_00))
+		require.Equal(t, deposits.DepositStatusPartiallyFunded, repository.tables.deposits[deposit.Id].status)
+
+		_, updated, err := service.UpdateDeposit(context.Background(), deposit.Id, []deposits.Amendment{
+			deposits.ChangeNominalAmendment{AccountId: gia.Id, NominalAmount: gbp(50_00)},
+		})
+		require.NoError(t, err)
+		require.Equal(t, deposits.DepositStatusFunded, updated.Status)
+		require.Equal(t, deposits.DepositStatusFunded, repository.tables.deposits[deposit.Id].status)
+	})
+
+	t.Run("closed deposits can't be amended", func(t *testing.T) {
+		_, service, deposit := create(t)
+		_, _, err := service.CancelDeposit(context.Background(), deposit.Id)
+		require.NoError(t, err)
+
+		_, _, err = service.UpdateDeposit(context.Background(), deposit.Id, []deposits.Amendment{
+			deposits.RenamePotAmendment{PotId: deposit.Pots[0].Id, Name: "Renamed"},
+		})
+		require.ErrorIs(t, err, deposits.ErrDepositClosed)
+	})
+}
+
+func TestServiceListDeposits(t *testing.T) {
+	investorId := investors.InvestorId(uuid.NewString())
+	repository := newMemoryRepository()
+	service := deposits.NewService(repository, memoryInvestors{})
+
+	// Three deposits for the investor, and one for someone else
+	created := []*deposits.Deposit{}
+	for range 3 {
+		deposit := newTestDeposit(t, gbp(100), deposits.WrapperTypeGIA, deposits.WrapperTypeISA)
+		require.NoError(t, service.Create(context.Background(), investorId, deposit))
+		created = append(created, deposit)
+	}
+	other := newTestDeposit(t, gbp(100), deposits.WrapperTypeGIA)
+	require.NoError(t, service.Create(context.Background(), investors.InvestorId(uuid.NewString()), other))
+
+	// Half fund the first deposit
+	receipt, err := deposits.NewReceipt(gbp(100))
+	require.NoError(t, err)
+	_, _, err = service.ReceiveReceipt(context.Background(), created[0].Pots[0].Accounts[0].Id, receipt)
+	require.NoError(t, err)
+
+	t.Run("pages newest first", func(t *testing.T) {
+		ids := []deposits.DepositId{}
+		pageToken := ""
+		for {
+			filter, err := deposits.NewDepositFilter(investorId, nil, time.Time{}, time.Time{}, 2, pageToken)
+			require.NoError(t, err)
+
+			page, err := service.ListDeposits(context.Background(), filter)
+			require.NoError(t, err)
+			for _, summary := range page.Deposits {
+				ids = append(ids, summary.Id)
+			}
+
+			if page.Next == nil {
+				break
+			}
+			pageToken = page.Next.String()
+		}
+
+		require.Equal(t, []deposits.DepositId{created[2].Id, created[1].Id, created[0].Id}, ids)
+	})
+
+	t.Run("summarises accounts", func(t *testing.T) {
+		filter, err := deposits.NewDepositFilter(investorId, []deposits.DepositStatus{deposits.DepositStatusPartiallyFunded}, time.Time{}, time.Time{}, 0, "")
+		require.NoError(t, err)
+
+		page, err := service.ListDeposits(context.Background(), filter)
+		require.NoError(t, err)
+		require.Nil(t, page.Next)
+		require.Len(t, page.Deposits, 1)
+		require.Equal(t, created[0].Id, page.Deposits[0].Id)
+		require.Equal(t, []deposits.DepositTotal{
+			{NominalAmount: gbp(200), AllocatedAmount: gbp(100), FundedPercentage: 50},
+		}, page.Deposits[0].Totals)
+	})
+
+	t.Run("date range", func(t *testing.T) {
+		// The repository creates deposits a second apart from the epoch
+		filter, err := deposits.NewDepositFilter("", nil, time.Unix(1, 0), time.Unix(3, 0), 0, "")
+		require.NoError(t, err)
+
+		page, err := service.ListDeposits(context.Background(), filter)
+		require.NoError(t, err)
+		require.Len(t, page.Deposits, 2)
+		require.Equal(t, created[2].Id, page.Deposits[0].Id)
+		require.Equal(t, created[1].Id, page.Deposits[1].Id)
+	})
+}
+
+func TestServiceReplayAccount(t *testing.T) {
+	investorId := investors.InvestorId(uuid.NewString())
+
+	// receive creates a deposit with a SIPP, and receives a payment into it and a reversed one
+	receive := func(t *testing.T) (*memoryRepository, *deposits.Service, *deposits.Account) {
+		repository := newMemoryRepository()
+		service := deposits.NewService(repository, memoryInvestors{})
+
+		deposit := newTestDeposit(t, gbp(100_00), deposits.WrapperTypeSIPP)
+		err := service.Create(context.Background(), investorId, deposit)
+		require.NoError(t, err)
+		account := deposit.Pots[0].Accounts[0]
+
+		receipt, err := deposits.NewReceipt(gbp(40_00))
+		require.NoError(t, err)
+		_, _, err = service.ReceiveReceipt(context.Background(), account.Id, receipt)
+		require.NoError(t, err)
+
+		bounced, err := deposits.NewReceipt(gbp(8_00))
+		require.NoError(t, err)
+		_, _, err = service.ReceiveReceipt(context.Background(), account.Id, bounced)
+		require.NoError(t, err)
+		_, err = service.ReverseReceipt(context.Background(), bounced.Id, deposits.ReversalReasonBounced, "")
+		require.NoError(t, err)
+
+		return repository, service, account
+	}
+
+	t.Run("projects what the stream folds to", func(t *testing.T) {
+		repository, _, account := receive(t)
+
+		stored := repository.tables.accounts[account.Id]
+		require.Equal(t, gbp(40_00), stored.TotalAllocatedAmount.Money)
+		require.Equal(t, gbp(10_00), stored.PendingReliefAmount.Money)
+		require.Equal(t, int64(5), stored.Version)
+		require.Len(t, repository.tables.events[account.Id], 5)
+
+		restored, err := repository.GetAccount(context.Background(), account.Id)
+		require.NoError(t, err)
+		require.Equal(t, stored.TotalAllocatedAmount, restored.TotalAllocatedAmount)
+		require.Equal(t, stored.PendingReliefAmount, restored.PendingReliefAmount)
+		require.Equal(t, stored.Version, restored.Version)
+	})
+
+	t.Run("rebuilds drifted balances", func(t *testing.T) {
+		repository, service, account := receive(t)
+
+		drifted := repository.tables.accounts[account.Id]
+		drifted.TotalAllocatedAmount = deposits.TotalAllocatedAmount{Money: gbp(99_00)}
+		drifted.PendingReliefAmount = deposits.TotalAllocatedAmount{Money: gbp(0)}
+		repository.tables.accounts[account.Id] = drifted
+
+		replayed, err := service.ReplayAccount(context.Background(), account.Id)
+		require.NoError(t, err)
+		require.Equal(t, gbp(40_00), replayed.TotalAllocatedAmount.Money)
+
+		stored := repository.tables.accounts[account.Id]
+		require.Equal(t, gbp(40_00), stored.TotalAllocatedAmount.Money)
+		require.Equal(t, gbp(10_00), stored.PendingReliefAmount.Money)
+		require.Equal(t, int64(5), stored.Version)
+		require.Len(t, repository.tables.events[account.Id], 5)
+	})
+
+	t.Run("replaces snapshots of drifted balances", func(t *testing.T) {
+		repository, service, account := receive(t)
+
+		drifted := repository.tables.accounts[account.Id]
+		drifted.TotalAllocatedAmount = deposits.TotalAllocatedAmount{Money: gbp(99_00)}
+		drifted.Receipts = nil
+		repository.tables.snapshots[account.Id] = []deposits.Account{drifted}
+
+		_, err := service.ReplayAccount(context.Background(), account.Id)
+		require.NoError(t, err)
+
+		snapshots := repository.tables.snapshots[account.Id]
+		require.Len(t, snapshots, 1)
+		require.Equal(t, gbp(40_00), snapshots[0].TotalAllocatedAmount.Money)
+		require.Equal(t, gbp(10_00), snapshots[0].PendingReliefAmount.Money)
+		require.Equal(t, int64(5), snapshots[0].Version)
+	})
+
+	t.Run("lists every account to replay", func(t *testing.T) {
+		_, service, account := receive(t)
+
+		accountIds, err := service.ListAccountIds(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []deposits.AccountId{account.Id}, accountIds)
+	})
+
+	t.Run("fails for an unknown account", func(t *testing.T) {
+		_, service, _ := receive(t)
+
+		_, err := service.ReplayAccount(context.Background(), deposits.AccountId(uuid.NewString()))
+		require.ErrorIs(t, err, deposits.ErrAccountNotFound)
+	})
+
+	t.Run("leaves the projection if it can't be saved", func(t *testing.T) {
+		repository, service, account := receive(t)
+		repository.failOn("ProjectAccount")
+
+		drifted := repository.tables.accounts[account.Id]
+		drifted.TotalAllocatedAmount = deposits.TotalAllocatedAmount{Money: gbp(99_00)}
+		repository.tables.accounts[account.Id] = drifted
+
+		_, err := service.ReplayAccount(context.Background(), account.Id)
+		require.ErrorIs(t, err, errInjected)
+		require.Equal(t, gbp(99_00), repository.tables.accounts[account.Id].TotalAllocatedAmount.Money)
+	})
+}
+
+func TestServiceLedger(t *testing.T) {
+	investorId := investors.InvestorId(uuid.NewString())
+	isaLiability := ledger.InvestorLiability(deposits.WrapperTypeISA.String())
+	giaLiability := ledger.InvestorLiability(deposits.WrapperTypeGIA.String())
+
+	create := func(t *testing.T, wrapperTypes ...deposits.WrapperType) (*memoryRepository, *deposits.Service, *deposits.Deposit) {
+		repository := newMemoryRepository()
+		service := deposits.NewService(repository, memoryInvestors{})
+
+		deposit := newTestDeposit(t, gbp(100_00), wrapperTypes...)
+		err := service.Create(context.Background(), investorId, deposit)
+		require.NoError(t, err)
+
+		return repository, service, deposit
+	}
+
+	t.Run("posts receipts from the bank to the wrapper", func(t *testing.T) {
+		repository, service, deposit := create(t, deposits.WrapperTypeISA)
+
+		receipt, err := deposits.NewReceipt(gbp(40_00))
+		require.NoError(t, err)
+		_, _, err = service.ReceiveReceipt(context.Background(), deposit.Pots[0].Accounts[0].Id, receipt)
+		require.NoError(t, err)
+
+		require.Len(t, repository.tables.journals, 1)
+		journal := repository.tables.journals[0]
+		require.Equal(t, receipt.Id.String(), journal.Reference)
+		require.Equal(t, []ledger.Entry{
+			ledger.Debit(ledger.AccountClientMoneyBank, "GBP", 40_00),
+			ledger.Credit(isaLiability, "GBP", 40_00),
+		}, journal.Entries)
+	})
+
+	t.Run("posts reversals back out of the wrapper", func(t *testing.T) {
+		repository, service, deposit := create(t, deposits.WrapperTypeISA)
+
+		receipt, err := deposits.NewReceipt(gbp(40_00))
+		require.NoError(t, err)
+		_, _, err = service.ReceiveReceipt(context.Background(), deposit.Pots[0].Accounts[0].Id, receipt)
+		require.NoError(t, err)
+		reversal, err := service.ReverseReceipt(context.Background(), receipt.Id, deposits.ReversalReasonBounced, "")
+		require.NoError(t, err)
+
+		require.Len(t, repository.tables.journals, 2)
+		require.Equal(t, reversal.Id.String(), repository.tables.journals[1].Reference)
+		require.Equal(t, map[ledger.AccountCode]int64{
+			ledger.AccountClientMoneyBank: 0,
+			isaLiability:                  0,
+		}, repository.ledgerBalances())
+	})
+
+	t.Run("posts unallocated cash to suspense and transfers it out when allocated", func(t *testing.T) {
+		repository, service, deposit := create(t, deposits.WrapperTypeGIA)
+
+		unallocated, err := deposits.NewUnallocatedReceipt(gbp(150_00), "UNKNOWN-REF")
+		require.NoError(t, err)
+		_, err = service.ReceiveUnallocatedReceipt(context.Background(), unallocated)
+		require.NoError(t, err)
+		_, err = service.AllocateUnallocatedReceipt(context.Background(), unallocated.Id, deposit.Pots[0].Accounts[0].Id, gbp(60_00), "ops@example.com", "")
+		require.NoError(t, err)
+
+		require.Len(t, repository.tables.journals, 2)
+		require.Equal(t, []ledger.Entry{
+			ledger.Debit(ledger.AccountSuspense, "GBP", 60_00),
+			ledger.Credit(giaLiability, "GBP", 60_00),
+		}, repository.tables.journals[1].Entries)
+		require.Equal(t, map[ledger.AccountCode]int64{
+			ledger.AccountClientMoneyBank: 150_00,
+			ledger.AccountSuspense:        -90_00,
+			giaLiability:                  -60_00,
+		}, repository.ledgerBalances())
+	})
+
+	t.Run("posts journals when the payment was received", func(t *testing.T) {
+		repository, service, deposit := create(t, deposits.WrapperTypeISA)
+
+		receipt, err := deposits.NewReceipt(gbp(40_00))
+		require.NoError(t, err)
+		receipt.ReceivedAt = time.Now().AddDate(0, 0, -3).Truncate(time.Second)
+		_, _, err = service.ReceiveReceipt(context.Background(), deposit.Pots[0].Accounts[0].Id, receipt)
+		require.NoError(t, err)
+
+		require.Len(t, repository.tables.journals, 1)
+		require.Equal(t, receipt.ReceivedAt, repository.tables.journals[0].PostedAt)
+	})
+
+	t.Run("returns reversed allocations to suspense", func(t *testing.T) {
+		repository, service, deposit := create(t, deposits.WrapperTypeGIA)
+
+		unallocated, err := deposits.NewUnallocatedReceipt(gbp(150_00), "UNKNOWN-REF")
+		require.NoError(t, err)
+		_, err = service.ReceiveUnallocatedReceipt(context.Background(), unallocated)
+		require.NoError(t, err)
+		allocation, err := service.AllocateUnallocatedReceipt(context.Background(), unallocated.Id, deposit.Pots[0].Accounts[0].Id, gbp(60_00), "ops@example.com", "")
+		require.NoError(t, err)
+		_, err = service.ReverseReceipt(context.Background(), allocation.ReceiptId, deposits.ReversalReasonRecalled, "")
+		require.NoError(t, err)
+
+		// The trial balance's suspense is what's left to allocate
+		listed, err := service.ListUnallocatedReceipts(context.Background(), "")
+		require.NoError(t, err)
+		require.Len(t, listed, 1)
+		require.Equal(t, gbp(150_00), listed[0].UnallocatedAmount.Money)
+		require.Equal(t, map[ledger.AccountCode]int64{
+			ledger.AccountClientMoneyBank: 150_00,
+			ledger.AccountSuspense:        -listed[0].UnallocatedAmount.Amount,
+			giaLiability:                  0,
+		}, repository.ledgerBalances())
+
+		// The returned cash can be allocated again
+		_, err = service.AllocateUnallocatedReceipt(context.Background(), unallocated.Id, deposit.Pots[0].Accounts[0].Id, gbp(150_00), "ops@example.com", "")
+		require.NoError(t, err)
+	})
+
+	t.Run("posts overflow to the GIA and suspense", func(t *testing.T) {
+		repository, service, deposit := create(t, deposits.WrapperTypeISA, deposits.WrapperTypeGIA)
+
+		receipt, err := deposits.NewReceipt(gbp(130_00))
+		require.NoError(t, err)
+		_, _, _, err = service.ReceiveReceiptWithOverflow(context.Background(), deposit.Pots[0].Accounts[0].Id, receipt)
+		require.NoError(t, err)
+
+		require.Equal(t, map[ledger.AccountCode]int64{
+			ledger.AccountClientMoneyBank: 130_00,
+			isaLiability:                  -100_00,
+			giaLiability:                  -30_00,
+		}, repository.ledgerBalances())
+
+		repository, service, deposit = create(t, deposits.WrapperTypeISA)
+		receipt, err = deposits.NewReceipt(gbp(130_00))
+		require.NoError(t, err)
+		_, _, _, err = service.ReceiveReceiptWithOverflow(context.Background(), deposit.Pots[0].Accounts[0].Id, receipt)
+		require.NoError(t, err)
+
+		require.Equal(t, map[ledger.AccountCode]int64{
+			ledger.AccountClientMoneyBank: 130_00,
+			isaLiability:                  -100_00,
+			ledger.AccountSuspense:        -30_00,
+		}, repository.ledgerBalances())
+	})
+
+	t.Run("doesn't receive anything if the journal can't be posted", func(t *testing.T) {
+		repository, service, deposit := create(t, deposits.WrapperTypeISA)
+		repository.failOn("SaveJournal")
+		account := deposit.Pots[0].Accounts[0]
+
+		receipt, err := deposits.NewReceipt(gbp(40_00))
+		require.NoError(t, err)
+		_, _, err = service.ReceiveReceipt(context.Background(), account.Id, receipt)
+		require.ErrorIs(t, err, errInjected)
+
+		require.Empty(t, repository.tables.receipts)
+		require.Empty(t, repository.tables.journals)
+		require.Equal(t, gbp(0), repository.tables.accounts[account.Id].TotalAllocatedAmount.Money)
+	})
+}
+
+func TestServiceOutbox(t *testing.T) {
+	investorId := investors.InvestorId(uuid.NewString())
+
+	create := func(t *testing.T) (*memoryRepository, *deposits.Service, *deposits.Deposit) {
+		repository := newMemoryRepository()
+		service := deposits.NewService(repository, memoryInvestors{})
+
+		deposit := newTestDeposit(t, gbp(100_00), deposits.WrapperTypeISA)
+		err := service.Create(context.Background(), investorId, deposit)
+		require.NoError(t, err)
+
+		return repository, service, deposit
+	}
+
+	t.Run("publishes the deposit opening with its accounts", func(t *testing.T) {
+		repository, _, deposit := create(t)
+		account := deposit.Pots[0].Accounts[0]
+
+		require.Equal(t, []string{deposits.TopicDepositStatusChanged}, repository.outboxTopics(deposit.Id.String()))
+		require.Equal(t, []string{deposits.AccountEventTypeAccountOpened.String()}, repository.outboxTopics(account.Id.String()))
+	})
+
+	t.Run("publishes receipts and the deposit being funded", func(t *testing.T) {
+		repository, service, deposit := create(t)
+		account := deposit.Pots[0].Accounts[0]
+
+		receipt, err := deposits.NewReceipt(gbp(100_00))
+		require.NoError(t, err)
+		_, _, err = service.ReceiveReceipt(context.Background(), account.Id, receipt)
+		require.NoError(t, err)
+
+		require.Equal(t, []string{
+			deposits.AccountEventTypeAccountOpened.String(),
+			deposits.AccountEventTypeReceiptAllocated.String(),
+		}, repository.outboxTopics(account.Id.String()))
+		require.Equal(t, []string{
+			deposits.TopicDepositStatusChanged,
+			deposits.TopicDepositStatusChanged,
+			deposits.TopicDepositFunded,
+		}, repository.outboxTopics(deposit.Id.String()))
+
+		// The funding is the last message
+		message := repository.tables.outbox[len(repository.tables.outbox)-1]
+		require.JSONEq(t, `{
+			"deposit_id": "`+deposit.Id.String()+`",
+			"investor_id": "`+investorId.String()+`",
+			"from": "OPEN",
+			"to": "FUNDED"
+		}`, string(message.Payload))
+
+		// Receipts are published with what was allocated
+		var payload map[string]any
+		for _, message := range repository.tables.outbox {
+			if message.Topic == deposits.AccountEventTypeReceiptAllocated.String() {
+				require.NoError(t, json.Unmarshal(message.Payload, &payload))
+			}
+		}
+		require.Equal(t, receipt.Id.String(), payload["receipt_id"])
+		require.Equal(t, "GBP", payload["currency"])
+		require.Equal(t, float64(100_00), payload["amount"])
+	})
+
+	t.Run("doesn't publish changes that aren't saved", func(t *testing.T) {
+		repository, service, deposit := create(t)
+		// Fails after the account's events are saved
+		repository.failOn("UpdateDepositStatus")
+		saved := len(repository.tables.outbox)
+
+		receipt, err := deposits.NewReceipt(gbp(40_00))
+		require.NoError(t, err)
+		_, _, err = service.ReceiveReceipt(context.Background(), deposit.Pots[0].Accounts[0].Id, receipt)
+		require.ErrorIs(t, err, errInjected)
+
+		require.Len(t, repository.tables.outbox, saved)
+	})
+
+	t.Run("publishes receipts rejected for breaking a business rule", func(t *testing.T) {
+		repository, service, deposit := create(t)
+		account := deposit.Pots[0].Accounts[0]
+
+		receipt, err := deposits.NewReceipt(gbp(150_00))
+		require.NoError(t, err)
+		receipt.IdempotencyKey = "BANK-REF-1"
+		_, _, err = service.ReceiveReceipt(context.Background(), account.Id, receipt)
+		require.ErrorIs(t, err, deposits.ErrNominalExceeded)
+
+		depositReceipt, err := deposits.NewDepositReceipt(deposit.Id, gbp(150_00))
+		require.NoError(t, err)
+		_, err = service.ReceiveDepositReceipt(context.Background(), depositReceipt, deposits.WaterfallAllocation{Order: deposits.DefaultWaterfallOrder})
+		require.ErrorIs(t, err, deposits.ErrAllocationExceedsNominal)
+
+		unallocated, err := deposits.NewUnallocatedReceipt(gbp(10_00), "UNKNOWN-REF")
+		require.NoError(t, err)
+		_, err = service.ReceiveUnallocatedReceipt(context.Background(), unallocated)
+		require.NoError(t, err)
+		_, err = service.AllocateUnallocatedReceipt(context.Background(), unallocated.Id, account.Id, gbp(20_00), "ops@example.com", "")
+		require.ErrorIs(t, err, deposits.ErrAllocationExceedsUnallocated)
+
+		// Only the rejections were saved
+		require.Equal(t, []string{deposits.AccountEventTypeAccountOpened.String(), deposits.TopicReceiptRejected, deposits.TopicReceiptRejected}, repository.outboxTopics(account.Id.String()))
+		require.Equal(t, []string{deposits.TopicDepositStatusChanged, deposits.TopicReceiptRejected}, repository.outboxTopics(deposit.Id.String()))
+
+		payloads := []string{}
+		for _, message := range repository.tables.outbox {
+			if message.Topic == deposits.TopicReceiptRejected {
+				payloads = append(payloads, string(message.Payload))
+			}
+		}
+		require.Len(t, payloads, 3)
+		require.JSONEq(t, `{
+			"receipt_id": "`+receipt.Id.String()+`",
+			"account_id": "`+account.Id.String()+`",
+			"idempotency_key": "BANK-REF-1",
+			"currency": "GBP",
+			"amount": 15000,
+			"reason": "NOMINAL_EXCEEDED"
+		}`, payloads[0])
+		require.JSONEq(t, `{
+			"deposit_receipt_id": "`+depositReceipt.Id.String()+`",
+			"deposit_id": "`+deposit.Id.String()+`",
+			"currency": "GBP",
+			"amount": 15000,
+			"reason": "ALLOCATION_EXCEEDS_NOMINAL"
+		}`, payloads[1])
+		require.JSONEq(t, `{
+			"account_id": "`+account.Id.String()+`",
+			"allocated_from": "`+unallocated.Id.String()+`",
+			"currency": "GBP",
+			"amount": 2000,
+			"reason": "ALLOCATION_EXCEEDS_UNALLOCATED"
+		}`, payloads[2])
+	})
+
+	t.Run("doesn't publish receipts that failed for anything else", func(t *testing.T) {
+		repository, service, _ := create(t)
+		saved := len(repository.tables.outbox)
+
+		receipt, err := deposits.NewReceipt(gbp(10_00))
+		require.NoError(t, err)
+		_, _, err = service.ReceiveReceipt(context.Background(), deposits.AccountId(uuid.NewString()), receipt)
+		require.ErrorIs(t, err, deposits.ErrAccountNotFound)
+
+		require.Len(t, repository.tables.outbox, saved)
+	})
+}
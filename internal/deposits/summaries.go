@@ -0,0 +1,111 @@
+package deposits
+
+import (
+	"errors"
+	"time"
+
+	"github.com/iainvm/deposits/common/pagination"
+	"github.com/iainvm/deposits/internal/investors"
+)
+
+var (
+	ErrInvalidDateRange = errors.New("date range must end after it starts")
+)
+
+// DepositSummary is a deposit with the totals of its accounts, instead of the accounts themselves
+type DepositSummary struct {
+	Id         DepositId
+	InvestorId investors.InvestorId
+	Status     DepositStatus
+	CreatedAt  time.Time
+	// Totals has one total for each currency the deposit's accounts are in, and none if it has no accounts
+	Totals []DepositTotal
+}
+
+// DepositTotal sums a deposit's accounts in one currency
+type DepositTotal struct {
+	NominalAmount   Money
+	AllocatedAmount Money
+	// FundedPercentage is how much of the nominal is allocated, with any relief pending on it, like the funding status
+	FundedPercentage float64
+}
+
+// DepositCursor is the position in the list of deposits, newest first, that the next page starts after
+type DepositCursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	Id        DepositId `json:"id"`
+}
+
+// NewDepositCursor creates the cursor for the page after the given deposit
+func NewDepositCursor(summary DepositSummary) DepositCursor {
+	return DepositCursor{
+		CreatedAt: summary.CreatedAt,
+		Id:        summary.Id,
+	}
+}
+
+// ParseDepositCursor parses the opaque token given to clients back into a DepositCursor, nil if the token is blank as
+// that's the first page
+func ParseDepositCursor(token string) (*DepositCursor, error) {
+	cursor, err := pagination.DecodeToken[DepositCursor](token)
+	if err != nil || cursor == nil {
+		return nil, err
+	}
+
+	_, err = ParseDepositId(cursor.Id.String())
+	if err != nil {
+		return nil, errors.Join(pagination.ErrInvalidPageToken, err)
+	}
+
+	return cursor, nil
+}
+
+// String encodes the cursor as an opaque token for clients
+func (cursor DepositCursor) String() string {
+	return pagination.EncodeToken(cursor)
+}
+
+// DepositFilter picks which deposits are listed, and which page of them
+//
+// Blank fields don't filter, so the zero DepositFilter lists every deposit
+type DepositFilter struct {
+	InvestorId investors.InvestorId
+	Statuses   []DepositStatus
+	// CreatedFrom and CreatedTo are the range deposits were created in, including CreatedFrom but not CreatedTo
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	PageSize    int
+	After       *DepositCursor
+}
+
+// NewDepositFilter creates a DepositFilter, defaulting and capping the page size
+func NewDepositFilter(investorId investors.InvestorId, statuses []DepositStatus, createdFrom time.Time, createdTo time.Time, pageSize int, pageToken string) (DepositFilter, error) {
+	if !createdFrom.IsZero() && !createdTo.IsZero() && !createdTo.After(createdFrom) {
+		return DepositFilter{}, ErrInvalidDateRange
+	}
+
+	pageSize, err := pagination.NewPageSize(pageSize)
+	if err != nil {
+		return DepositFilter{}, err
+	}
+
+	after, err := ParseDepositCursor(pageToken)
+	if err != nil {
+		return DepositFilter{}, err
+	}
+
+	return DepositFilter{
+		InvestorId:  investorId,
+		Statuses:    statuses,
+		CreatedFrom: createdFrom,
+		CreatedTo:   createdTo,
+		PageSize:    pageSize,
+		After:       after,
+	}, nil
+}
+
+// DepositPage is one page of deposit summaries, Next is nil when there are no more
+type DepositPage struct {
+	Deposits []*DepositSummary
+	Next     *DepositCursor
+}
@@ -0,0 +1,109 @@
+package deposits_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iainvm/deposits/common/pagination"
+	"github.com/iainvm/deposits/internal/deposits"
+	"github.com/iainvm/deposits/internal/investors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepositCursor(t *testing.T) {
+	cursor := deposits.DepositCursor{
+		CreatedAt: time.Date(2024, time.May, 1, 12, 30, 0, 123456000, time.UTC),
+		Id:        deposits.DepositId(uuid.NewString()),
+	}
+
+	parsed, err := deposits.ParseDepositCursor(cursor.String())
+	require.NoError(t, err)
+	require.True(t, cursor.CreatedAt.Equal(parsed.CreatedAt))
+	require.Equal(t, cursor.Id, parsed.Id)
+
+	// The first page has no cursor
+	parsed, err = deposits.ParseDepositCursor("")
+	require.NoError(t, err)
+	require.Nil(t, parsed)
+
+	_, err = deposits.ParseDepositCursor("not a cursor")
+	require.ErrorIs(t, err, pagination.ErrInvalidPageToken)
+
+	_, err = deposits.ParseDepositCursor(deposits.DepositCursor{Id: "not a uuid"}.String())
+	require.ErrorIs(t, err, pagination.ErrInvalidPageToken)
+}
+
+func TestNewDepositFilter(t *testing.T) {
+	investorId := investors.InvestorId(uuid.NewString())
+	from := time.Date(2024, time.April, 6, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(1, 0, 0)
+
+	testCases := []struct {
+		description string
+		from        time.Time
+		to          time.Time
+		pageSize    int
+		pageToken   string
+		expected    deposits.DepositFilter
+		expectedErr error
+	}{
+		{
+			description: "defaults page size",
+			expected:    deposits.DepositFilter{InvestorId: investorId, PageSize: pagination.DefaultPageSize},
+		},
+		{
+			description: "caps page size",
+			pageSize:    pagination.MaxPageSize + 1,
+			expected:    deposits.DepositFilter{InvestorId: investorId, PageSize: pagination.MaxPageSize},
+		},
+		{
+			description: "date range",
+			from:        from,
+			to:          to,
+			pageSize:    10,
+			expected:    deposits.DepositFilter{InvestorId: investorId, CreatedFrom: from, CreatedTo: to, PageSize: 10},
+		},
+		{
+			description: "open ended date range",
+			from:        from,
+			pageSize:    10,
+			expected:    deposits.DepositFilter{InvestorId: investorId, CreatedFrom: from, PageSize: 10},
+		},
+		{
+			description: "date range ends before it starts",
+			from:        to,
+			to:          from,
+			expectedErr: deposits.ErrInvalidDateRange,
+		},
+		{
+			description: "empty date range",
+			from:        from,
+			to:          from,
+			expectedErr: deposits.ErrInvalidDateRange,
+		},
+		{
+			description: "negative page size",
+			pageSize:    -1,
+			expectedErr: pagination.ErrInvalidPageSize,
+		},
+		{
+			description: "invalid page token",
+			pageToken:   "not a cursor",
+			expectedErr: pagination.ErrInvalidPageToken,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			filter, err := deposits.NewDepositFilter(investorId, nil, testCase.from, testCase.to, testCase.pageSize, testCase.pageToken)
+			if testCase.expectedErr != nil {
+				require.ErrorIs(t, err, testCase.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, filter)
+		})
+	}
+}
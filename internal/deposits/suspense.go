@@ -0,0 +1,234 @@
+package deposits
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrReceiptAlreadyAllocated       = errors.New("receipt is already on an account")
+	ErrAllocationExceedsUnallocated  = errors.New("allocation exceeds the receipt's unallocated amount")
+	ErrInvalidAllocatedBy            = errors.New("invalid allocated by given")
+	ErrInvalidAccountReference       = errors.New("invalid account reference given")
+	ErrSuspenseAllocationAmountEmpty = errors.New("suspense allocation amount must be more than zero")
+	ErrSuspenseAllocationNotFound    = errors.New("suspense allocation not found")
+	ErrReceiptNotAllocatedFrom       = errors.New("receipt wasn't allocated from the unallocated receipt")
+	ErrUnallocatedReceiptAllocated   = errors.New("unallocated receipt has already had cash allocated from it")
+)
+
+// maxAccountReferenceLength is the longest reference the receipts table will store
+const maxAccountReferenceLength = 255
+
+// NewUnallocatedReceipt creates a Receipt for a payment that couldn't be matched to an account, holding all of it as
+// unallocated cash until it's allocated with Receipt.AllocateTo
+//
+// The account reference is whatever the payment arrived with, kept so ops can work out where it should go
+func NewUnallocatedReceipt(allocatedAmount Money, accountReference string) (*Receipt, error) {
+	if len(accountReference) > maxAccountReferenceLength {
+		return nil, ErrInvalidAccountReference
+	}
+
+	receipt, err := NewReceipt(allocatedAmount)
+	if err != nil {
+		return nil, err
+	}
+	receipt.AccountReference = accountReference
+	receipt.UnallocatedAmount = receipt.AllocatedAmount
+
+	return receipt, nil
+}
+
+// HoldInSuspense holds the receipt in the deposit's suspense balance, as unallocated cash, instead of on an account
+func (receipt *Receipt) HoldInSuspense(depositId DepositId) {
+	receipt.AccountId = ""
+	receipt.SuspenseDepositId = depositId
+	receipt.UnallocatedAmount = receipt.AllocatedAmount
+}
+
+// IsUnallocated is true for receipts that aren't on an account, which are allocated to accounts with Receipt.AllocateTo
+func (receipt Receipt) IsUnallocated() bool {
+	return receipt.AccountId == ""
+}
+
+// AllocateTo takes the amount off the receipt's unallocated amount, returning a new receipt for it to be received by
+// the account and the SuspenseAllocation recording who allocated it
+func (receipt *Receipt) AllocateTo(accountId AccountId, amount Money, allocatedBy string, allocatedAt time.Time) (*Receipt, *SuspenseAllocation, error) {
+	if !receipt.IsUnallocated() {
+		return nil, nil, ErrReceiptAlreadyAllocated
+	}
+	if allocatedBy == "" {
+		return nil, nil, ErrInvalidAllocatedBy
+	}
+	if amount.IsZero() || amount.IsNegative() {
+		return nil, nil, ErrSuspenseAllocationAmountEmpty
+	}
+
+	comparison, err := amount.Compare(receipt.UnallocatedAmount.Money)
+	if err != nil {
+		return nil, nil, err
+	}
+	if comparison > 0 {
+		return nil, nil, ErrAllocationExceedsUnallocated
+	}
+
+	allocated, err := NewReceipt(amount)
+	if err != nil {
+		return nil, nil, err
+	}
+	allocated.AccountId = accountId
+	allocated.AllocatedFrom = receipt.Id
+	// The cash arrived with the unallocated receipt, however long it waited to be allocated
+	allocated.ReceivedAt = receipt.ReceivedAt
+
+	allocationId, err := newSuspenseAllocationId()
+	if err != nil {
+		return nil, nil, err
+	}
+	allocation := &SuspenseAllocation{
+		Id:                   allocationId,
+		UnallocatedReceiptId: receipt.Id,
+		ReceiptId:            allocated.Id,
+		AccountId:            accountId,
+		Amount:               allocated.AllocatedAmount,
+		AllocatedBy:          allocatedBy,
+		AllocatedAt:          allocatedAt,
+	}
+
+	receipt.UnallocatedAmount.Money, err = receipt.UnallocatedAmount.Subtract(amount)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return allocated, allocation, nil
+}
+
+// ReturnAllocation puts the cash of a receipt allocated from the unallocated receipt back on it, when the allocated
+// receipt's reversed, so it's held in suspense until it's allocated again
+func (receipt *Receipt) ReturnAllocation(allocated Receipt) error {
+	if !receipt.IsUnallocated() || allocated.AllocatedFrom != receipt.Id {
+		return ErrReceiptNotAllocatedFrom
+	}
+
+	unallocatedAmount, err := receipt.UnallocatedAmount.Add(allocated.AllocatedAmount.Money)
+	if err != nil {
+		return err
+	}
+	receipt.UnallocatedAmount.Money = unallocatedAmount
+
+	return nil
+}
+
+// ReverseFromSuspense takes all the unallocated receipt's cash out of suspense, when its payment's reversed, which can
+// only be done before any of it's allocated
+func (receipt *Receipt) ReverseFromSuspense() error {
+	if !receipt.IsUnallocated() {
+		return ErrReceiptAlreadyAllocated
+	}
+	if receipt.UnallocatedAmount != receipt.AllocatedAmount {
+		return ErrUnallocatedReceiptAllocated
+	}
+
+	unallocatedAmount, err := receipt.UnallocatedAmount.Subtract(receipt.AllocatedAmount.Money)
+	if err != nil {
+		return err
+	}
+	receipt.UnallocatedAmount.Money = unallocatedAmount
+
+	return nil
+}
+
+type SuspenseAllocationId string
+
+func newSuspenseAllocationId() (SuspenseAllocationId, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return "", errors.Join(ErrIdGeneration, err)
+	}
+
+	return SuspenseAllocationId(id.String()), nil
+}
+
+func ParseSuspenseAllocationId(id string) (SuspenseAllocationId, error) {
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return "", err
+	}
+
+	return SuspenseAllocationId(id), nil
+}
+
+func (id SuspenseAllocationId) String() string {
+	return string(id)
+}
+
+// SuspenseAllocation is the audit record of unallocated cash being allocated to an account
+type SuspenseAllocation struct {
+	Id                   SuspenseAllocationId
+	UnallocatedReceiptId ReceiptId
+	// ReceiptId is the receipt the account received for the allocation
+	ReceiptId   ReceiptId
+	AccountId   AccountId
+	Amount      AllocatedAmount
+	AllocatedBy string
+	// IdempotencyKey is optional, an allocation with a key is only made once
+	IdempotencyKey IdempotencyKey
+	AllocatedAt    time.Time
+}
+
+// ParseSuspenseAllocation parses the given data into a SuspenseAllocation type, ensuring it's valid data
+func ParseSuspenseAllocation(id string, unallocatedReceiptId string, receiptId string, accountId string, currency string, amount int64, allocatedBy string, idempotencyKey string, allocatedAt time.Time) (*SuspenseAllocation, error) {
+	allocationId, err := ParseSuspenseAllocationId(id)
+	if err != nil {
+		return nil, err
+	}
+
+	allocationUnallocatedReceiptId, err := ParseReceiptId(unallocatedReceiptId)
+	if err != nil {
+		return nil, err
+	}
+
+	allocationReceiptId, err := ParseReceiptId(receiptId)
+	if err != nil {
+		return nil, err
+	}
+
+	allocationAccountId, err := ParseAccountId(accountId)
+	if err != nil {
+		return nil, err
+	}
+
+	money, err := NewMoney(amount, currency)
+	if err != nil {
+		return nil, err
+	}
+	allocationAmount, err := NewAllocatedAmount(money)
+	if err != nil {
+		return nil, err
+	}
+
+	if allocatedBy == "" {
+		return nil, ErrInvalidAllocatedBy
+	}
+
+	allocation := &SuspenseAllocation{
+		Id:                   allocationId,
+		UnallocatedReceiptId: allocationUnallocatedReceiptId,
+		ReceiptId:            allocationReceiptId,
+		AccountId:            allocationAccountId,
+		Amount:               allocationAmount,
+		AllocatedBy:          allocatedBy,
+		AllocatedAt:          allocatedAt,
+	}
+
+	// Keys are optional
+	if idempotencyKey != "" {
+		allocation.IdempotencyKey, err = NewIdempotencyKey(idempotencyKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return allocation, nil
+}
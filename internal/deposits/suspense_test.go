@@ -0,0 +1,200 @@
+package deposits_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iainvm/deposits/internal/deposits"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUnallocatedReceipt(t *testing.T) {
+	t.Run("holds everything as unallocated", func(t *testing.T) {
+		receipt, err := deposits.NewUnallocatedReceipt(gbp(10_00), "UNKNOWN-REF")
+		require.NoError(t, err)
+		require.True(t, receipt.IsUnallocated())
+		require.Equal(t, "UNKNOWN-REF", receipt.AccountReference)
+		require.Equal(t, receipt.AllocatedAmount, receipt.UnallocatedAmount)
+	})
+
+	t.Run("fails for long references", func(t *testing.T) {
+		_, err := deposits.NewUnallocatedReceipt(gbp(10_00), strings.Repeat("a", 256))
+		require.ErrorIs(t, err, deposits.ErrInvalidAccountReference)
+	})
+}
+
+func TestReceiptHoldInSuspense(t *testing.T) {
+	receipt, err := deposits.NewReceipt(gbp(10_00))
+	require.NoError(t, err)
+	depositId := deposits.DepositId(uuid.NewString())
+
+	receipt.HoldInSuspense(depositId)
+	require.True(t, receipt.IsUnallocated())
+	require.Equal(t, depositId, receipt.SuspenseDepositId)
+	require.Equal(t, gbp(10_00), receipt.UnallocatedAmount.Money)
+}
+
+func TestReceiptAllocateTo(t *testing.T) {
+	accountId := deposits.AccountId(uuid.NewString())
+	allocatedAt := time.Now()
+
+	testCases := []struct {
+		description         string
+		amount              deposits.Money
+		allocatedBy         string
+		expectedError       error
+		expectedUnallocated deposits.Money
+	}{
+		{
+			description:         "allocates part",
+			amount:              gbp(4_00),
+			allocatedBy:         "ops@example.com",
+			expectedUnallocated: gbp(6_00),
+		},
+		{
+			description:         "allocates everything",
+			amount:              gbp(10_00),
+			allocatedBy:         "ops@example.com",
+			expectedUnallocated: gbp(0),
+		},
+		{
+			description:   "fails for more than is unallocated",
+			amount:        gbp(10_01),
+			allocatedBy:   "ops@example.com",
+			expectedError: deposits.ErrAllocationExceedsUnallocated,
+		},
+		{
+			description:   "fails for nothing",
+			amount:        gbp(0),
+			allocatedBy:   "ops@example.com",
+			expectedError: deposits.ErrSuspenseAllocationAmountEmpty,
+		},
+		{
+			description:   "fails for a different currency",
+			amount:        eur(1_00),
+			allocatedBy:   "ops@example.com",
+			expectedError: deposits.ErrCurrencyMismatch,
+		},
+		{
+			description:   "fails without who allocated it",
+			amount:        gbp(1_00),
+			expectedError: deposits.ErrInvalidAllocatedBy,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			unallocated, err := deposits.NewUnallocatedReceipt(gbp(10_00), "UNKNOWN-REF")
+			require.NoError(t, err)
+			unallocated.ReceivedAt = allocatedAt.AddDate(0, 0, -7)
+
+			receipt, allocation, err := unallocated.AllocateTo(accountId, testCase.amount, testCase.allocatedBy, allocatedAt)
+			if testCase.expectedError != nil {
+				require.ErrorIs(t, err, testCase.expectedError)
+				require.Equal(t, gbp(10_00), unallocated.UnallocatedAmount.Money)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedUnallocated, unallocated.UnallocatedAmount.Money)
+			require.Equal(t, testCase.amount, receipt.AllocatedAmount.Money)
+			require.Equal(t, accountId, receipt.AccountId)
+			require.Equal(t, unallocated.Id, receipt.AllocatedFrom)
+			require.Equal(t, unallocated.ReceivedAt, receipt.ReceivedAt)
+			require.Equal(t, &deposits.SuspenseAllocation{
+				Id:                   allocation.Id,
+				UnallocatedReceiptId: unallocated.Id,
+				ReceiptId:            receipt.Id,
+				AccountId:            accountId,
+				Amount:               receipt.AllocatedAmount,
+				AllocatedBy:          testCase.allocatedBy,
+				AllocatedAt:          allocatedAt,
+			}, allocation)
+		})
+	}
+
+	t.Run("fails for receipts on an account", func(t *testing.T) {
+		receipt, err := deposits.NewReceipt(gbp(10_00))
+		require.NoError(t, err)
+		receipt.AccountId = accountId
+
+		_, _, err = receipt.AllocateTo(accountId, gbp(1_00), "ops@example.com", allocatedAt)
+		require.ErrorIs(t, err, deposits.ErrReceiptAlreadyAllocated)
+	})
+}
+
+func TestReceiptReturnAllocation(t *testing.T) {
+	accountId := deposits.AccountId(uuid.NewString())
+	allocatedAt := time.Now()
+
+	t.Run("puts the allocated cash back in suspense", func(t *testing.T) {
+		unallocated, err := deposits.NewUnallocatedReceipt(gbp(10_00), "UNKNOWN-REF")
+		require.NoError(t, err)
+		receipt, _, err := unallocated.AllocateTo(accountId, gbp(6_00), "ops@example.com", allocatedAt)
+		require.NoError(t, err)
+
+		err = unallocated.ReturnAllocation(*receipt)
+		require.NoError(t, err)
+		require.Equal(t, gbp(10_00), unallocated.UnallocatedAmount.Money)
+	})
+
+	t.Run("fails for receipts allocated from elsewhere", func(t *testing.T) {
+		unallocated, err := deposits.NewUnallocatedReceipt(gbp(10_00), "UNKNOWN-REF")
+		require.NoError(t, err)
+		other, err := deposits.NewUnallocatedReceipt(gbp(10_00), "OTHER-REF")
+		require.NoError(t, err)
+		receipt, _, err := other.AllocateTo(accountId, gbp(6_00), "ops@example.com", allocatedAt)
+		require.NoError(t, err)
+
+		err = unallocated.ReturnAllocation(*receipt)
+		require.ErrorIs(t, err, deposits.ErrReceiptNotAllocatedFrom)
+		require.Equal(t, gbp(10_00), unallocated.UnallocatedAmount.Money)
+	})
+}
+
+func TestReceiptReverseFromSuspense(t *testing.T) {
+	t.Run("takes all the cash out of suspense", func(t *testing.T) {
+		unallocated, err := deposits.NewUnallocatedReceipt(gbp(10_00), "UNKNOWN-REF")
+		require.NoError(t, err)
+
+		err = unallocated.ReverseFromSuspense()
+		require.NoError(t, err)
+		require.Equal(t, gbp(0), unallocated.UnallocatedAmount.Money)
+	})
+
+	t.Run("fails once cash is allocated", func(t *testing.T) {
+		unallocated, err := deposits.NewUnallocatedReceipt(gbp(10_00), "UNKNOWN-REF")
+		require.NoError(t, err)
+		_, _, err = unallocated.AllocateTo(deposits.AccountId(uuid.NewString()), gbp(1_00), "ops@example.com", time.Now())
+		require.NoError(t, err)
+
+		err = unallocated.ReverseFromSuspense()
+		require.ErrorIs(t, err, deposits.ErrUnallocatedReceiptAllocated)
+		require.Equal(t, gbp(9_00), unallocated.UnallocatedAmount.Money)
+	})
+}
+
+func TestParseSuspenseAllocation(t *testing.T) {
+	id := uuid.NewString()
+	unallocatedReceiptId := uuid.NewString()
+	receiptId := uuid.NewString()
+	accountId := uuid.NewString()
+	allocatedAt := time.Now()
+
+	allocation, err := deposits.ParseSuspenseAllocation(id, unallocatedReceiptId, receiptId, accountId, "GBP", 10_00, "ops@example.com", "", allocatedAt)
+	require.NoError(t, err)
+	require.Equal(t, &deposits.SuspenseAllocation{
+		Id:                   deposits.SuspenseAllocationId(id),
+		UnallocatedReceiptId: deposits.ReceiptId(unallocatedReceiptId),
+		ReceiptId:            deposits.ReceiptId(receiptId),
+		AccountId:            deposits.AccountId(accountId),
+		Amount:               deposits.AllocatedAmount{Money: gbp(10_00)},
+		AllocatedBy:          "ops@example.com",
+		AllocatedAt:          allocatedAt,
+	}, allocation)
+
+	_, err = deposits.ParseSuspenseAllocation(id, unallocatedReceiptId, receiptId, accountId, "GBP", 10_00, "", "", allocatedAt)
+	require.ErrorIs(t, err, deposits.ErrInvalidAllocatedBy)
+}
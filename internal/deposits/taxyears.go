@@ -0,0 +1,78 @@
+package deposits
+
+import (
+	"errors"
+	"fmt"
+	"time"
+	_ "time/tzdata" // Tax years are in UK time, so don't rely on the host having zone data
+)
+
+var ErrInvalidTaxYear = errors.New("invalid tax year given")
+
+// TaxYear is a UK tax year, running from 6 April to 5 April, identified by the calendar year it starts in
+type TaxYear int
+
+var ukLocation = mustLoadLocation("Europe/London")
+
+func mustLoadLocation(name string) *time.Location {
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+
+	return location
+}
+
+// TaxYearOf returns the TaxYear the given time falls in
+func TaxYearOf(t time.Time) TaxYear {
+	ukTime := t.In(ukLocation)
+
+	year := ukTime.Year()
+	if ukTime.Before(TaxYear(year).Start()) {
+		year--
+	}
+
+	return TaxYear(year)
+}
+
+// ParseTaxYear parses a tax year in the "2024/25" format
+func ParseTaxYear(taxYear string) (TaxYear, error) {
+	var start, end int
+	_, err := fmt.Sscanf(taxYear, "%4d/%2d", &start, &end)
+	if err != nil {
+		return 0, errors.Join(ErrInvalidTaxYear, err)
+	}
+
+	if (start+1)%100 != end {
+		return 0, ErrInvalidTaxYear
+	}
+
+	return TaxYear(start), nil
+}
+
+// NewTaxYear creates a TaxYear from the calendar year it starts in
+func NewTaxYear(year int) (TaxYear, error) {
+	if year <= 0 {
+		return 0, ErrInvalidTaxYear
+	}
+
+	return TaxYear(year), nil
+}
+
+// Start returns the first moment of the tax year
+func (taxYear TaxYear) Start() time.Time {
+	return time.Date(int(taxYear), time.April, 6, 0, 0, 0, 0, ukLocation)
+}
+
+// End returns the first moment of the following tax year
+func (taxYear TaxYear) End() time.Time {
+	return (taxYear + 1).Start()
+}
+
+func (taxYear TaxYear) Int() int {
+	return int(taxYear)
+}
+
+func (taxYear TaxYear) String() string {
+	return fmt.Sprintf("%d/%02d", int(taxYear), (int(taxYear)+1)%100)
+}
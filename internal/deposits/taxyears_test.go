@@ -0,0 +1,99 @@
+package deposits_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iainvm/deposits/internal/deposits"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaxYearOf(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		description   string
+		input         time.Time
+		expectedValue deposits.TaxYear
+	}{
+		{
+			description:   "5 April is the previous tax year",
+			input:         time.Date(2024, time.April, 5, 23, 59, 59, 0, london),
+			expectedValue: 2023,
+		},
+		{
+			description:   "6 April starts the tax year",
+			input:         time.Date(2024, time.April, 6, 0, 0, 0, 0, london),
+			expectedValue: 2024,
+		},
+		{
+			description:   "UTC is converted to UK time",
+			input:         time.Date(2024, time.April, 5, 23, 30, 0, 0, time.UTC),
+			expectedValue: 2024,
+		},
+		{
+			description:   "January is the previous tax year",
+			input:         time.Date(2025, time.January, 1, 0, 0, 0, 0, london),
+			expectedValue: 2024,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			require.Equal(t, testCase.expectedValue, deposits.TaxYearOf(testCase.input))
+		})
+	}
+}
+
+func TestParseTaxYear(t *testing.T) {
+	testCases := []struct {
+		description   string
+		input         string
+		expectedError error
+		expectedValue deposits.TaxYear
+	}{
+		{
+			description:   "passes for 2024/25",
+			input:         "2024/25",
+			expectedValue: 2024,
+		},
+		{
+			description:   "passes for 2099/00",
+			input:         "2099/00",
+			expectedValue: 2099,
+		},
+		{
+			description:   "fails for non consecutive years",
+			input:         "2024/26",
+			expectedError: deposits.ErrInvalidTaxYear,
+		},
+		{
+			description:   "fails for random string",
+			input:         "abcdef",
+			expectedError: deposits.ErrInvalidTaxYear,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			actualValue, actualError := deposits.ParseTaxYear(testCase.input)
+			if testCase.expectedError != nil {
+				require.ErrorIs(t, actualError, testCase.expectedError)
+				return
+			}
+
+			require.NoError(t, actualError)
+			require.Equal(t, testCase.expectedValue, actualValue)
+			require.Equal(t, testCase.input, actualValue.String())
+		})
+	}
+}
+
+func TestTaxYearBoundaries(t *testing.T) {
+	taxYear := deposits.TaxYear(2024)
+
+	require.Equal(t, taxYear, deposits.TaxYearOf(taxYear.Start()))
+	require.Equal(t, taxYear+1, deposits.TaxYearOf(taxYear.End()))
+	require.Equal(t, taxYear, deposits.TaxYearOf(taxYear.End().Add(-time.Nanosecond)))
+}
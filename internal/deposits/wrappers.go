@@ -0,0 +1,403 @@
+package deposits
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/iainvm/deposits/internal/investors"
+)
+
+var (
+	ErrWrapperIneligible        = errors.New("wrapper type not eligible for pot")
+	ErrInvestorTooYoung         = errors.New("investor is too young for wrapper type")
+	ErrInvestorTooOld           = errors.New("investor is too old for wrapper type")
+	ErrInvestorNotUKResident    = errors.New("wrapper type is only for UK residents")
+	ErrWrapperAlreadyHeld       = errors.New("investor can only hold one account of wrapper type")
+	ErrWrapperPolicyRegistered  = errors.New("wrapper policy already registered")
+	ErrWrapperPolicyInvalidCode = errors.New("wrapper policy code cannot be blank")
+	ErrWrapperPolicyInvalidType = errors.New("wrapper policy type must be positive")
+)
+
+type WrapperType int
+
+const (
+	WrapperTypeGIA WrapperType = iota + 1 //Bump number so that you can't set UNSPECIFIED account type
+	WrapperTypeISA
+	WrapperTypeSIPP
+	WrapperTypeLifetimeISA
+	WrapperTypeJuniorISA
+	WrapperTypeJISAToISA
+	WrapperTypeCashISA
+)
+
+func (wrapperType WrapperType) Int() int {
+	return int(wrapperType)
+}
+
+// String returns the registered code for the WrapperType, or an empty string if it isn't registered
+func (wrapperType WrapperType) String() string {
+	policy, err := LookupWrapperPolicy(wrapperType)
+	if err != nil {
+		return ""
+	}
+
+	return policy.Code()
+}
+
+// ParseWrapperTypeCode parses the given code into the WrapperType it's registered against
+func ParseWrapperTypeCode(code string) (WrapperType, error) {
+	policy, err := LookupWrapperPolicyByCode(code)
+	if err != nil {
+		return 0, err
+	}
+
+	return policy.Type(), nil
+}
+
+func validateWrapperType(wrapperType WrapperType) error {
+	_, err := LookupWrapperPolicy(wrapperType)
+	return err
+}
+
+// WrapperPolicy holds the rules an Account must follow for its WrapperType
+type WrapperPolicy interface {
+	// Type is the WrapperType the policy applies to
+	Type() WrapperType
+	// Code is the stable name of the wrapper, used for storage and the API
+	Code() string
+	// Capped reports if allocations to the wrapper are limited to the Account's NominalAmount
+	Capped() bool
+	// UsesISAAllowance reports if allocations to the wrapper count towards the investor's annual ISA allowance
+	UsesISAAllowance() bool
+	// TaxRelief returns the relief to claim for a net contribution, zero if the wrapper doesn't get relief at source
+	TaxRelief(net AllocatedAmount) AllocatedAmount
+	// ValidateAllocation checks the Account is allowed to hold the given TotalAllocatedAmount
+	ValidateAllocation(account Account, amount TotalAllocatedAmount) error
+	// Headroom returns the largest receipt the Account can still take, false if the wrapper isn't capped
+	Headroom(account Account) (AllocatedAmount, bool, error)
+	// ValidateEligibility checks the Account is allowed to be added to the Pot
+	ValidateEligibility(pot Pot, account Account) error
+	// ValidateInvestor checks the investor can hold the wrapper on the date of the given time, with every rule they fail
+	ValidateInvestor(investor investors.Investor, on time.Time) error
+	// SingleAccount reports if investors can only hold one account of the wrapper, across all their deposits
+	SingleAccount() bool
+}
+
+// StandardWrapperPolicy is a WrapperPolicy configured by its fields, covering the common wrapper rules
+type StandardWrapperPolicy struct {
+	WrapperType WrapperType
+	Name        string
+	// CappedAtNominal stops allocations exceeding the Account's NominalAmount
+	CappedAtNominal bool
+	// ISAAllowance counts allocations towards the investor's annual ISA allowance
+	ISAAllowance bool
+	// ReliefAtSource claims basic rate tax relief on contributions
+	ReliefAtSource bool
+	// Excludes lists the wrapper types that can't share a Pot with this one
+	Excludes []WrapperType
+	// MinimumAge is the age investors must have reached to hold the wrapper, zero for no minimum
+	MinimumAge int
+	// MaximumAge is the age investors must be under to hold the wrapper, zero for no maximum
+	MaximumAge int
+	// UKResidentOnly limits the wrapper to investors resident in the UK for tax
+	UKResidentOnly bool
+	// OneAccount limits investors to one account of the wrapper, across all their deposits
+	OneAccount bool
+}
+
+func (policy StandardWrapperPolicy) Type() WrapperType {
+	return policy.WrapperType
+}
+
+func (policy StandardWrapperPolicy) Code() string {
+	return policy.Name
+}
+
+func (policy StandardWrapperPolicy) Capped() bool {
+	return policy.CappedAtNominal
+}
+
+func (policy StandardWrapperPolicy) UsesISAAllowance() bool {
+	return policy.ISAAllowance
+}
+
+func (policy StandardWrapperPolicy) TaxRelief(net AllocatedAmount) AllocatedAmount {
+	if !policy.ReliefAtSource {
+		return AllocatedAmount{Money{Currency: net.Currency}}
+	}
+
+	return BasicRateRelief(net)
+}
+
+// ValidateAllocation applies the nominal to the gross amount, so relief still pending on the account counts towards it
+func (policy StandardWrapperPolicy) ValidateAllocation(account Account, amount TotalAllocatedAmount) error {
+	if !policy.CappedAtNominal {
+		return nil
+	}
+
+	gross, err := amount.Add(account.PendingReliefAmount.Money)
+	if err != nil {
+		return err
+	}
+	comparison, err := gross.Compare(account.NominalAmount.Money)
+	if err != nil {
+		return err
+	}
+	if comparison > 0 {
+		return ErrNominalExceeded
+	}
+
+	return nil
+}
+
+// Headroom leaves room for the relief due on the receipt, so a relief at source receipt's gross amount fits the nominal
+func (policy StandardWrapperPolicy) Headroom(account Account) (AllocatedAmount, bool, error) {
+	if !policy.CappedAtNominal {
+		return AllocatedAmount{}, false, nil
+	}
+
+	used, err := account.TotalAllocatedAmount.Add(account.PendingReliefAmount.Money)
+	if err != nil {
+		return AllocatedAmount{}, false, err
+	}
+	available, err := account.NominalAmount.Subtract(used)
+	if err != nil {
+		return AllocatedAmount{}, false, err
+	}
+	if available.IsNegative() {
+		available.Amount = 0
+	}
+
+	// Find the largest net amount whose gross amount is available
+	fits := func(net int64) bool {
+		relief := policy.TaxRelief(AllocatedAmount{Money{Amount: net, Currency: available.Currency}})
+		return net+relief.Amount <= available.Amount
+	}
+	net := available.Amount / 5 * 4
+	if !policy.ReliefAtSource {
+		net = available.Amount
+	}
+	for net > 0 && !fits(net) {
+		net--
+	}
+	for net < available.Amount && fits(net+1) {
+		net++
+	}
+
+	return AllocatedAmount{Money{Amount: net, Currency: available.Currency}}, true, nil
+}
+
+func (policy StandardWrapperPolicy) ValidateEligibility(pot Pot, account Account) error {
+	for _, potAccount := range pot.Accounts {
+		if slices.Contains(policy.Excludes, potAccount.WrapperType) {
+			return ErrWrapperIneligible
+		}
+	}
+
+	return nil
+}
+
+// ValidateInvestor only checks what's in the investor's profile, anything missing from it fails their KYC instead
+func (policy StandardWrapperPolicy) ValidateInvestor(investor investors.Investor, on time.Time) error {
+	failures := []error{}
+
+	dateOfBirth := investor.Profile.DateOfBirth
+	if !dateOfBirth.IsZero() {
+		age := dateOfBirth.AgeOn(on)
+		if policy.MinimumAge > 0 && age < policy.MinimumAge {
+			failures = append(failures, ErrInvestorTooYoung)
+		}
+		if policy.MaximumAge > 0 && age >= policy.MaximumAge {
+			failures = append(failures, ErrInvestorTooOld)
+		}
+	}
+
+	taxResidency := investor.Profile.TaxResidency
+	if policy.UKResidentOnly && taxResidency != "" && !taxResidency.IsUK() {
+		failures = append(failures, ErrInvestorNotUKResident)
+	}
+
+	return errors.Join(failures...)
+}
+
+func (policy StandardWrapperPolicy) SingleAccount() bool {
+	return policy.OneAccount
+}
+
+// WrapperRegistry stores the WrapperPolicy for each WrapperType
+type WrapperRegistry struct {
+	mu       sync.RWMutex
+	policies map[WrapperType]WrapperPolicy
+	codes    map[string]WrapperType
+}
+
+func NewWrapperRegistry() *WrapperRegistry {
+	return &WrapperRegistry{
+		policies: map[WrapperType]WrapperPolicy{},
+		codes:    map[string]WrapperType{},
+	}
+}
+
+// Register adds the policy to the registry, a WrapperType or code can only be registered once
+func (registry *WrapperRegistry) Register(policy WrapperPolicy) error {
+	if policy.Type() <= 0 {
+		return ErrWrapperPolicyInvalidType
+	}
+	if policy.Code() == "" {
+		return ErrWrapperPolicyInvalidCode
+	}
+
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	if _, ok := registry.policies[policy.Type()]; ok {
+		return ErrWrapperPolicyRegistered
+	}
+	if _, ok := registry.codes[policy.Code()]; ok {
+		return ErrWrapperPolicyRegistered
+	}
+
+	registry.policies[policy.Type()] = policy
+	registry.codes[policy.Code()] = policy.Type()
+	return nil
+}
+
+// Policy returns the policy registered against the WrapperType
+func (registry *WrapperRegistry) Policy(wrapperType WrapperType) (WrapperPolicy, error) {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	policy, ok := registry.policies[wrapperType]
+	if !ok {
+		return nil, ErrInvalidWrapperType
+	}
+
+	return policy, nil
+}
+
+// PolicyByCode returns the policy registered against the code
+func (registry *WrapperRegistry) PolicyByCode(code string) (WrapperPolicy, error) {
+	registry.mu.RLock()
+	wrapperType, ok := registry.codes[code]
+	registry.mu.RUnlock()
+	if !ok {
+		return nil, ErrInvalidWrapperType
+	}
+
+	return registry.Policy(wrapperType)
+}
+
+// Policies returns all registered policies ordered by WrapperType
+func (registry *WrapperRegistry) Policies() []WrapperPolicy {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	policies := make([]WrapperPolicy, 0, len(registry.policies))
+	for _, policy := range registry.policies {
+		policies = append(policies, policy)
+	}
+	slices.SortFunc(policies, func(a WrapperPolicy, b WrapperPolicy) int {
+		return a.Type().Int() - b.Type().Int()
+	})
+
+	return policies
+}
+
+// wrappers is the registry used by the domain types
+var wrappers = defaultWrapperRegistry()
+
+func defaultWrapperRegistry() *WrapperRegistry {
+	registry := NewWrapperRegistry()
+
+	defaults := []WrapperPolicy{
+		StandardWrapperPolicy{
+			WrapperType: WrapperTypeGIA,
+			Name:        "GIA",
+			MinimumAge:  18,
+		},
+		StandardWrapperPolicy{
+			WrapperType:     WrapperTypeISA,
+			Name:            "ISA",
+			CappedAtNominal: true,
+			ISAAllowance:    true,
+			Excludes:        []WrapperType{WrapperTypeJuniorISA},
+			MinimumAge:      18,
+			UKResidentOnly:  true,
+		},
+		StandardWrapperPolicy{
+			WrapperType:     WrapperTypeSIPP,
+			Name:            "SIPP",
+			CappedAtNominal: true,
+			ReliefAtSource:  true,
+			MaximumAge:      75,
+		},
+		StandardWrapperPolicy{
+			WrapperType:     WrapperTypeLifetimeISA,
+			Name:            "LIFETIME_ISA",
+			CappedAtNominal: true,
+			ISAAllowance:    true,
+			Excludes:        []WrapperType{WrapperTypeJuniorISA},
+			MinimumAge:      18,
+			MaximumAge:      40,
+			UKResidentOnly:  true,
+		},
+		StandardWrapperPolicy{
+			WrapperType:     WrapperTypeJuniorISA,
+			Name:            "JUNIOR_ISA",
+			CappedAtNominal: true,
+			Excludes:        []WrapperType{WrapperTypeISA, WrapperTypeLifetimeISA, WrapperTypeJISAToISA, WrapperTypeCashISA},
+			MaximumAge:      18,
+			UKResidentOnly:  true,
+			OneAccount:      true,
+		},
+		StandardWrapperPolicy{
+			WrapperType:     WrapperTypeJISAToISA,
+			Name:            "JISA_TO_ISA",
+			CappedAtNominal: true,
+			ISAAllowance:    true,
+			Excludes:        []WrapperType{WrapperTypeJuniorISA},
+			MinimumAge:      18,
+			UKResidentOnly:  true,
+		},
+		StandardWrapperPolicy{
+			WrapperType:     WrapperTypeCashISA,
+			Name:            "CASH_ISA",
+			CappedAtNominal: true,
+			ISAAllowance:    true,
+			Excludes:        []WrapperType{WrapperTypeJuniorISA},
+			MinimumAge:      18,
+			UKResidentOnly:  true,
+		},
+	}
+
+	for _, policy := range defaults {
+		err := registry.Register(policy)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return registry
+}
+
+// RegisterWrapperPolicy adds a new WrapperPolicy to the registry used by the domain types
+func RegisterWrapperPolicy(policy WrapperPolicy) error {
+	return wrappers.Register(policy)
+}
+
+// LookupWrapperPolicy returns the registered WrapperPolicy for the WrapperType
+func LookupWrapperPolicy(wrapperType WrapperType) (WrapperPolicy, error) {
+	return wrappers.Policy(wrapperType)
+}
+
+// LookupWrapperPolicyByCode returns the registered WrapperPolicy for the code
+func LookupWrapperPolicyByCode(code string) (WrapperPolicy, error) {
+	return wrappers.PolicyByCode(code)
+}
+
+// WrapperPolicies returns every registered WrapperPolicy ordered by WrapperType
+func WrapperPolicies() []WrapperPolicy {
+	return wrappers.Policies()
+}
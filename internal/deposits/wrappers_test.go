@@ -0,0 +1,294 @@
+package deposits_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/iainvm/deposits/internal/deposits"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWrapperTypeCode(t *testing.T) {
+	testCases := []struct {
+		description   string
+		input         string
+		expectedError error
+		expectedValue deposits.WrapperType
+	}{
+		{
+			description:   "passes for GIA",
+			input:         "GIA",
+			expectedError: nil,
+			expectedValue: deposits.WrapperTypeGIA,
+		},
+		{
+			description:   "passes for JUNIOR_ISA",
+			input:         "JUNIOR_ISA",
+			expectedError: nil,
+			expectedValue: deposits.WrapperTypeJuniorISA,
+		},
+		{
+			description:   "fails for unknown code",
+			input:         "PREMIUM_BONDS",
+			expectedError: deposits.ErrInvalidWrapperType,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			actualValue, actualError := deposits.ParseWrapperTypeCode(testCase.input)
+			if testCase.expectedError != nil {
+				require.ErrorIs(t, actualError, testCase.expectedError)
+				return
+			}
+
+			require.NoError(t, actualError)
+			require.Equal(t, testCase.expectedValue, actualValue)
+			require.Equal(t, testCase.input, actualValue.String())
+		})
+	}
+}
+
+func TestWrapperRegistry(t *testing.T) {
+	policy := deposits.StandardWrapperPolicy{
+		WrapperType:     100,
+		Name:            "TEST",
+		CappedAtNominal: true,
+	}
+
+	t.Run("registers policy", func(t *testing.T) {
+		registry := deposits.NewWrapperRegistry()
+		err := registry.Register(policy)
+		require.NoError(t, err)
+
+		actual, err := registry.Policy(100)
+		require.NoError(t, err)
+		require.Equal(t, policy, actual)
+
+		actual, err = registry.PolicyByCode("TEST")
+		require.NoError(t, err)
+		require.Equal(t, policy, actual)
+	})
+
+	t.Run("rejects duplicate type", func(t *testing.T) {
+		registry := deposits.NewWrapperRegistry()
+		err := registry.Register(policy)
+		require.NoError(t, err)
+
+		err = registry.Register(deposits.StandardWrapperPolicy{WrapperType: 100, Name: "OTHER"})
+		require.ErrorIs(t, err, deposits.ErrWrapperPolicyRegistered)
+	})
+
+	t.Run("rejects duplicate code", func(t *testing.T) {
+		registry := deposits.NewWrapperRegistry()
+		err := registry.Register(policy)
+		require.NoError(t, err)
+
+		err = registry.Register(deposits.StandardWrapperPolicy{WrapperType: 101, Name: "TEST"})
+		require.ErrorIs(t, err, deposits.ErrWrapperPolicyRegistered)
+	})
+
+	t.Run("rejects unspecified type", func(t *testing.T) {
+		registry := deposits.NewWrapperRegistry()
+		err := registry.Register(deposits.StandardWrapperPolicy{WrapperType: 0, Name: "TEST"})
+		require.ErrorIs(t, err, deposits.ErrWrapperPolicyInvalidType)
+	})
+
+	t.Run("rejects blank code", func(t *testing.T) {
+		registry := deposits.NewWrapperRegistry()
+		err := registry.Register(deposits.StandardWrapperPolicy{WrapperType: 100})
+		require.ErrorIs(t, err, deposits.ErrWrapperPolicyInvalidCode)
+	})
+
+	t.Run("unknown type", func(t *testing.T) {
+		registry := deposits.NewWrapperRegistry()
+		_, err := registry.Policy(100)
+		require.ErrorIs(t, err, deposits.ErrInvalidWrapperType)
+	})
+}
+
+func TestWrapperPolicies(t *testing.T) {
+	policies := deposits.WrapperPolicies()
+
+	codes := []string{}
+	for _, policy := range policies {
+		codes = append(codes, policy.Code())
+	}
+	// Other tests register their own policies after the defaults
+	require.Equal(t, []string{"GIA", "ISA", "SIPP", "LIFETIME_ISA", "JUNIOR_ISA", "JISA_TO_ISA", "CASH_ISA"}, codes[:7])
+}
+
+func TestRegisteredWrapperPolicy(t *testing.T) {
+	err := deposits.RegisterWrapperPolicy(deposits.StandardWrapperPolicy{
+		WrapperType:     200,
+		Name:            "REGISTERED_TEST",
+		CappedAtNominal: true,
+	})
+	// The registry is global, so it's already registered if the test is run again
+	if !errors.Is(err, deposits.ErrWrapperPolicyRegistered) {
+		require.NoError(t, err)
+	}
+
+	account, err := deposits.NewAccount(200, gbp(100))
+	require.NoError(t, err)
+
+	err = account.IncreaseTotalAllocationAmount(deposits.TotalAllocatedAmount{Money: gbp(100)})
+	require.NoError(t, err)
+
+	err = account.IncreaseTotalAllocationAmount(deposits.TotalAllocatedAmount{Money: gbp(1)})
+	require.ErrorIs(t, err, deposits.ErrNominalExceeded)
+}
+
+func TestWrapperCaps(t *testing.T) {
+	testCases := []struct {
+		description   string
+		wrapperType   deposits.WrapperType
+		expectedError error
+	}{
+		{
+			description:   "GIA can exceed nominal",
+			wrapperType:   deposits.WrapperTypeGIA,
+			expectedError: nil,
+		},
+		{
+			description:   "ISA can't exceed nominal",
+			wrapperType:   deposits.WrapperTypeISA,
+			expectedError: deposits.ErrNominalExceeded,
+		},
+		{
+			description:   "SIPP can't exceed nominal",
+			wrapperType:   deposits.WrapperTypeSIPP,
+			expectedError: deposits.ErrNominalExceeded,
+		},
+		{
+			description:   "Lifetime ISA can't exceed nominal",
+			wrapperType:   deposits.WrapperTypeLifetimeISA,
+			expectedError: deposits.ErrNominalExceeded,
+		},
+		{
+			description:   "Junior ISA can't exceed nominal",
+			wrapperType:   deposits.WrapperTypeJuniorISA,
+			expectedError: deposits.ErrNominalExceeded,
+		},
+		{
+			description:   "Cash ISA can't exceed nominal",
+			wrapperType:   deposits.WrapperTypeCashISA,
+			expectedError: deposits.ErrNominalExceeded,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			account, err := deposits.ParseAccount(uuid.NewString(), testCase.wrapperType.Int(), "GBP", 100, 0)
+			require.NoError(t, err)
+
+			err = account.IncreaseTotalAllocationAmount(deposits.TotalAllocatedAmount{Money: gbp(101)})
+			if testCase.expectedError != nil {
+				require.ErrorIs(t, err, testCase.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestWrapperEligibility(t *testing.T) {
+	t.Run("Junior ISA can't share a pot with an ISA", func(t *testing.T) {
+		pot, err := deposits.NewPot("Pot A")
+		require.NoError(t, err)
+
+		isa, err := deposits.NewAccount(deposits.WrapperTypeISA, gbp(100))
+		require.NoError(t, err)
+		err = pot.AddAccount(isa)
+		require.NoError(t, err)
+
+		jisa, err := deposits.NewAccount(deposits.WrapperTypeJuniorISA, gbp(100))
+		require.NoError(t, err)
+		err = pot.AddAccount(jisa)
+		require.ErrorIs(t, err, deposits.ErrWrapperIneligible)
+	})
+
+	t.Run("ISA can't share a pot with a Junior ISA", func(t *testing.T) {
+		pot, err := deposits.NewPot("Pot A")
+		require.NoError(t, err)
+
+		jisa, err := deposits.NewAccount(deposits.WrapperTypeJuniorISA, gbp(100))
+		require.NoError(t, err)
+		err = pot.AddAccount(jisa)
+		require.NoError(t, err)
+
+		isa, err := deposits.NewAccount(deposits.WrapperTypeISA, gbp(100))
+		require.NoError(t, err)
+		err = pot.AddAccount(isa)
+		require.ErrorIs(t, err, deposits.ErrWrapperIneligible)
+	})
+
+	t.Run("GIA can share a pot with a Junior ISA", func(t *testing.T) {
+		pot, err := deposits.NewPot("Pot A")
+		require.NoError(t, err)
+
+		jisa, err := deposits.NewAccount(deposits.WrapperTypeJuniorISA, gbp(100))
+		require.NoError(t, err)
+		err = pot.AddAccount(jisa)
+		require.NoError(t, err)
+
+		gia, err := deposits.NewAccount(deposits.WrapperTypeGIA, gbp(100))
+		require.NoError(t, err)
+		err = pot.AddAccount(gia)
+		require.NoError(t, err)
+	})
+}
+
+func TestWrapperHeadroom(t *testing.T) {
+	testCases := []struct {
+		description      string
+		wrapperType      deposits.WrapperType
+		totalAllocated   int64
+		expectedCapped   bool
+		expectedHeadroom deposits.Money
+	}{
+		{
+			description:    "GIA isn't capped",
+			wrapperType:    deposits.WrapperTypeGIA,
+			expectedCapped: false,
+		},
+		{
+			description:      "ISA can take up to its nominal",
+			wrapperType:      deposits.WrapperTypeISA,
+			totalAllocated:   40_00,
+			expectedCapped:   true,
+			expectedHeadroom: gbp(60_00),
+		},
+		{
+			description:      "ISA that's full can't take anything",
+			wrapperType:      deposits.WrapperTypeISA,
+			totalAllocated:   100_00,
+			expectedCapped:   true,
+			expectedHeadroom: gbp(0),
+		},
+		{
+			description:      "SIPP leaves room for relief",
+			wrapperType:      deposits.WrapperTypeSIPP,
+			expectedCapped:   true,
+			expectedHeadroom: gbp(80_00),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			account, err := deposits.ParseAccount(uuid.NewString(), testCase.wrapperType.Int(), "GBP", 100_00, testCase.totalAllocated)
+			require.NoError(t, err)
+			policy, err := deposits.LookupWrapperPolicy(testCase.wrapperType)
+			require.NoError(t, err)
+
+			headroom, capped, err := policy.Headroom(*account)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedCapped, capped)
+			if capped {
+				require.Equal(t, testCase.expectedHeadroom, headroom.Money)
+			}
+		})
+	}
+}
@@ -7,16 +7,19 @@ import (
 )
 
 var (
-	ErrIdGeneration    = errors.New("failed to generate id")
-	ErrInvalidInvestor = errors.New("invalid investor")
-	ErrInvalidId       = errors.New("invalid id")
-	ErrInvalidName     = errors.New("invalid name")
-	ErrBlankName       = errors.New("blank name given")
+	ErrIdGeneration        = errors.New("failed to generate id")
+	ErrInvalidInvestor     = errors.New("invalid investor")
+	ErrInvalidId           = errors.New("invalid id")
+	ErrInvalidName         = errors.New("invalid name")
+	ErrBlankName           = errors.New("blank name given")
+	ErrInvestorNotFound    = errors.New("investor not found")
+	ErrInvestorHasDeposits = errors.New("investor has deposits")
 )
 
 type Investor struct {
-	Id   InvestorId
-	Name Name
+	Id      InvestorId
+	Name    Name
+	Profile Profile
 }
 
 // NewInvestor creates a new Investor, ensuring the given data is valid
@@ -39,6 +42,35 @@ func NewInvestor(name string) (*Investor, error) {
 	return investor, nil
 }
 
+// ParseInvestor parses the given data into an Investor type, ensuring it's valid data
+func ParseInvestor(id string, name string) (*Investor, error) {
+	investorId, err := ParseInvestorId(id)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidInvestor, ErrInvalidId, err)
+	}
+
+	investorsName, err := NewName(name)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidInvestor, err)
+	}
+
+	return &Investor{
+		Id:   investorId,
+		Name: investorsName,
+	}, nil
+}
+
+// Rename changes the investor's name
+func (investor *Investor) Rename(name string) error {
+	investorsName, err := NewName(name)
+	if err != nil {
+		return errors.Join(ErrInvalidInvestor, err)
+	}
+
+	investor.Name = investorsName
+	return nil
+}
+
 type InvestorId string
 
 func newInvestorId() (InvestorId, error) {
@@ -43,3 +43,16 @@ func TestName(t *testing.T) {
 		})
 	}
 }
+
+func TestRename(t *testing.T) {
+	investor, err := investors.NewInvestor("Jane")
+	require.NoError(t, err)
+
+	err = investor.Rename("Janet")
+	require.NoError(t, err)
+	require.Equal(t, investors.Name("Janet"), investor.Name)
+
+	err = investor.Rename("")
+	require.ErrorIs(t, err, investors.ErrInvalidInvestor)
+	require.Equal(t, investors.Name("Janet"), investor.Name)
+}
@@ -0,0 +1,74 @@
+package investors
+
+import (
+	"errors"
+
+	"github.com/iainvm/deposits/common/pagination"
+)
+
+// Cursor is the position in the list of investors, ordered by name then id, that the next page starts after
+type Cursor struct {
+	Name Name       `json:"name"`
+	Id   InvestorId `json:"id"`
+}
+
+// NewCursor creates the cursor for the page after the given investor
+func NewCursor(investor Investor) Cursor {
+	return Cursor{
+		Name: investor.Name,
+		Id:   investor.Id,
+	}
+}
+
+// ParseCursor parses the opaque token given to clients back into a Cursor, nil if the token is blank as that's the
+// first page
+func ParseCursor(token string) (*Cursor, error) {
+	cursor, err := pagination.DecodeToken[Cursor](token)
+	if err != nil || cursor == nil {
+		return nil, err
+	}
+
+	_, err = ParseInvestorId(cursor.Id.String())
+	if err != nil {
+		return nil, errors.Join(pagination.ErrInvalidPageToken, err)
+	}
+
+	return cursor, nil
+}
+
+// String encodes the cursor as an opaque token for clients
+func (cursor Cursor) String() string {
+	return pagination.EncodeToken(cursor)
+}
+
+// ListFilter picks which investors are listed, and which page of them
+type ListFilter struct {
+	NamePrefix string
+	PageSize   int
+	After      *Cursor
+}
+
+// NewListFilter creates a ListFilter, defaulting and capping the page size
+func NewListFilter(namePrefix string, pageSize int, pageToken string) (ListFilter, error) {
+	pageSize, err := pagination.NewPageSize(pageSize)
+	if err != nil {
+		return ListFilter{}, err
+	}
+
+	after, err := ParseCursor(pageToken)
+	if err != nil {
+		return ListFilter{}, err
+	}
+
+	return ListFilter{
+		NamePrefix: namePrefix,
+		PageSize:   pageSize,
+		After:      after,
+	}, nil
+}
+
+// Page is one page of investors, Next is nil when there are no more
+type Page struct {
+	Investors []*Investor
+	Next      *Cursor
+}
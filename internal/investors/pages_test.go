@@ -0,0 +1,82 @@
+package investors_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/iainvm/deposits/common/pagination"
+	"github.com/iainvm/deposits/internal/investors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor(t *testing.T) {
+	investor, err := investors.NewInvestor("Jane")
+	require.NoError(t, err)
+
+	cursor := investors.NewCursor(*investor)
+	parsed, err := investors.ParseCursor(cursor.String())
+	require.NoError(t, err)
+	require.Equal(t, cursor, *parsed)
+
+	// The first page has no cursor
+	parsed, err = investors.ParseCursor("")
+	require.NoError(t, err)
+	require.Nil(t, parsed)
+
+	_, err = investors.ParseCursor("not a cursor")
+	require.ErrorIs(t, err, pagination.ErrInvalidPageToken)
+
+	_, err = investors.ParseCursor(investors.Cursor{Name: "Jane", Id: "not a uuid"}.String())
+	require.ErrorIs(t, err, pagination.ErrInvalidPageToken)
+}
+
+func TestNewListFilter(t *testing.T) {
+	cursor := investors.Cursor{Name: "Jane", Id: investors.InvestorId(uuid.NewString())}
+
+	testCases := []struct {
+		description string
+		pageSize    int
+		pageToken   string
+		expected    investors.ListFilter
+		expectedErr error
+	}{
+		{
+			description: "defaults page size",
+			expected:    investors.ListFilter{NamePrefix: "J", PageSize: pagination.DefaultPageSize},
+		},
+		{
+			description: "caps page size",
+			pageSize:    pagination.MaxPageSize + 1,
+			expected:    investors.ListFilter{NamePrefix: "J", PageSize: pagination.MaxPageSize},
+		},
+		{
+			description: "next page",
+			pageSize:    10,
+			pageToken:   cursor.String(),
+			expected:    investors.ListFilter{NamePrefix: "J", PageSize: 10, After: &cursor},
+		},
+		{
+			description: "negative page size",
+			pageSize:    -1,
+			expectedErr: pagination.ErrInvalidPageSize,
+		},
+		{
+			description: "invalid page token",
+			pageToken:   "not a cursor",
+			expectedErr: pagination.ErrInvalidPageToken,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			filter, err := investors.NewListFilter("J", testCase.pageSize, testCase.pageToken)
+			if testCase.expectedErr != nil {
+				require.ErrorIs(t, err, testCase.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, filter)
+		})
+	}
+}
@@ -2,13 +2,25 @@ package store
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/iainvm/deposits/internal/investors"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
-var ErrCreationFailed = errors.New("failed to create investor")
+var (
+	ErrCreationFailed = errors.New("failed to create investor")
+	ErrReadFailed     = errors.New("failed to read investor")
+	ErrSaveFailed     = errors.New("failed to save investor")
+)
+
+// foreignKeyViolation is the postgres error code for a foreign key constraint failing
+const foreignKeyViolation = "23503"
 
 type Store struct {
 	db *sqlx.DB
@@ -20,23 +32,89 @@ func NewStore(db *sqlx.DB) Store {
 	}
 }
 
+// InvestorRow is an investor, with their profile in nullable columns as investors onboarded before profiles were
+// collected don't have one
 type InvestorRow struct {
-	Id   string `db:"id"`
-	Name string `db:"name"`
+	Id              string         `db:"id"`
+	Name            string         `db:"name"`
+	DateOfBirth     sql.NullTime   `db:"date_of_birth"`
+	NINumber        sql.NullString `db:"ni_number"`
+	TaxResidency    sql.NullString `db:"tax_residency"`
+	AddressLines    pq.StringArray `db:"address_lines"`
+	AddressPostcode sql.NullString `db:"address_postcode"`
+	AddressCountry  sql.NullString `db:"address_country"`
+	Email           sql.NullString `db:"email"`
+}
+
+func createInvestorRow(investor investors.Investor) InvestorRow {
+	profile := investor.Profile
+	row := InvestorRow{
+		Id:              investor.Id.String(),
+		Name:            investor.Name.String(),
+		DateOfBirth:     sql.NullTime{Time: profile.DateOfBirth.Time(), Valid: !profile.DateOfBirth.IsZero()},
+		NINumber:        sql.NullString{String: profile.NINumber.String(), Valid: profile.NINumber != ""},
+		TaxResidency:    sql.NullString{String: profile.TaxResidency.String(), Valid: profile.TaxResidency != ""},
+		AddressPostcode: sql.NullString{String: profile.Address.Postcode, Valid: !profile.Address.IsZero()},
+		AddressCountry:  sql.NullString{String: profile.Address.Country, Valid: !profile.Address.IsZero()},
+		Email:           sql.NullString{String: profile.Email.String(), Valid: profile.Email != ""},
+	}
+	if !profile.Address.IsZero() {
+		row.AddressLines = pq.StringArray(profile.Address.Lines)
+	}
+
+	return row
+}
+
+func createDomainInvestor(row InvestorRow) (*investors.Investor, error) {
+	investor, err := investors.ParseInvestor(row.Id, row.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the parts of the profile that were given are stored
+	if row.DateOfBirth.Valid {
+		investor.Profile.DateOfBirth, err = investors.NewDateOfBirth(row.DateOfBirth.Time)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if row.NINumber.Valid {
+		investor.Profile.NINumber, err = investors.NewNINumber(row.NINumber.String)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if row.TaxResidency.Valid {
+		investor.Profile.TaxResidency, err = investors.NewTaxResidency(row.TaxResidency.String)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(row.AddressLines) > 0 {
+		investor.Profile.Address, err = investors.NewAddress(row.AddressLines, row.AddressPostcode.String, row.AddressCountry.String)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if row.Email.Valid {
+		investor.Profile.Email, err = investors.NewEmail(row.Email.String)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return investor, nil
 }
 
 // SaveInvestor saves the given investor to the connected database
 func (store Store) SaveInvestor(ctx context.Context, investor *investors.Investor) error {
 	// Define query separately for easy editting
 	const query = `--sql
-	INSERT INTO investors (id, name)
-	VALUES (:id, :name)
+	INSERT INTO investors (id, name, date_of_birth, ni_number, tax_residency, address_lines, address_postcode, address_country, email)
+	VALUES (:id, :name, :date_of_birth, :ni_number, :tax_residency, :address_lines, :address_postcode, :address_country, :email)
 	`
 	// Create Row
-	row := InvestorRow{
-		Id:   investor.Id.String(),
-		Name: investor.Name.String(),
-	}
+	row := createInvestorRow(*investor)
 
 	// Execute query
 	_, err := store.db.NamedExecContext(
@@ -50,3 +128,169 @@ func (store Store) SaveInvestor(ctx context.Context, investor *investors.Investo
 
 	return nil
 }
+
+// GetInvestor gets the investor with the given id
+func (store Store) GetInvestor(ctx context.Context, id investors.InvestorId) (*investors.Investor, error) {
+	// Define query separately for easy editting
+	const query = `--sql
+	SELECT *
+	FROM investors
+	WHERE id = $1
+	`
+
+	// Execute query
+	row := InvestorRow{}
+	err := store.db.GetContext(ctx, &row, query, id.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, investors.ErrInvestorNotFound
+	}
+	if err != nil {
+		return nil, errors.Join(ErrReadFailed, err)
+	}
+
+	return createDomainInvestor(row)
+}
+
+// updateColumns are the columns each update field is stored in
+var updateColumns = map[investors.UpdateField][]string{
+	investors.UpdateFieldName:         {"name"},
+	investors.UpdateFieldDateOfBirth:  {"date_of_birth"},
+	investors.UpdateFieldNINumber:     {"ni_number"},
+	investors.UpdateFieldTaxResidency: {"tax_residency"},
+	investors.UpdateFieldAddress:      {"address_lines", "address_postcode", "address_country"},
+	investors.UpdateFieldEmail:        {"email"},
+}
+
+// UpdateInvestor saves only the columns of the given fields, so concurrent updates of other fields aren't overwritten,
+// returning the investor as they are now
+func (store Store) UpdateInvestor(ctx context.Context, investor *investors.Investor, fields []investors.UpdateField) (*investors.Investor, error) {
+	// Only set each column once, however many times its field is named
+	set := []string{}
+	for _, field := range fields {
+		for _, column := range updateColumns[field] {
+			assignment := fmt.Sprintf("%s=:%s", column, column)
+			if !slices.Contains(set, assignment) {
+				set = append(set, assignment)
+			}
+		}
+	}
+	if len(set) == 0 {
+		return store.GetInvestor(ctx, investor.Id)
+	}
+
+	// Define query separately for easy editting
+	query := fmt.Sprintf(`--sql
+	UPDATE investors
+	SET %s
+	WHERE id=:id
+	RETURNING *
+	`, strings.Join(set, ",\n\t\t"))
+
+	// Create Row
+	row := createInvestorRow(*investor)
+
+	// Execute query
+	query, args, err := store.db.BindNamed(query, row)
+	if err != nil {
+		return nil, errors.Join(ErrSaveFailed, err)
+	}
+	updated := InvestorRow{}
+	err = store.db.GetContext(ctx, &updated, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, investors.ErrInvestorNotFound
+	}
+	if err != nil {
+		return nil, errors.Join(ErrSaveFailed, err)
+	}
+
+	return createDomainInvestor(updated)
+}
+
+// ListInvestors lists up to limit investors whose names start with the prefix, ordered by name then id, starting after
+// the cursor
+func (store Store) ListInvestors(ctx context.Context, namePrefix string, after *investors.Cursor, limit int) ([]*investors.Investor, error) {
+	// Define query separately for easy editting
+	const query = `--sql
+	SELECT *
+	FROM investors
+	WHERE starts_with(name, $1)
+		AND ($2::VARCHAR IS NULL OR (name, id) > ($2, $3))
+	ORDER BY name, id
+	LIMIT $4
+	`
+
+	afterName, afterId := sql.NullString{}, sql.NullString{}
+	if after != nil {
+		afterName = sql.NullString{String: after.Name.String(), Valid: true}
+		afterId = sql.NullString{String: after.Id.String(), Valid: true}
+	}
+
+	// Execute query
+	rows := []InvestorRow{}
+	err := store.db.SelectContext(ctx, &rows, query, namePrefix, afterName, afterId, limit)
+	if err != nil {
+		return nil, errors.Join(ErrReadFailed, err)
+	}
+
+	investorsList := make([]*investors.Investor, 0, len(rows))
+	for _, row := range rows {
+		investor, err := createDomainInvestor(row)
+		if err != nil {
+			return nil, err
+		}
+		investorsList = append(investorsList, investor)
+	}
+
+	return investorsList, nil
+}
+
+// InvestorHasDeposits is true if the investor owns any deposits, whatever their status
+func (store Store) InvestorHasDeposits(ctx context.Context, id investors.InvestorId) (bool, error) {
+	// Define query separately for easy editting
+	const query = `--sql
+	SELECT EXISTS (
+		SELECT 1
+		FROM deposits
+		WHERE investor_id = $1
+	)
+	`
+
+	// Execute query
+	hasDeposits := false
+	err := store.db.GetContext(ctx, &hasDeposits, query, id.String())
+	if err != nil {
+		return false, errors.Join(ErrReadFailed, err)
+	}
+
+	return hasDeposits, nil
+}
+
+// DeleteInvestor deletes the investor, failing if anything still references them
+func (store Store) DeleteInvestor(ctx context.Context, id investors.InvestorId) error {
+	// Define query separately for easy editting
+	const query = `--sql
+	DELETE FROM investors
+	WHERE id = $1
+	`
+
+	// Execute query
+	result, err := store.db.ExecContext(ctx, query, id.String())
+	if err != nil {
+		// A deposit created since checking still blocks the delete
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == foreignKeyViolation {
+			return errors.Join(investors.ErrInvestorHasDeposits, err)
+		}
+		return errors.Join(ErrSaveFailed, err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.Join(ErrSaveFailed, err)
+	}
+	if rows == 0 {
+		return investors.ErrInvestorNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,251 @@
+package investors
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidDateOfBirth  = errors.New("invalid date of birth")
+	ErrInvalidNINumber     = errors.New("invalid national insurance number")
+	ErrInvalidTaxResidency = errors.New("invalid tax residency")
+	ErrInvalidAddress      = errors.New("invalid address")
+	ErrInvalidEmail        = errors.New("invalid email")
+	ErrKYCIncomplete       = errors.New("investor's profile is missing what's needed to verify them")
+)
+
+// Profile is what's known about an investor that decides which wrappers they can hold
+//
+// Investors onboarded before profiles were collected have a zero Profile, each part is zero until it's given
+type Profile struct {
+	DateOfBirth  DateOfBirth
+	NINumber     NINumber
+	TaxResidency TaxResidency
+	Address      Address
+	Email        Email
+}
+
+// niNumberAge is the age investors are issued a National Insurance number by
+const niNumberAge = 16
+
+// ValidateKYC checks the profile has everything needed to verify who the investor is on the date of the given time
+//
+// Every investor needs a date of birth, tax residency and address, and those old enough to have been issued one a
+// National Insurance number
+func (profile Profile) ValidateKYC(on time.Time) error {
+	missing := []string{}
+	if profile.DateOfBirth.IsZero() {
+		missing = append(missing, "date of birth")
+	}
+	if profile.TaxResidency == "" {
+		missing = append(missing, "tax residency")
+	}
+	if profile.Address.IsZero() {
+		missing = append(missing, "address")
+	}
+	// Without a date of birth it's not known if they should have one
+	if profile.NINumber == "" && (profile.DateOfBirth.IsZero() || profile.DateOfBirth.AgeOn(on) >= niNumberAge) {
+		missing = append(missing, "national insurance number")
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: missing %s", ErrKYCIncomplete, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// dateOfBirthLayout is the format dates of birth are given in
+const dateOfBirthLayout = "2006-01-02"
+
+// earliestDateOfBirth rules out years typed wrong, rather than anyone alive
+var earliestDateOfBirth = time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// DateOfBirth is the calendar date an investor was born on, it has no time of day or zone
+type DateOfBirth struct {
+	date time.Time
+}
+
+// NewDateOfBirth creates a DateOfBirth from the date of the given time, in its location, which must be in the past
+func NewDateOfBirth(t time.Time) (DateOfBirth, error) {
+	date := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if date.Before(earliestDateOfBirth) || date.After(time.Now()) {
+		return DateOfBirth{}, ErrInvalidDateOfBirth
+	}
+
+	return DateOfBirth{date}, nil
+}
+
+// ParseDateOfBirth parses a date of birth in the "2006-01-02" format
+func ParseDateOfBirth(dateOfBirth string) (DateOfBirth, error) {
+	date, err := time.Parse(dateOfBirthLayout, dateOfBirth)
+	if err != nil {
+		return DateOfBirth{}, errors.Join(ErrInvalidDateOfBirth, err)
+	}
+
+	return NewDateOfBirth(date)
+}
+
+// AgeOn is how old the investor is on the date of the given time, in its location
+//
+// Anyone born on 29 February turns a year older on 1 March when it isn't a leap year
+func (dateOfBirth DateOfBirth) AgeOn(t time.Time) int {
+	age := t.Year() - dateOfBirth.date.Year()
+
+	// Not had their birthday yet this year
+	if t.Month() < dateOfBirth.date.Month() || (t.Month() == dateOfBirth.date.Month() && t.Day() < dateOfBirth.date.Day()) {
+		age--
+	}
+
+	return age
+}
+
+func (dateOfBirth DateOfBirth) IsZero() bool {
+	return dateOfBirth.date.IsZero()
+}
+
+// Time is the start of the date of birth in UTC
+func (dateOfBirth DateOfBirth) Time() time.Time {
+	return dateOfBirth.date
+}
+
+func (dateOfBirth DateOfBirth) String() string {
+	if dateOfBirth.IsZero() {
+		return ""
+	}
+
+	return dateOfBirth.date.Format(dateOfBirthLayout)
+}
+
+// NINumber is a UK National Insurance number, like "AB123456C"
+type NINumber string
+
+// niNumberPattern is two prefix letters, six digits and a suffix of A to D
+//
+// The first letter is never D, F, I, Q, U or V, and the second is never D, F, I, O, Q, U or V
+var niNumberPattern = regexp.MustCompile(`^[A-CEGHJ-PR-TW-Z][A-CEGHJ-NPR-TW-Z][0-9]{6}[A-D]$`)
+
+// niNumberUnusedPrefixes are prefixes that are never allocated
+var niNumberUnusedPrefixes = []string{"BG", "GB", "KN", "NK", "NT", "TN", "ZZ"}
+
+// NewNINumber validates the format of the National Insurance number, ignoring case and spaces
+func NewNINumber(niNumber string) (NINumber, error) {
+	normalised := strings.ToUpper(strings.ReplaceAll(niNumber, " ", ""))
+
+	if !niNumberPattern.MatchString(normalised) {
+		return "", ErrInvalidNINumber
+	}
+	for _, prefix := range niNumberUnusedPrefixes {
+		if strings.HasPrefix(normalised, prefix) {
+			return "", ErrInvalidNINumber
+		}
+	}
+
+	return NINumber(normalised), nil
+}
+
+func (niNumber NINumber) String() string {
+	return string(niNumber)
+}
+
+// TaxResidency is the ISO 3166-1 alpha-2 code of the country an investor is resident in for tax
+type TaxResidency string
+
+// TaxResidencyUK is the UK, which is GB in ISO 3166
+const TaxResidencyUK TaxResidency = "GB"
+
+// countryCodePattern is the format of ISO 3166-1 alpha-2 country codes
+var countryCodePattern = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// NewTaxResidency creates a TaxResidency from a country code, ignoring case
+func NewTaxResidency(country string) (TaxResidency, error) {
+	code := strings.ToUpper(strings.TrimSpace(country))
+	if !countryCodePattern.MatchString(code) {
+		return "", ErrInvalidTaxResidency
+	}
+
+	return TaxResidency(code), nil
+}
+
+// IsUK is true for investors resident in the UK for tax
+func (taxResidency TaxResidency) IsUK() bool {
+	return taxResidency == TaxResidencyUK
+}
+
+func (taxResidency TaxResidency) String() string {
+	return string(taxResidency)
+}
+
+// maxAddressLines is the most lines an address can have, before its postcode and country
+const maxAddressLines = 4
+
+// ukPostcodePattern is the format of UK postcodes, with the space between the outward and inward codes optional
+var ukPostcodePattern = regexp.MustCompile(`^[A-Z]{1,2}[0-9][A-Z0-9]? ?[0-9][A-Z]{2}$`)
+
+// Address is where an investor lives
+type Address struct {
+	Lines    []string
+	Postcode string
+	// Country is an ISO 3166-1 alpha-2 country code
+	Country string
+}
+
+// NewAddress creates an Address, UK addresses must have a valid postcode
+func NewAddress(lines []string, postcode string, country string) (Address, error) {
+	addressLines := []string{}
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line != "" {
+			addressLines = append(addressLines, line)
+		}
+	}
+	if len(addressLines) == 0 || len(addressLines) > maxAddressLines {
+		return Address{}, ErrInvalidAddress
+	}
+
+	countryCode := strings.ToUpper(strings.TrimSpace(country))
+	if !countryCodePattern.MatchString(countryCode) {
+		return Address{}, ErrInvalidAddress
+	}
+
+	addressPostcode := strings.ToUpper(strings.TrimSpace(postcode))
+	if countryCode == TaxResidencyUK.String() && !ukPostcodePattern.MatchString(addressPostcode) {
+		return Address{}, ErrInvalidAddress
+	}
+
+	return Address{
+		Lines:    addressLines,
+		Postcode: addressPostcode,
+		Country:  countryCode,
+	}, nil
+}
+
+func (address Address) IsZero() bool {
+	return len(address.Lines) == 0
+}
+
+// Email is an investor's email address, without a display name
+type Email string
+
+// NewEmail validates the email address
+func NewEmail(email string) (Email, error) {
+	address, err := mail.ParseAddress(email)
+	if err != nil {
+		return "", errors.Join(ErrInvalidEmail, err)
+	}
+
+	// Display names, like "Jane <jane@example.com>", aren't part of the address
+	if address.Address != email {
+		return "", ErrInvalidEmail
+	}
+
+	return Email(address.Address), nil
+}
+
+func (email Email) String() string {
+	return string(email)
+}
@@ -0,0 +1,271 @@
+package investors_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iainvm/deposits/internal/investors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDateOfBirth(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		dateOfBirth string
+		on          time.Time
+		expectedErr error
+		expectedAge int
+	}{
+		{
+			desc:        "Before birthday",
+			dateOfBirth: "2000-06-15",
+			on:          time.Date(2018, time.June, 14, 23, 59, 0, 0, time.UTC),
+			expectedAge: 17,
+		},
+		{
+			desc:        "On birthday",
+			dateOfBirth: "2000-06-15",
+			on:          time.Date(2018, time.June, 15, 0, 0, 0, 0, time.UTC),
+			expectedAge: 18,
+		},
+		{
+			desc:        "Leap day birthday in a leap year",
+			dateOfBirth: "2004-02-29",
+			on:          time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC),
+			expectedAge: 20,
+		},
+		{
+			desc:        "Leap day birthday waits for March",
+			dateOfBirth: "2004-02-29",
+			on:          time.Date(2022, time.February, 28, 0, 0, 0, 0, time.UTC),
+			expectedAge: 17,
+		},
+		{
+			desc:        "Leap day birthday on 1 March",
+			dateOfBirth: "2004-02-29",
+			on:          time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC),
+			expectedAge: 18,
+		},
+		{
+			desc:        "Wrong format",
+			dateOfBirth: "15/06/2000",
+			expectedErr: investors.ErrInvalidDateOfBirth,
+		},
+		{
+			desc:        "In the future",
+			dateOfBirth: time.Now().AddDate(1, 0, 0).Format("2006-01-02"),
+			expectedErr: investors.ErrInvalidDateOfBirth,
+		},
+		{
+			desc:        "Too long ago",
+			dateOfBirth: "1899-12-31",
+			expectedErr: investors.ErrInvalidDateOfBirth,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.desc, func(t *testing.T) {
+			dateOfBirth, err := investors.ParseDateOfBirth(testCase.dateOfBirth)
+			if testCase.expectedErr != nil {
+				require.ErrorIs(t, err, testCase.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.dateOfBirth, dateOfBirth.String())
+			require.Equal(t, testCase.expectedAge, dateOfBirth.AgeOn(testCase.on))
+		})
+	}
+}
+
+func TestNINumber(t *testing.T) {
+	testCases := []struct {
+		desc             string
+		niNumber         string
+		expectedErr      error
+		expectedNINumber investors.NINumber
+	}{
+		{
+			desc:             "Valid",
+			niNumber:         "AB123456C",
+			expectedNINumber: "AB123456C",
+		},
+		{
+			desc:             "Spaced and lower case",
+			niNumber:         "ab 12 34 56 c",
+			expectedNINumber: "AB123456C",
+		},
+		{
+			desc:        "Invalid first letter",
+			niNumber:    "DA123456C",
+			expectedErr: investors.ErrInvalidNINumber,
+		},
+		{
+			desc:        "Invalid second letter",
+			niNumber:    "AO123456C",
+			expectedErr: investors.ErrInvalidNINumber,
+		},
+		{
+			desc:        "Unused prefix",
+			niNumber:    "GB123456C",
+			expectedErr: investors.ErrInvalidNINumber,
+		},
+		{
+			desc:        "Invalid suffix",
+			niNumber:    "AB123456E",
+			expectedErr: investors.ErrInvalidNINumber,
+		},
+		{
+			desc:        "Too few digits",
+			niNumber:    "AB12345C",
+			expectedErr: investors.ErrInvalidNINumber,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.desc, func(t *testing.T) {
+			niNumber, err := investors.NewNINumber(testCase.niNumber)
+			if testCase.expectedErr != nil {
+				require.ErrorIs(t, err, testCase.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedNINumber, niNumber)
+		})
+	}
+}
+
+func TestTaxResidency(t *testing.T) {
+	taxResidency, err := investors.NewTaxResidency("gb")
+	require.NoError(t, err)
+	require.True(t, taxResidency.IsUK())
+
+	taxResidency, err = investors.NewTaxResidency("FR")
+	require.NoError(t, err)
+	require.False(t, taxResidency.IsUK())
+
+	_, err = investors.NewTaxResidency("GBR")
+	require.ErrorIs(t, err, investors.ErrInvalidTaxResidency)
+}
+
+func TestAddress(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		lines       []string
+		postcode    string
+		country     string
+		expectedErr error
+		expected    investors.Address
+	}{
+		{
+			desc:     "UK address",
+			lines:    []string{"10 Downing Street", " ", "London"},
+			postcode: "sw1a 2aa",
+			country:  "gb",
+			expected: investors.Address{Lines: []string{"10 Downing Street", "London"}, Postcode: "SW1A 2AA", Country: "GB"},
+		},
+		{
+			desc:     "Overseas address without a postcode",
+			lines:    []string{"1 Rue de Rivoli", "Paris"},
+			country:  "FR",
+			expected: investors.Address{Lines: []string{"1 Rue de Rivoli", "Paris"}, Country: "FR"},
+		},
+		{
+			desc:        "UK address without a postcode",
+			lines:       []string{"10 Downing Street"},
+			country:     "GB",
+			expectedErr: investors.ErrInvalidAddress,
+		},
+		{
+			desc:        "No lines",
+			postcode:    "SW1A 2AA",
+			country:     "GB",
+			expectedErr: investors.ErrInvalidAddress,
+		},
+		{
+			desc:        "Too many lines",
+			lines:       []string{"1", "2", "3", "4", "5"},
+			country:     "FR",
+			expectedErr: investors.ErrInvalidAddress,
+		},
+		{
+			desc:        "Invalid country",
+			lines:       []string{"10 Downing Street"},
+			postcode:    "SW1A 2AA",
+			country:     "UK!",
+			expectedErr: investors.ErrInvalidAddress,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.desc, func(t *testing.T) {
+			address, err := investors.NewAddress(testCase.lines, testCase.postcode, testCase.country)
+			if testCase.expectedErr != nil {
+				require.ErrorIs(t, err, testCase.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, address)
+		})
+	}
+}
+
+func TestEmail(t *testing.T) {
+	email, err := investors.NewEmail("jane@example.com")
+	require.NoError(t, err)
+	require.Equal(t, investors.Email("jane@example.com"), email)
+
+	_, err = investors.NewEmail("Jane <jane@example.com>")
+	require.ErrorIs(t, err, investors.ErrInvalidEmail)
+
+	_, err = investors.NewEmail("not an email")
+	require.ErrorIs(t, err, investors.ErrInvalidEmail)
+}
+
+func TestValidateKYC(t *testing.T) {
+	on := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+	address, err := investors.NewAddress([]string{"10 Downing Street"}, "SW1A 2AA", "GB")
+	require.NoError(t, err)
+	adult, err := investors.ParseDateOfBirth("1990-01-31")
+	require.NoError(t, err)
+	child, err := investors.ParseDateOfBirth("2015-01-31")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		desc        string
+		profile     investors.Profile
+		expectedErr error
+	}{
+		{
+			desc:    "Complete",
+			profile: investors.Profile{DateOfBirth: adult, NINumber: "AB123456C", TaxResidency: investors.TaxResidencyUK, Address: address},
+		},
+		{
+			desc:    "Child without a national insurance number",
+			profile: investors.Profile{DateOfBirth: child, TaxResidency: investors.TaxResidencyUK, Address: address},
+		},
+		{
+			desc:        "Adult without a national insurance number",
+			profile:     investors.Profile{DateOfBirth: adult, TaxResidency: investors.TaxResidencyUK, Address: address},
+			expectedErr: investors.ErrKYCIncomplete,
+		},
+		{
+			desc:        "Empty",
+			expectedErr: investors.ErrKYCIncomplete,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.desc, func(t *testing.T) {
+			err := testCase.profile.ValidateKYC(on)
+			if testCase.expectedErr != nil {
+				require.ErrorIs(t, err, testCase.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -4,6 +4,11 @@ import "context"
 
 type Repository interface {
 	SaveInvestor(ctx context.Context, investor *Investor) error
+	GetInvestor(ctx context.Context, id InvestorId) (*Investor, error)
+	UpdateInvestor(ctx context.Context, investor *Investor, fields []UpdateField) (*Investor, error)
+	ListInvestors(ctx context.Context, namePrefix string, after *Cursor, limit int) ([]*Investor, error)
+	InvestorHasDeposits(ctx context.Context, id InvestorId) (bool, error)
+	DeleteInvestor(ctx context.Context, id InvestorId) error
 }
 
 type Service struct {
@@ -26,3 +31,73 @@ func (service Service) Onboard(ctx context.Context, investor *Investor) error {
 
 	return nil
 }
+
+// Get returns the investor with the given id
+func (service Service) Get(ctx context.Context, id InvestorId) (*Investor, error) {
+	return service.repository.GetInvestor(ctx, id)
+}
+
+// Update changes the fields of the investor the update names, returning the investor as they were and as they are now.
+// Only the named fields are saved, so updates of different fields made at the same time don't undo each other
+func (service Service) Update(ctx context.Context, id InvestorId, update Update) (*Investor, *Investor, error) {
+	investor, err := service.repository.GetInvestor(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	before := *investor
+
+	err = update.apply(investor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	investor, err = service.repository.UpdateInvestor(ctx, investor, update.Fields)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &before, investor, nil
+}
+
+// List returns a page of investors, ordered by name, whose names start with the filter's prefix
+func (service Service) List(ctx context.Context, filter ListFilter) (*Page, error) {
+	// Ask for one more than the page to know if there's another page after it
+	investors, err := service.repository.ListInvestors(ctx, filter.NamePrefix, filter.After, filter.PageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &Page{
+		Investors: investors,
+	}
+	if len(investors) > filter.PageSize {
+		page.Investors = investors[:filter.PageSize]
+		next := NewCursor(*page.Investors[filter.PageSize-1])
+		page.Next = &next
+	}
+
+	return page, nil
+}
+
+// Delete removes the investor, only if they don't own any deposits, returning the investor as they were
+func (service Service) Delete(ctx context.Context, id InvestorId) (*Investor, error) {
+	investor, err := service.repository.GetInvestor(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	hasDeposits, err := service.repository.InvestorHasDeposits(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if hasDeposits {
+		return nil, ErrInvestorHasDeposits
+	}
+
+	err = service.repository.DeleteInvestor(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return investor, nil
+}
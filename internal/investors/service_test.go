@@ -0,0 +1,273 @@
+package investors_test
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/iainvm/deposits/internal/investors"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryRepository keeps investors in memory, with the ids of those owning deposits
+type memoryRepository struct {
+	investors   map[investors.InvestorId]investors.Investor
+	hasDeposits map[investors.InvestorId]bool
+
+	// beforeUpdate is run once by the next UpdateInvestor, to change the investor while an update is being made
+	beforeUpdate func()
+}
+
+func newMemoryRepository() *memoryRepository {
+	return &memoryRepository{
+		investors:   map[investors.InvestorId]investors.Investor{},
+		hasDeposits: map[investors.InvestorId]bool{},
+	}
+}
+
+func (repository *memoryRepository) SaveInvestor(ctx context.Context, investor *investors.Investor) error {
+	repository.investors[investor.Id] = *investor
+	return nil
+}
+
+func (repository *memoryRepository) GetInvestor(ctx context.Context, id investors.InvestorId) (*investors.Investor, error) {
+	investor, ok := repository.investors[id]
+	if !ok {
+		return nil, investors.ErrInvestorNotFound
+	}
+	return &investor, nil
+}
+
+func (repository *memoryRepository) UpdateInvestor(ctx context.Context, investor *investors.Investor, fields []investors.UpdateField) (*investors.Investor, error) {
+	if beforeUpdate := repository.beforeUpdate; beforeUpdate != nil {
+		repository.beforeUpdate = nil
+		beforeUpdate()
+	}
+
+	// Only the fields named are saved, like the store
+	stored, ok := repository.investors[investor.Id]
+	if !ok {
+		return nil, investors.ErrInvestorNotFound
+	}
+	for _, field := range fields {
+		switch field {
+		case investors.UpdateFieldName:
+			stored.Name = investor.Name
+		case investors.UpdateFieldDateOfBirth:
+			stored.Profile.DateOfBirth = investor.Profile.DateOfBirth
+		case investors.UpdateFieldNINumber:
+			stored.Profile.NINumber = investor.Profile.NINumber
+		case investors.UpdateFieldTaxResidency:
+			stored.Profile.TaxResidency = investor.Profile.TaxResidency
+		case investors.UpdateFieldAddress:
+			stored.Profile.Address = investor.Profile.Address
+		case investors.UpdateFieldEmail:
+			stored.Profile.Email = investor.Profile.Email
+		}
+	}
+	repository.investors[investor.Id] = stored
+	return &stored, nil
+}
+
+func (repository *memoryRepository) ListInvestors(ctx context.Context, namePrefix string, after *investors.Cursor, limit int) ([]*investors.Investor, error) {
+	listed := []*investors.Investor{}
+	for _, investor := range repository.investors {
+		if !strings.HasPrefix(investor.Name.String(), namePrefix) {
+			continue
+		}
+		if after != nil && compareInvestor(investor, after.Name, after.Id) <= 0 {
+			continue
+		}
+		listed = append(listed, &investor)
+	}
+
+	slices.SortFunc(listed, func(a, b *investors.Investor) int {
+		return compareInvestor(*a, b.Name, b.Id)
+	})
+	return listed[:min(limit, len(listed))], nil
+}
+
+// compareInvestor orders investors by name then id, like the store
+func compareInvestor(investor investors.Investor, name investors.Name, id investors.InvestorId) int {
+	if investor.Name != name {
+		return strings.Compare(investor.Name.String(), name.String())
+	}
+	return strings.Compare(investor.Id.String(), id.String())
+}
+
+func (repository *memoryRepository) InvestorHasDeposits(ctx context.Context, id investors.InvestorId) (bool, error) {
+	return repository.hasDeposits[id], nil
+}
+
+func (repository *memoryRepository) DeleteInvestor(ctx context.Context, id investors.InvestorId) error {
+	if _, ok := repository.investors[id]; !ok {
+		return investors.ErrInvestorNotFound
+	}
+	delete(repository.investors, id)
+	return nil
+}
+
+// onboard onboards an investor for each name
+func onboard(t *testing.T, service *investors.Service, names ...string) []*investors.Investor {
+	t.Helper()
+
+	onboarded := []*investors.Investor{}
+	for _, name := range names {
+		investor, err := investors.NewInvestor(name)
+		require.NoError(t, err)
+		require.NoError(t, service.Onboard(context.Background(), investor))
+		onboarded = append(onboarded, investor)
+	}
+	return onboarded
+}
+
+func TestServiceUpdate(t *testing.T) {
+	service := investors.NewService(newMemoryRepository())
+	investor := onboard(t, service, "Jane")[0]
+
+	dateOfBirth, err := investors.ParseDateOfBirth("1990-01-31")
+	require.NoError(t, err)
+	profile := investors.Profile{DateOfBirth: dateOfBirth, TaxResidency: investors.TaxResidencyUK}
+
+	before, updated, err := service.Update(context.Background(), investor.Id, investors.Update{Name: "Janet", Profile: profile, Fields: investors.UpdateFields()})
+	require.NoError(t, err)
+	require.Equal(t, investor, before)
+	require.Equal(t, investors.Name("Janet"), updated.Name)
+	require.Equal(t, profile, updated.Profile)
+
+	got, err := service.Get(context.Background(), investor.Id)
+	require.NoError(t, err)
+	require.Equal(t, updated, got)
+
+	_, _, err = service.Update(context.Background(), investor.Id, investors.Update{Fields: investors.UpdateFields()})
+	require.ErrorIs(t, err, investors.ErrInvalidInvestor)
+
+	_, _, err = service.Update(context.Background(), investors.InvestorId(uuid.NewString()), investors.Update{Name: "Jane", Fields: investors.UpdateFields()})
+	require.ErrorIs(t, err, investors.ErrInvestorNotFound)
+
+	_, _, err = service.Update(context.Background(), investor.Id, investors.Update{Fields: []investors.UpdateField{"nickname"}})
+	require.ErrorIs(t, err, investors.ErrInvalidUpdateField)
+}
+
+func TestServiceUpdatePartial(t *testing.T) {
+	service := investors.NewService(newMemoryRepository())
+	investor := onboard(t, service, "Jane")[0]
+
+	dateOfBirth, err := investors.ParseDateOfBirth("1990-01-31")
+	require.NoError(t, err)
+	_, investor, err = service.Update(context.Background(), investor.Id, investors.Update{
+		Name:    "Jane",
+		Profile: investors.Profile{DateOfBirth: dateOfBirth, TaxResidency: investors.TaxResidencyUK},
+		Fields:  investors.UpdateFields(),
+	})
+	require.NoError(t, err)
+
+	// Only the email is changed, the name and the rest of the profile are kept
+	email, err := investors.NewEmail("jane@example.com")
+	require.NoError(t, err)
+	before, updated, err := service.Update(context.Background(), investor.Id, investors.Update{
+		Profile: investors.Profile{Email: email},
+		Fields:  []investors.UpdateField{investors.UpdateFieldEmail},
+	})
+	require.NoError(t, err)
+	require.Equal(t, investor, before)
+	require.Equal(t, investors.Name("Jane"), updated.Name)
+	require.Equal(t, dateOfBirth, updated.Profile.DateOfBirth)
+	require.Equal(t, investors.TaxResidencyUK, updated.Profile.TaxResidency)
+	require.Equal(t, email, updated.Profile.Email)
+
+	got, err := service.Get(context.Background(), investor.Id)
+	require.NoError(t, err)
+	require.Equal(t, updated, got)
+}
+
+func TestServiceUpdateConcurrently(t *testing.T) {
+	repository := newMemoryRepository()
+	service := investors.NewService(repository)
+	investor := onboard(t, service, "Jane")[0]
+
+	// The name is changed after the email update has read the investor
+	email, err := investors.NewEmail("jane@example.com")
+	require.NoError(t, err)
+	repository.beforeUpdate = func() {
+		_, _, err := service.Update(context.Background(), investor.Id, investors.Update{
+			Name:   "Janet",
+			Fields: []investors.UpdateField{investors.UpdateFieldName},
+		})
+		require.NoError(t, err)
+	}
+	_, updated, err := service.Update(context.Background(), investor.Id, investors.Update{
+		Profile: investors.Profile{Email: email},
+		Fields:  []investors.UpdateField{investors.UpdateFieldEmail},
+	})
+	require.NoError(t, err)
+
+	// Both changes are kept
+	require.Equal(t, investors.Name("Janet"), updated.Name)
+	require.Equal(t, email, updated.Profile.Email)
+
+	got, err := service.Get(context.Background(), investor.Id)
+	require.NoError(t, err)
+	require.Equal(t, updated, got)
+}
+
+func TestServiceList(t *testing.T) {
+	service := investors.NewService(newMemoryRepository())
+	onboard(t, service, "Jane", "Bob", "Janet", "Jack", "Alice")
+
+	// Page through the investors starting with J
+	names := []investors.Name{}
+	pageToken := ""
+	pages := 0
+	for {
+		filter, err := investors.NewListFilter("J", 2, pageToken)
+		require.NoError(t, err)
+
+		page, err := service.List(context.Background(), filter)
+		require.NoError(t, err)
+		pages++
+		for _, investor := range page.Investors {
+			names = append(names, investor.Name)
+		}
+
+		if page.Next == nil {
+			break
+		}
+		pageToken = page.Next.String()
+	}
+
+	require.Equal(t, []investors.Name{"Jack", "Jane", "Janet"}, names)
+	require.Equal(t, 2, pages)
+
+	// A full last page has no next page
+	filter, err := investors.NewListFilter("", 5, "")
+	require.NoError(t, err)
+	page, err := service.List(context.Background(), filter)
+	require.NoError(t, err)
+	require.Len(t, page.Investors, 5)
+	require.Nil(t, page.Next)
+}
+
+func TestServiceDelete(t *testing.T) {
+	repository := newMemoryRepository()
+	service := investors.NewService(repository)
+	onboarded := onboard(t, service, "Jane", "Bob")
+	jane, bob := onboarded[0], onboarded[1]
+	repository.hasDeposits[bob.Id] = true
+
+	deleted, err := service.Delete(context.Background(), jane.Id)
+	require.NoError(t, err)
+	require.Equal(t, jane, deleted)
+	_, err = service.Get(context.Background(), jane.Id)
+	require.ErrorIs(t, err, investors.ErrInvestorNotFound)
+
+	_, err = service.Delete(context.Background(), bob.Id)
+	require.ErrorIs(t, err, investors.ErrInvestorHasDeposits)
+	_, err = service.Get(context.Background(), bob.Id)
+	require.NoError(t, err)
+
+	_, err = service.Delete(context.Background(), jane.Id)
+	require.ErrorIs(t, err, investors.ErrInvestorNotFound)
+}
@@ -0,0 +1,82 @@
+package investors
+
+import (
+	"errors"
+	"slices"
+)
+
+var (
+	ErrInvalidUpdateField = errors.New("invalid update field")
+)
+
+// UpdateField names a part of an investor an Update can change on its own
+type UpdateField string
+
+const (
+	UpdateFieldName         UpdateField = "name"
+	UpdateFieldDateOfBirth  UpdateField = "date_of_birth"
+	UpdateFieldNINumber     UpdateField = "ni_number"
+	UpdateFieldTaxResidency UpdateField = "tax_residency"
+	UpdateFieldAddress      UpdateField = "address"
+	UpdateFieldEmail        UpdateField = "email"
+)
+
+// UpdateFields returns every field an investor can be updated on
+func UpdateFields() []UpdateField {
+	return []UpdateField{
+		UpdateFieldName,
+		UpdateFieldDateOfBirth,
+		UpdateFieldNINumber,
+		UpdateFieldTaxResidency,
+		UpdateFieldAddress,
+		UpdateFieldEmail,
+	}
+}
+
+func ParseUpdateField(field string) (UpdateField, error) {
+	updateField := UpdateField(field)
+	if !slices.Contains(UpdateFields(), updateField) {
+		return "", ErrInvalidUpdateField
+	}
+
+	return updateField, nil
+}
+
+func (field UpdateField) String() string {
+	return string(field)
+}
+
+// Update changes the investor's name and profile, only the fields it names are changed and the rest are left as they
+// are, so a field is cleared by naming it with a zero value
+type Update struct {
+	Name    string
+	Profile Profile
+	Fields  []UpdateField
+}
+
+// apply changes the investor's fields named by the update
+func (update Update) apply(investor *Investor) error {
+	for _, field := range update.Fields {
+		switch field {
+		case UpdateFieldName:
+			err := investor.Rename(update.Name)
+			if err != nil {
+				return err
+			}
+		case UpdateFieldDateOfBirth:
+			investor.Profile.DateOfBirth = update.Profile.DateOfBirth
+		case UpdateFieldNINumber:
+			investor.Profile.NINumber = update.Profile.NINumber
+		case UpdateFieldTaxResidency:
+			investor.Profile.TaxResidency = update.Profile.TaxResidency
+		case UpdateFieldAddress:
+			investor.Profile.Address = update.Profile.Address
+		case UpdateFieldEmail:
+			investor.Profile.Email = update.Profile.Email
+		default:
+			return ErrInvalidUpdateField
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package ledger
+
+import (
+	"slices"
+	"time"
+)
+
+// TrialBalanceLine is the total debited and credited to an account in a currency, in minor units
+type TrialBalanceLine struct {
+	Account  AccountCode
+	Currency string
+	Debits   int64
+	Credits  int64
+}
+
+// Balance is the account's debit balance, negative if it's in credit
+func (line TrialBalanceLine) Balance() int64 {
+	return line.Debits - line.Credits
+}
+
+// TrialBalance lists every account's totals from the journals posted up to AsOf
+type TrialBalance struct {
+	AsOf  time.Time
+	Lines []TrialBalanceLine
+}
+
+// TrialBalanceTotal is the total of all the lines in a currency
+type TrialBalanceTotal struct {
+	Currency string
+	Debits   int64
+	Credits  int64
+}
+
+// Totals adds up the lines in each currency, in currency order
+func (balance TrialBalance) Totals() []TrialBalanceTotal {
+	totals := map[string]*TrialBalanceTotal{}
+	currencies := []string{}
+	for _, line := range balance.Lines {
+		total, ok := totals[line.Currency]
+		if !ok {
+			total = &TrialBalanceTotal{Currency: line.Currency}
+			totals[line.Currency] = total
+			currencies = append(currencies, line.Currency)
+		}
+		total.Debits += line.Debits
+		total.Credits += line.Credits
+	}
+
+	slices.Sort(currencies)
+	result := []TrialBalanceTotal{}
+	for _, currency := range currencies {
+		result = append(result, *totals[currency])
+	}
+
+	return result
+}
+
+// Balanced reports if debits equal credits in every currency, which they always should as every journal balances
+func (balance TrialBalance) Balanced() bool {
+	for _, total := range balance.Totals() {
+		if total.Debits != total.Credits {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,49 @@
+package ledger_test
+
+import (
+	"testing"
+
+	"github.com/iainvm/deposits/internal/ledger"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrialBalance(t *testing.T) {
+	isa := ledger.InvestorLiability("ISA")
+
+	t.Run("balances when debits equal credits in each currency", func(t *testing.T) {
+		balance := ledger.TrialBalance{
+			Lines: []ledger.TrialBalanceLine{
+				{Account: ledger.AccountClientMoneyBank, Currency: "GBP", Debits: 150, Credits: 40},
+				{Account: ledger.AccountClientMoneyBank, Currency: "EUR", Debits: 20},
+				{Account: ledger.AccountSuspense, Currency: "GBP", Debits: 60, Credits: 100},
+				{Account: isa, Currency: "GBP", Debits: 40, Credits: 110},
+				{Account: isa, Currency: "EUR", Credits: 20},
+			},
+		}
+
+		require.Equal(t, []ledger.TrialBalanceTotal{
+			{Currency: "EUR", Debits: 20, Credits: 20},
+			{Currency: "GBP", Debits: 250, Credits: 250},
+		}, balance.Totals())
+		require.True(t, balance.Balanced())
+		require.Equal(t, int64(110), balance.Lines[0].Balance())
+		require.Equal(t, int64(-70), balance.Lines[3].Balance())
+	})
+
+	t.Run("doesn't balance when a currency is out", func(t *testing.T) {
+		balance := ledger.TrialBalance{
+			Lines: []ledger.TrialBalanceLine{
+				{Account: ledger.AccountClientMoneyBank, Currency: "GBP", Debits: 100},
+				{Account: isa, Currency: "GBP", Credits: 100},
+				{Account: ledger.AccountClientMoneyBank, Currency: "EUR", Debits: 10},
+			},
+		}
+
+		require.False(t, balance.Balanced())
+	})
+
+	t.Run("balances when empty", func(t *testing.T) {
+		require.True(t, ledger.TrialBalance{}.Balanced())
+		require.Empty(t, ledger.TrialBalance{}.Totals())
+	})
+}
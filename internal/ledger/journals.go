@@ -0,0 +1,174 @@
+package ledger
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrIdGeneration       = errors.New("failed to generate id")
+	ErrUnbalancedJournal  = errors.New("journal debits and credits don't balance")
+	ErrEmptyJournal       = errors.New("journal needs at least two entries")
+	ErrZeroEntry          = errors.New("journal entry for nothing")
+	ErrInvalidAccountCode = errors.New("invalid ledger account code given")
+	ErrInvalidCurrency    = errors.New("invalid currency given")
+)
+
+// AccountCode identifies an account in the ledger
+type AccountCode string
+
+const (
+	// AccountClientMoneyBank is the bank account client money is paid into, an asset
+	AccountClientMoneyBank AccountCode = "CLIENT_MONEY_BANK"
+	// AccountSuspense is client money that's been received but not yet allocated to an investor's account, a liability
+	AccountSuspense AccountCode = "SUSPENSE"
+	// investorLiabilityPrefix starts the codes of the money owed to investors in each wrapper, a liability
+	investorLiabilityPrefix = "INVESTOR_LIABILITY:"
+)
+
+// InvestorLiability is the account for the money held for investors in the wrapper, given by its code, e.g. "ISA"
+func InvestorLiability(wrapperCode string) AccountCode {
+	return AccountCode(investorLiabilityPrefix + wrapperCode)
+}
+
+// ParseAccountCode ensures the code is one of the ledger's accounts
+func ParseAccountCode(code string) (AccountCode, error) {
+	switch {
+	case code == AccountClientMoneyBank.String(), code == AccountSuspense.String():
+		return AccountCode(code), nil
+	case strings.HasPrefix(code, investorLiabilityPrefix) && len(code) > len(investorLiabilityPrefix):
+		return AccountCode(code), nil
+	}
+
+	return "", ErrInvalidAccountCode
+}
+
+func (code AccountCode) String() string {
+	return string(code)
+}
+
+// currencyPattern matches ISO 4217 codes, the ledger doesn't limit them to the currencies deposits supports
+var currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// Entry is one line of a journal, a positive Amount is a debit and a negative one a credit, in minor units
+type Entry struct {
+	Account  AccountCode
+	Currency string
+	Amount   int64
+}
+
+// Debit creates an entry debiting the account by the amount
+func Debit(account AccountCode, currency string, amount int64) Entry {
+	return Entry{Account: account, Currency: currency, Amount: amount}
+}
+
+// Credit creates an entry crediting the account by the amount
+func Credit(account AccountCode, currency string, amount int64) Entry {
+	return Entry{Account: account, Currency: currency, Amount: -amount}
+}
+
+func (entry Entry) IsDebit() bool {
+	return entry.Amount > 0
+}
+
+type JournalId string
+
+func newJournalId() (JournalId, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return "", errors.Join(ErrIdGeneration, err)
+	}
+
+	return JournalId(id.String()), nil
+}
+
+func ParseJournalId(id string) (JournalId, error) {
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return "", err
+	}
+
+	return JournalId(id), nil
+}
+
+func (id JournalId) String() string {
+	return string(id)
+}
+
+// Journal is a set of entries posted together, which always balance
+type Journal struct {
+	Id JournalId
+	// Reference is the id of what the journal was posted for, e.g. a receipt
+	Reference   string
+	Description string
+	PostedAt    time.Time
+	Entries     []Entry
+}
+
+// NewJournal creates a new Journal with a new Id, if the entries balance
+func NewJournal(reference string, description string, postedAt time.Time, entries ...Entry) (*Journal, error) {
+	id, err := newJournalId()
+	if err != nil {
+		return nil, err
+	}
+
+	journal := &Journal{
+		Id:          id,
+		Reference:   reference,
+		Description: description,
+		PostedAt:    postedAt,
+		Entries:     entries,
+	}
+	err = journal.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return journal, nil
+}
+
+// Validate checks the journal's invariant, that its debits and credits sum to zero in each currency
+func (journal Journal) Validate() error {
+	if len(journal.Entries) < 2 {
+		return ErrEmptyJournal
+	}
+
+	totals := map[string]int64{}
+	for _, entry := range journal.Entries {
+		_, err := ParseAccountCode(entry.Account.String())
+		if err != nil {
+			return err
+		}
+		if !currencyPattern.MatchString(entry.Currency) {
+			return ErrInvalidCurrency
+		}
+		if entry.Amount == 0 {
+			return ErrZeroEntry
+		}
+
+		totals[entry.Currency] += entry.Amount
+	}
+
+	for _, total := range totals {
+		if total != 0 {
+			return ErrUnbalancedJournal
+		}
+	}
+
+	return nil
+}
+
+// Reversal creates a new Journal undoing this one, with each of its entries the other way round
+func (journal Journal) Reversal(reference string, description string, postedAt time.Time) (*Journal, error) {
+	entries := []Entry{}
+	for _, entry := range journal.Entries {
+		entry.Amount = -entry.Amount
+		entries = append(entries, entry)
+	}
+
+	return NewJournal(reference, description, postedAt, entries...)
+}
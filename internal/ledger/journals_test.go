@@ -0,0 +1,158 @@
+package ledger_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iainvm/deposits/internal/ledger"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAccountCode(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		code     string
+		expected error
+	}{
+		{desc: "passes for the bank", code: "CLIENT_MONEY_BANK"},
+		{desc: "passes for suspense", code: "SUSPENSE"},
+		{desc: "passes for a wrapper's liability", code: "INVESTOR_LIABILITY:ISA"},
+		{desc: "fails for a liability without a wrapper", code: "INVESTOR_LIABILITY:", expected: ledger.ErrInvalidAccountCode},
+		{desc: "fails for an unknown account", code: "PETTY_CASH", expected: ledger.ErrInvalidAccountCode},
+		{desc: "fails for blank", code: "", expected: ledger.ErrInvalidAccountCode},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.desc, func(t *testing.T) {
+			code, err := ledger.ParseAccountCode(testCase.code)
+			if testCase.expected != nil {
+				require.ErrorIs(t, err, testCase.expected)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.code, code.String())
+		})
+	}
+}
+
+func TestNewJournal(t *testing.T) {
+	isa := ledger.InvestorLiability("ISA")
+	gia := ledger.InvestorLiability("GIA")
+
+	testCases := []struct {
+		desc     string
+		entries  []ledger.Entry
+		expected error
+	}{
+		{
+			desc: "passes for balanced entries",
+			entries: []ledger.Entry{
+				ledger.Debit(ledger.AccountClientMoneyBank, "GBP", 100),
+				ledger.Credit(isa, "GBP", 60),
+				ledger.Credit(gia, "GBP", 40),
+			},
+		},
+		{
+			desc: "passes for entries balanced in each currency",
+			entries: []ledger.Entry{
+				ledger.Debit(ledger.AccountClientMoneyBank, "GBP", 100),
+				ledger.Credit(isa, "GBP", 100),
+				ledger.Debit(ledger.AccountClientMoneyBank, "EUR", 50),
+				ledger.Credit(gia, "EUR", 50),
+			},
+		},
+		{
+			desc: "fails for unbalanced entries",
+			entries: []ledger.Entry{
+				ledger.Debit(ledger.AccountClientMoneyBank, "GBP", 100),
+				ledger.Credit(isa, "GBP", 99),
+			},
+			expected: ledger.ErrUnbalancedJournal,
+		},
+		{
+			desc: "fails for entries balanced across currencies",
+			entries: []ledger.Entry{
+				ledger.Debit(ledger.AccountClientMoneyBank, "GBP", 100),
+				ledger.Credit(isa, "EUR", 100),
+			},
+			expected: ledger.ErrUnbalancedJournal,
+		},
+		{
+			desc: "fails for a single entry",
+			entries: []ledger.Entry{
+				ledger.Debit(ledger.AccountClientMoneyBank, "GBP", 100),
+			},
+			expected: ledger.ErrEmptyJournal,
+		},
+		{
+			desc:     "fails without entries",
+			expected: ledger.ErrEmptyJournal,
+		},
+		{
+			desc: "fails for an entry for nothing",
+			entries: []ledger.Entry{
+				ledger.Debit(ledger.AccountClientMoneyBank, "GBP", 0),
+				ledger.Credit(isa, "GBP", 0),
+			},
+			expected: ledger.ErrZeroEntry,
+		},
+		{
+			desc: "fails for an unknown account",
+			entries: []ledger.Entry{
+				ledger.Debit("PETTY_CASH", "GBP", 100),
+				ledger.Credit(isa, "GBP", 100),
+			},
+			expected: ledger.ErrInvalidAccountCode,
+		},
+		{
+			desc: "fails for an invalid currency",
+			entries: []ledger.Entry{
+				ledger.Debit(ledger.AccountClientMoneyBank, "gbp", 100),
+				ledger.Credit(isa, "gbp", 100),
+			},
+			expected: ledger.ErrInvalidCurrency,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.desc, func(t *testing.T) {
+			postedAt := time.Date(2024, 4, 6, 0, 0, 0, 0, time.UTC)
+			journal, err := ledger.NewJournal("REF-1", "receipt", postedAt, testCase.entries...)
+			if testCase.expected != nil {
+				require.ErrorIs(t, err, testCase.expected)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotEmpty(t, journal.Id)
+			require.Equal(t, "REF-1", journal.Reference)
+			require.Equal(t, postedAt, journal.PostedAt)
+			require.Equal(t, testCase.entries, journal.Entries)
+		})
+	}
+}
+
+func TestJournalReversal(t *testing.T) {
+	isa := ledger.InvestorLiability("ISA")
+	journal, err := ledger.NewJournal(
+		"REF-1",
+		"receipt",
+		time.Now(),
+		ledger.Debit(ledger.AccountClientMoneyBank, "GBP", 100),
+		ledger.Credit(isa, "GBP", 100),
+	)
+	require.NoError(t, err)
+
+	reversal, err := journal.Reversal("REF-2", "reversal", time.Now())
+	require.NoError(t, err)
+	require.NotEqual(t, journal.Id, reversal.Id)
+	require.Equal(t, "REF-2", reversal.Reference)
+	require.Equal(t, []ledger.Entry{
+		ledger.Credit(ledger.AccountClientMoneyBank, "GBP", 100),
+		ledger.Debit(isa, "GBP", 100),
+	}, reversal.Entries)
+
+	// The original is unchanged
+	require.True(t, journal.Entries[0].IsDebit())
+}
@@ -0,0 +1,150 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/iainvm/deposits/internal/ledger"
+)
+
+var (
+	ErrSaveFailed = errors.New("failed to save journal")
+)
+
+// Queryer runs queries against either the database or a transaction
+type Queryer interface {
+	GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	NamedExecContext(ctx context.Context, query string, arg interface{}) (sql.Result, error)
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+type Store struct {
+	db Queryer
+}
+
+// NewStore creates a Store on the database, or on a transaction so the journals it saves commit with everything else in
+// it
+func NewStore(db Queryer) Store {
+	return Store{
+		db: db,
+	}
+}
+
+type JournalRow struct {
+	Id          string    `db:"id"`
+	Reference   string    `db:"reference"`
+	Description string    `db:"description"`
+	PostedAt    time.Time `db:"posted_at"`
+}
+
+type JournalEntryRow struct {
+	JournalId string `db:"journal_id"`
+	Line      int    `db:"line"`
+	Account   string `db:"account"`
+	Currency  string `db:"currency"`
+	Amount    int64  `db:"amount"`
+}
+
+// SaveJournal saves the journal with its entries, the database checks they balance when the transaction commits
+func (store Store) SaveJournal(ctx context.Context, journal ledger.Journal) error {
+	// Don't save anything that doesn't balance
+	err := journal.Validate()
+	if err != nil {
+		return err
+	}
+
+	// Define query separately for easy editting
+	const journalQuery = `--sql
+	INSERT INTO journals (id, reference, description, posted_at)
+	VALUES (:id, :reference, :description, :posted_at)
+	`
+	const entryQuery = `--sql
+	INSERT INTO journal_entries (journal_id, line, account, currency, amount)
+	VALUES (:journal_id, :line, :account, :currency, :amount)
+	`
+
+	// Create Row
+	row := JournalRow{
+		Id:          journal.Id.String(),
+		Reference:   journal.Reference,
+		Description: journal.Description,
+		PostedAt:    journal.PostedAt,
+	}
+
+	// Execute query
+	_, err = store.db.NamedExecContext(
+		ctx,
+		journalQuery,
+		row,
+	)
+	if err != nil {
+		return errors.Join(ErrSaveFailed, err)
+	}
+
+	for i, entry := range journal.Entries {
+		entryRow := JournalEntryRow{
+			JournalId: journal.Id.String(),
+			Line:      i + 1,
+			Account:   entry.Account.String(),
+			Currency:  entry.Currency,
+			Amount:    entry.Amount,
+		}
+
+		_, err = store.db.NamedExecContext(
+			ctx,
+			entryQuery,
+			entryRow,
+		)
+		if err != nil {
+			return errors.Join(ErrSaveFailed, err)
+		}
+	}
+
+	return nil
+}
+
+type TrialBalanceRow struct {
+	Account  string `db:"account"`
+	Currency string `db:"currency"`
+	Debits   int64  `db:"debits"`
+	Credits  int64  `db:"credits"`
+}
+
+func (store Store) GetTrialBalanceLines(ctx context.Context, asOf time.Time) ([]ledger.TrialBalanceLine, error) {
+	const query = `--sql
+	SELECT e.account,
+		e.currency,
+		COALESCE(SUM(e.amount) FILTER (WHERE e.amount > 0), 0) AS debits,
+		COALESCE(-SUM(e.amount) FILTER (WHERE e.amount < 0), 0) AS credits
+	FROM journal_entries e
+	JOIN journals j ON j.id = e.journal_id
+	WHERE j.posted_at <= $1
+	GROUP BY e.account, e.currency
+	ORDER BY e.account, e.currency
+	`
+
+	rows := []TrialBalanceRow{}
+	err := store.db.SelectContext(ctx, &rows, query, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := []ledger.TrialBalanceLine{}
+	for _, row := range rows {
+		account, err := ledger.ParseAccountCode(row.Account)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, ledger.TrialBalanceLine{
+			Account:  account,
+			Currency: row.Currency,
+			Debits:   row.Debits,
+			Credits:  row.Credits,
+		})
+	}
+
+	return lines, nil
+}
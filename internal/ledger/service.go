@@ -0,0 +1,34 @@
+package ledger
+
+import (
+	"context"
+	"time"
+)
+
+// Repository reads the ledger, journals are saved by whatever posts them, in the same transaction as what they're for
+type Repository interface {
+	GetTrialBalanceLines(ctx context.Context, asOf time.Time) ([]TrialBalanceLine, error)
+}
+
+type Service struct {
+	repository Repository
+}
+
+func NewService(repository Repository) *Service {
+	return &Service{
+		repository: repository,
+	}
+}
+
+// TrialBalance totals every account from the journals posted up to asOf
+func (service Service) TrialBalance(ctx context.Context, asOf time.Time) (*TrialBalance, error) {
+	lines, err := service.repository.GetTrialBalanceLines(ctx, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TrialBalance{
+		AsOf:  asOf,
+		Lines: lines,
+	}, nil
+}
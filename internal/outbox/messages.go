@@ -0,0 +1,123 @@
+package outbox
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrIdGeneration = errors.New("failed to generate id")
+	ErrInvalidTopic = errors.New("invalid outbox topic given")
+)
+
+const (
+	// RetryBaseDelay is how long a message waits before it's retried the first time, doubling each time it fails again
+	RetryBaseDelay = time.Second
+	// RetryMaxDelay caps how long a message waits between retries, it's retried until it's published
+	RetryMaxDelay = 10 * time.Minute
+)
+
+type MessageId string
+
+func newMessageId() (MessageId, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return "", errors.Join(ErrIdGeneration, err)
+	}
+
+	return MessageId(id.String()), nil
+}
+
+func ParseMessageId(id string) (MessageId, error) {
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return "", err
+	}
+
+	return MessageId(id), nil
+}
+
+func (id MessageId) String() string {
+	return string(id)
+}
+
+// Message is an event waiting in the outbox to be published, it's saved in the same transaction as the change it's
+// about, so it's only published if the change was made
+type Message struct {
+	Id MessageId
+	// Topic is the type of event, e.g. "DEPOSIT_STATUS_CHANGED"
+	Topic string
+	// Key is the id of what the event happened to, subscribers can use it to group a thing's events
+	Key string
+	// Payload is the JSON encoded event
+	Payload    []byte
+	OccurredAt time.Time
+	// Attempts is how many times publishing the message has failed
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	// PublishedAt is nil until the message is published
+	PublishedAt *time.Time
+}
+
+// NewMessage creates a Message with a new Id, due to be published straight away
+func NewMessage(topic string, key string, payload []byte, occurredAt time.Time) (*Message, error) {
+	if topic == "" {
+		return nil, ErrInvalidTopic
+	}
+
+	// Generate Id
+	id, err := newMessageId()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Message{
+		Id:            id,
+		Topic:         topic,
+		Key:           key,
+		Payload:       payload,
+		OccurredAt:    occurredAt,
+		NextAttemptAt: occurredAt,
+	}, nil
+}
+
+// Published marks the message as published, it won't be published again
+func (message *Message) Published(publishedAt time.Time) {
+	message.PublishedAt = &publishedAt
+	message.LastError = ""
+}
+
+// Failed records a failed attempt to publish the message, delaying it before it's retried
+func (message *Message) Failed(err error, failedAt time.Time) {
+	message.Attempts++
+	message.NextAttemptAt = failedAt.Add(Backoff(message.Attempts))
+	message.LastError = err.Error()
+}
+
+// HoldBehind delays the message until the earlier message with the same key is retried, so it isn't published first
+func (message *Message) HoldBehind(earlier Message) {
+	message.NextAttemptAt = earlier.NextAttemptAt
+}
+
+// Backoff is how long to wait before retrying a message that's failed the number of attempts, doubling from
+// RetryBaseDelay up to RetryMaxDelay
+func Backoff(attempts int) time.Duration {
+	return ExponentialBackoff(attempts, RetryBaseDelay, RetryMaxDelay)
+}
+
+// ExponentialBackoff is how long to wait before retrying something that's failed the number of attempts, doubling from
+// the base delay after the first attempt up to the max delay
+func ExponentialBackoff(attempts int, base time.Duration, max time.Duration) time.Duration {
+	delay := base
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= max {
+			return max
+		}
+	}
+
+	return delay
+}
@@ -0,0 +1,66 @@
+package outbox_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/iainvm/deposits/internal/outbox"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMessage(t *testing.T) {
+	occurredAt := time.Date(2024, 4, 6, 9, 0, 0, 0, time.UTC)
+
+	t.Run("is due straight away", func(t *testing.T) {
+		message, err := outbox.NewMessage("DEPOSIT_STATUS_CHANGED", "key", []byte(`{}`), occurredAt)
+		require.NoError(t, err)
+
+		require.NotEmpty(t, message.Id)
+		require.Equal(t, occurredAt, message.NextAttemptAt)
+		require.Zero(t, message.Attempts)
+		require.Nil(t, message.PublishedAt)
+	})
+
+	t.Run("fails without a topic", func(t *testing.T) {
+		_, err := outbox.NewMessage("", "key", []byte(`{}`), occurredAt)
+		require.ErrorIs(t, err, outbox.ErrInvalidTopic)
+	})
+}
+
+func TestBackoff(t *testing.T) {
+	testCases := []struct {
+		attempts int
+		expected time.Duration
+	}{
+		{attempts: 1, expected: time.Second},
+		{attempts: 2, expected: 2 * time.Second},
+		{attempts: 3, expected: 4 * time.Second},
+		{attempts: 10, expected: 512 * time.Second},
+		{attempts: 11, expected: outbox.RetryMaxDelay},
+		{attempts: 1000, expected: outbox.RetryMaxDelay},
+	}
+
+	for _, testCase := range testCases {
+		require.Equal(t, testCase.expected, outbox.Backoff(testCase.attempts), "attempts %d", testCase.attempts)
+	}
+}
+
+func TestMessageFailed(t *testing.T) {
+	occurredAt := time.Date(2024, 4, 6, 9, 0, 0, 0, time.UTC)
+	message, err := outbox.NewMessage("DEPOSIT_STATUS_CHANGED", "key", []byte(`{}`), occurredAt)
+	require.NoError(t, err)
+
+	message.Failed(errors.New("connection refused"), occurredAt)
+	require.Equal(t, 1, message.Attempts)
+	require.Equal(t, occurredAt.Add(time.Second), message.NextAttemptAt)
+	require.Equal(t, "connection refused", message.LastError)
+
+	message.Failed(errors.New("connection refused"), occurredAt.Add(time.Second))
+	require.Equal(t, 2, message.Attempts)
+	require.Equal(t, occurredAt.Add(3*time.Second), message.NextAttemptAt)
+
+	message.Published(occurredAt.Add(3 * time.Second))
+	require.Equal(t, occurredAt.Add(3*time.Second), *message.PublishedAt)
+	require.Empty(t, message.LastError)
+}
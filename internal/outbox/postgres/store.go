@@ -0,0 +1,234 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/iainvm/deposits/internal/outbox"
+)
+
+var (
+	ErrSaveFailed = errors.New("failed to save outbox message")
+)
+
+// Queryer runs queries against either the database or a transaction
+type Queryer interface {
+	GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	NamedExecContext(ctx context.Context, query string, arg interface{}) (sql.Result, error)
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+type Store struct {
+	db Queryer
+}
+
+// NewStore creates a Store on the database, or on a transaction so the messages it saves commit with the changes
+// they're about
+func NewStore(db Queryer) Store {
+	return Store{
+		db: db,
+	}
+}
+
+type MessageRow struct {
+	Id            string       `db:"id"`
+	Topic         string       `db:"topic"`
+	Key           string       `db:"key"`
+	Payload       string       `db:"payload"`
+	OccurredAt    time.Time    `db:"occurred_at"`
+	Attempts      int          `db:"attempts"`
+	NextAttemptAt time.Time    `db:"next_attempt_at"`
+	LastError     string       `db:"last_error"`
+	PublishedAt   sql.NullTime `db:"published_at"`
+}
+
+func (store Store) SaveMessage(ctx context.Context, message outbox.Message) error {
+	// Define query separately for easy editting
+	const query = `--sql
+	INSERT INTO outbox (id, topic, key, payload, occurred_at, attempts, next_attempt_at, last_error)
+	VALUES (:id, :topic, :key, :payload, :occurred_at, :attempts, :next_attempt_at, :last_error)
+	`
+
+	// Create Row
+	row := createMessageRow(message)
+
+	// Execute query
+	_, err := store.db.NamedExecContext(
+		ctx,
+		query,
+		row,
+	)
+	if err != nil {
+		return errors.Join(ErrSaveFailed, err)
+	}
+
+	return nil
+}
+
+// ClaimDueMessages moves the next attempt of the messages it returns on by the lease in the same statement it selects
+// them, and skips rows locked by another relay claiming them, so concurrent relays don't publish the same messages
+//
+// A message is only claimed if every earlier unpublished message for its key is claimed with it. One locked by another
+// relay, or already moved on by that relay's claim, is left out of the batch, so its later ones are held back until
+// that relay's done with it. Keys held back by a message waiting to be retried are left out before the limit, so they
+// don't fill the batch
+func (store Store) ClaimDueMessages(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]outbox.Message, error) {
+	const query = `--sql
+	WITH candidates AS (
+		SELECT id, key, occurred_at
+		FROM outbox
+		WHERE published_at IS NULL
+			AND next_attempt_at <= $1
+			AND NOT EXISTS (
+				SELECT 1
+				FROM outbox earlier
+				WHERE earlier.key = outbox.key
+					AND earlier.published_at IS NULL
+					AND earlier.next_attempt_at > $1
+					AND (earlier.occurred_at, earlier.id) < (outbox.occurred_at, outbox.id)
+			)
+		ORDER BY occurred_at, id
+		LIMIT $3
+		FOR UPDATE SKIP LOCKED
+	)
+	UPDATE outbox
+	SET next_attempt_at = $2
+	WHERE id IN (
+		SELECT candidates.id
+		FROM candidates
+		WHERE NOT EXISTS (
+			SELECT 1
+			FROM outbox earlier
+			WHERE earlier.key = candidates.key
+				AND earlier.published_at IS NULL
+				AND (earlier.occurred_at, earlier.id) < (candidates.occurred_at, candidates.id)
+				AND earlier.id NOT IN (SELECT id FROM candidates)
+		)
+	)
+	RETURNING id, topic, key, payload, occurred_at, attempts, next_attempt_at, last_error, published_at
+	`
+
+	rows := []MessageRow{}
+	err := store.db.SelectContext(ctx, &rows, query, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := []outbox.Message{}
+	for _, row := range rows {
+		message, err := createDomainMessage(row)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, *message)
+	}
+
+	// Returned rows aren't ordered
+	slices.SortFunc(messages, func(message outbox.Message, other outbox.Message) int {
+		return message.OccurredAt.Compare(other.OccurredAt)
+	})
+
+	return messages, nil
+}
+
+func (store Store) UpdateMessage(ctx context.Context, message outbox.Message) error {
+	// Define query separately for easy editting
+	const query = `--sql
+	UPDATE outbox
+	SET attempts=:attempts,
+		next_attempt_at=:next_attempt_at,
+		last_error=:last_error,
+		published_at=:published_at
+	WHERE id=:id
+	`
+
+	// Create Row
+	row := createMessageRow(message)
+
+	// Execute query
+	_, err := store.db.NamedExecContext(
+		ctx,
+		query,
+		row,
+	)
+	if err != nil {
+		return errors.Join(ErrSaveFailed, err)
+	}
+
+	return nil
+}
+
+// DeletePublishedMessages deletes the oldest published messages first, a batch at a time so it doesn't hold locks on
+// the whole outbox
+func (store Store) DeletePublishedMessages(ctx context.Context, before time.Time, limit int) (int, error) {
+	// Define query separately for easy editting
+	const query = `--sql
+	DELETE FROM outbox
+	WHERE id IN (
+		SELECT id
+		FROM outbox
+		WHERE published_at IS NOT NULL
+			AND published_at < $1
+		ORDER BY published_at
+		LIMIT $2
+	)
+	`
+
+	// Execute query
+	result, err := store.db.ExecContext(ctx, query, before, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(deleted), nil
+}
+
+func createMessageRow(message outbox.Message) MessageRow {
+	row := MessageRow{
+		Id:            message.Id.String(),
+		Topic:         message.Topic,
+		Key:           message.Key,
+		Payload:       string(message.Payload),
+		OccurredAt:    message.OccurredAt,
+		Attempts:      message.Attempts,
+		NextAttemptAt: message.NextAttemptAt,
+		LastError:     message.LastError,
+	}
+	if message.PublishedAt != nil {
+		row.PublishedAt = sql.NullTime{Time: *message.PublishedAt, Valid: true}
+	}
+
+	return row
+}
+
+func createDomainMessage(row MessageRow) (*outbox.Message, error) {
+	id, err := outbox.ParseMessageId(row.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	message := &outbox.Message{
+		Id:            id,
+		Topic:         row.Topic,
+		Key:           row.Key,
+		Payload:       []byte(row.Payload),
+		OccurredAt:    row.OccurredAt,
+		Attempts:      row.Attempts,
+		NextAttemptAt: row.NextAttemptAt,
+		LastError:     row.LastError,
+	}
+	if row.PublishedAt.Valid {
+		message.PublishedAt = &row.PublishedAt.Time
+	}
+
+	return message, nil
+}
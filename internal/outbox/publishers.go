@@ -0,0 +1,116 @@
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+)
+
+var (
+	ErrPublishFailed = errors.New("failed to publish message")
+)
+
+// Publisher sends messages on to whatever's subscribed to them
+//
+// Messages are published at least once, a message may be published again if the relay fails before recording it was
+// published, so subscribers should use the message id to ignore repeats.
+// A key's messages are published in the order they occurred, later ones wait while an earlier one is retried.
+type Publisher interface {
+	Publish(ctx context.Context, message Message) error
+}
+
+// Publishers publishes each message to every one of its publishers in order, failing if any of them do
+//
+// A message that fails is published again to all of them, including those it was already published to
+type Publishers []Publisher
+
+func (publishers Publishers) Publish(ctx context.Context, message Message) error {
+	for _, publisher := range publishers {
+		err := publisher.Publish(ctx, message)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MemoryPublisher keeps the messages published to it, for tests and running locally
+type MemoryPublisher struct {
+	mu       sync.Mutex
+	messages []Message
+}
+
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{}
+}
+
+func (publisher *MemoryPublisher) Publish(ctx context.Context, message Message) error {
+	publisher.mu.Lock()
+	defer publisher.mu.Unlock()
+
+	publisher.messages = append(publisher.messages, message)
+	return nil
+}
+
+// Messages returns the messages published so far, in the order they were published
+func (publisher *MemoryPublisher) Messages() []Message {
+	publisher.mu.Lock()
+	defer publisher.mu.Unlock()
+
+	return slices.Clone(publisher.messages)
+}
+
+// Header names the WebhookPublisher sends each message's details in, the body is the message's payload
+const (
+	HeaderMessageId  = "Outbox-Message-Id"
+	HeaderTopic      = "Outbox-Topic"
+	HeaderKey        = "Outbox-Key"
+	HeaderOccurredAt = "Outbox-Occurred-At"
+)
+
+// WebhookPublisher publishes messages by POSTing them to a URL, any response other than a 2xx is a failure
+type WebhookPublisher struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhookPublisher(url string, client *http.Client) *WebhookPublisher {
+	return &WebhookPublisher{
+		url:    url,
+		client: client,
+	}
+}
+
+func (publisher *WebhookPublisher) Publish(ctx context.Context, message Message) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, publisher.url, bytes.NewReader(message.Payload))
+	if err != nil {
+		return errors.Join(ErrPublishFailed, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderMessageId, message.Id.String())
+	req.Header.Set(HeaderTopic, message.Topic)
+	req.Header.Set(HeaderKey, message.Key)
+	req.Header.Set(HeaderOccurredAt, message.OccurredAt.UTC().Format(time.RFC3339Nano))
+
+	res, err := publisher.client.Do(req)
+	if err != nil {
+		return errors.Join(ErrPublishFailed, err)
+	}
+	defer res.Body.Close()
+
+	// Drain the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return errors.Join(ErrPublishFailed, fmt.Errorf("webhook responded %s", res.Status))
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package outbox_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/iainvm/deposits/internal/outbox"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookPublisher(t *testing.T) {
+	occurredAt := time.Date(2024, 4, 6, 9, 0, 0, 0, time.UTC)
+	message, err := outbox.NewMessage("DEPOSIT_STATUS_CHANGED", "deposit-id", []byte(`{"to":"FUNDED"}`), occurredAt)
+	require.NoError(t, err)
+
+	t.Run("posts the message", func(t *testing.T) {
+		var received *http.Request
+		var body []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r
+			body, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		publisher := outbox.NewWebhookPublisher(server.URL, server.Client())
+		err := publisher.Publish(context.Background(), *message)
+		require.NoError(t, err)
+
+		require.Equal(t, http.MethodPost, received.Method)
+		require.Equal(t, "application/json", received.Header.Get("Content-Type"))
+		require.Equal(t, message.Id.String(), received.Header.Get(outbox.HeaderMessageId))
+		require.Equal(t, "DEPOSIT_STATUS_CHANGED", received.Header.Get(outbox.HeaderTopic))
+		require.Equal(t, "deposit-id", received.Header.Get(outbox.HeaderKey))
+		require.Equal(t, "2024-04-06T09:00:00Z", received.Header.Get(outbox.HeaderOccurredAt))
+		require.JSONEq(t, `{"to":"FUNDED"}`, string(body))
+	})
+
+	t.Run("fails when the webhook doesn't accept it", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		publisher := outbox.NewWebhookPublisher(server.URL, server.Client())
+		err := publisher.Publish(context.Background(), *message)
+		require.ErrorIs(t, err, outbox.ErrPublishFailed)
+	})
+
+	t.Run("fails when the webhook can't be reached", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		server.Close()
+
+		publisher := outbox.NewWebhookPublisher(server.URL, server.Client())
+		err := publisher.Publish(context.Background(), *message)
+		require.ErrorIs(t, err, outbox.ErrPublishFailed)
+	})
+}
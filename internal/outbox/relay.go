@@ -0,0 +1,150 @@
+package outbox
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+const (
+	// RelayInterval is how often the relay checks the outbox for messages due to be published
+	RelayInterval = time.Second
+	// RelayBatchSize is the most messages the relay claims at a time
+	RelayBatchSize = 100
+	// RelayLease is how long claimed messages are held by a relay, if it stops before recording what happened to them
+	// they're published again once it runs out
+	RelayLease = time.Minute
+	// RetentionPeriod is how long published messages are kept before they're purged
+	RetentionPeriod = 7 * 24 * time.Hour
+	// PurgeInterval is how often the relay purges published messages past the retention period
+	PurgeInterval = time.Hour
+	// PurgeBatchSize is the most messages the relay purges at a time
+	PurgeBatchSize = 1000
+)
+
+// Repository holds the outbox, messages are saved to it by whatever makes the change they're about
+type Repository interface {
+	// ClaimDueMessages returns up to limit unpublished messages due by now, oldest first, holding them for the lease so
+	// other relays skip them
+	//
+	// Messages with an earlier unpublished message for the same key that isn't claimed with them aren't claimed, whether
+	// it isn't due or another relay's claiming it, so a key's messages aren't published out of order
+	ClaimDueMessages(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]Message, error)
+	UpdateMessage(ctx context.Context, message Message) error
+	// DeletePublishedMessages deletes up to limit messages published before the time, returning how many it deleted
+	DeletePublishedMessages(ctx context.Context, before time.Time, limit int) (int, error)
+}
+
+// Relay publishes the messages saved in the outbox, retrying those that fail with an exponential backoff until they're
+// published
+type Relay struct {
+	log        *slog.Logger
+	repository Repository
+	publisher  Publisher
+}
+
+func NewRelay(log *slog.Logger, repository Repository, publisher Publisher) *Relay {
+	return &Relay{
+		log:        log,
+		repository: repository,
+		publisher:  publisher,
+	}
+}
+
+// Run relays messages every RelayInterval, and purges published ones every PurgeInterval, until the context is
+// cancelled
+func (relay *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(RelayInterval)
+	defer ticker.Stop()
+	purgeTicker := time.NewTicker(PurgeInterval)
+	defer purgeTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-purgeTicker.C:
+			_, err := relay.PurgePublished(ctx, time.Now())
+			if err != nil {
+				relay.log.With("error", err).Error("failed to purge outbox")
+			}
+			continue
+		case <-ticker.C:
+		}
+
+		// Keep going while there's a backlog
+		for {
+			claimed, err := relay.RelayDue(ctx, time.Now())
+			if err != nil {
+				relay.log.With("error", err).Error("failed to relay outbox")
+				break
+			}
+			if claimed < RelayBatchSize {
+				break
+			}
+		}
+	}
+}
+
+// RelayDue publishes a batch of the messages due by now, returning how many it claimed
+//
+// A message that fails to publish is retried later, it doesn't stop the rest of the batch, only the later messages for
+// its key, which are held back until it's retried
+func (relay *Relay) RelayDue(ctx context.Context, now time.Time) (int, error) {
+	messages, err := relay.repository.ClaimDueMessages(ctx, now, RelayBatchSize, RelayLease)
+	if err != nil {
+		return 0, err
+	}
+
+	failed := map[string]Message{}
+	for _, message := range messages {
+		// Keep the key's messages in order
+		if earlier, ok := failed[message.Key]; ok {
+			message.HoldBehind(earlier)
+			err = relay.repository.UpdateMessage(ctx, message)
+			if err != nil {
+				return 0, err
+			}
+			continue
+		}
+
+		err := relay.publisher.Publish(ctx, message)
+		if err != nil {
+			message.Failed(err, time.Now())
+			relay.log.
+				With("error", err).
+				With("message_id", message.Id).
+				With("topic", message.Topic).
+				With("attempts", message.Attempts).
+				With("next_attempt_at", message.NextAttemptAt).
+				Warn("failed to publish outbox message")
+			failed[message.Key] = message
+		} else {
+			message.Published(time.Now())
+		}
+
+		// The message is published again once its lease runs out if this fails
+		err = relay.repository.UpdateMessage(ctx, message)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return len(messages), nil
+}
+
+// PurgePublished deletes the messages published more than the RetentionPeriod before now, returning how many it deleted
+func (relay *Relay) PurgePublished(ctx context.Context, now time.Time) (int, error) {
+	purged := 0
+	for {
+		deleted, err := relay.repository.DeletePublishedMessages(ctx, now.Add(-RetentionPeriod), PurgeBatchSize)
+		if err != nil {
+			return purged, err
+		}
+		purged += deleted
+
+		if deleted < PurgeBatchSize {
+			return purged, nil
+		}
+	}
+}
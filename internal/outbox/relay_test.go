@@ -0,0 +1,213 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/iainvm/deposits/internal/outbox"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryOutbox is an in memory outbox.Repository
+type memoryOutbox struct {
+	messages []outbox.Message
+}
+
+func (repository *memoryOutbox) ClaimDueMessages(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]outbox.Message, error) {
+	claimed := []outbox.Message{}
+	held := map[string]bool{}
+	for i, message := range repository.messages {
+		if message.PublishedAt != nil || held[message.Key] {
+			continue
+		}
+		if message.NextAttemptAt.After(now) {
+			held[message.Key] = true
+			continue
+		}
+		if len(claimed) == limit {
+			continue
+		}
+
+		repository.messages[i].NextAttemptAt = now.Add(lease)
+		claimed = append(claimed, repository.messages[i])
+	}
+
+	return claimed, nil
+}
+
+func (repository *memoryOutbox) UpdateMessage(ctx context.Context, message outbox.Message) error {
+	i := slices.IndexFunc(repository.messages, func(stored outbox.Message) bool {
+		return stored.Id == message.Id
+	})
+	repository.messages[i] = message
+	return nil
+}
+
+func (repository *memoryOutbox) DeletePublishedMessages(ctx context.Context, before time.Time, limit int) (int, error) {
+	deleted := 0
+	repository.messages = slices.DeleteFunc(repository.messages, func(message outbox.Message) bool {
+		if message.PublishedAt == nil || !message.PublishedAt.Before(before) || deleted == limit {
+			return false
+		}
+		deleted++
+		return true
+	})
+
+	return deleted, nil
+}
+
+// failingPublisher fails to publish the topics it's given
+type failingPublisher struct {
+	outbox.Publisher
+	topics []string
+}
+
+func (publisher failingPublisher) Publish(ctx context.Context, message outbox.Message) error {
+	if slices.Contains(publisher.topics, message.Topic) {
+		return errors.New("subscriber unavailable")
+	}
+
+	return publisher.Publisher.Publish(ctx, message)
+}
+
+func TestRelayDue(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	now := time.Now()
+
+	// newOutbox saves a message for each topic, keyed by its topic
+	newOutbox := func(t *testing.T, topics ...string) *memoryOutbox {
+		repository := &memoryOutbox{}
+		for _, topic := range topics {
+			message, err := outbox.NewMessage(topic, topic, []byte(`{}`), now)
+			require.NoError(t, err)
+			repository.messages = append(repository.messages, *message)
+		}
+
+		return repository
+	}
+
+	t.Run("publishes due messages once", func(t *testing.T) {
+		repository := newOutbox(t, "A", "B")
+		publisher := outbox.NewMemoryPublisher()
+		relay := outbox.NewRelay(log, repository, publisher)
+
+		claimed, err := relay.RelayDue(context.Background(), now)
+		require.NoError(t, err)
+		require.Equal(t, 2, claimed)
+
+		claimed, err = relay.RelayDue(context.Background(), now.Add(time.Hour))
+		require.NoError(t, err)
+		require.Zero(t, claimed)
+
+		require.Len(t, publisher.Messages(), 2)
+		require.Equal(t, "A", publisher.Messages()[0].Topic)
+		require.Equal(t, "B", publisher.Messages()[1].Topic)
+		for _, message := range repository.messages {
+			require.NotNil(t, message.PublishedAt)
+		}
+	})
+
+	t.Run("retries failed messages with a backoff", func(t *testing.T) {
+		repository := newOutbox(t, "A", "B")
+		publisher := outbox.NewMemoryPublisher()
+		relay := outbox.NewRelay(log, repository, failingPublisher{Publisher: publisher, topics: []string{"A"}})
+
+		// The failure doesn't hold up the rest
+		_, err := relay.RelayDue(context.Background(), now)
+		require.NoError(t, err)
+		require.Len(t, publisher.Messages(), 1)
+		require.Equal(t, "B", publisher.Messages()[0].Topic)
+
+		failed := repository.messages[0]
+		require.Nil(t, failed.PublishedAt)
+		require.Equal(t, 1, failed.Attempts)
+		require.Equal(t, "subscriber unavailable", failed.LastError)
+		require.True(t, failed.NextAttemptAt.After(now))
+
+		// Not retried until it's due
+		claimed, err := relay.RelayDue(context.Background(), now)
+		require.NoError(t, err)
+		require.Zero(t, claimed)
+
+		// Published once the subscriber's back
+		relay = outbox.NewRelay(log, repository, publisher)
+		claimed, err = relay.RelayDue(context.Background(), failed.NextAttemptAt)
+		require.NoError(t, err)
+		require.Equal(t, 1, claimed)
+		require.Len(t, publisher.Messages(), 2)
+		require.NotNil(t, repository.messages[0].PublishedAt)
+	})
+
+	t.Run("holds a key's later messages behind one that failed", func(t *testing.T) {
+		repository := newOutbox(t, "A", "A", "B")
+		publisher := outbox.NewMemoryPublisher()
+		relay := outbox.NewRelay(log, repository, failingPublisher{Publisher: publisher, topics: []string{"A"}})
+
+		_, err := relay.RelayDue(context.Background(), now)
+		require.NoError(t, err)
+		require.Len(t, publisher.Messages(), 1)
+		require.Equal(t, "B", publisher.Messages()[0].Topic)
+
+		// The later message waits for the failed one, without counting as a failed attempt
+		failed, held := repository.messages[0], repository.messages[1]
+		require.Equal(t, 1, failed.Attempts)
+		require.Zero(t, held.Attempts)
+		require.Equal(t, failed.NextAttemptAt, held.NextAttemptAt)
+
+		// Not claimed while the failed one's pushed back, even when it's due
+		repository.messages[1].NextAttemptAt = now
+		claimed, err := relay.RelayDue(context.Background(), now)
+		require.NoError(t, err)
+		require.Zero(t, claimed)
+
+		// Published in order once the subscriber's back
+		relay = outbox.NewRelay(log, repository, publisher)
+		claimed, err = relay.RelayDue(context.Background(), failed.NextAttemptAt)
+		require.NoError(t, err)
+		require.Equal(t, 2, claimed)
+		require.Len(t, publisher.Messages(), 3)
+		require.Equal(t, failed.Id, publisher.Messages()[1].Id)
+		require.Equal(t, held.Id, publisher.Messages()[2].Id)
+	})
+
+	t.Run("doesn't publish messages not yet due", func(t *testing.T) {
+		repository := newOutbox(t, "A")
+		publisher := outbox.NewMemoryPublisher()
+		relay := outbox.NewRelay(log, repository, publisher)
+
+		claimed, err := relay.RelayDue(context.Background(), now.Add(-time.Second))
+		require.NoError(t, err)
+		require.Zero(t, claimed)
+		require.Empty(t, publisher.Messages())
+	})
+}
+
+func TestRelayPurgePublished(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	now := time.Now()
+
+	repository := &memoryOutbox{}
+	for _, publishedAt := range []time.Time{now.Add(-outbox.RetentionPeriod - time.Hour), now.Add(-time.Hour)} {
+		message, err := outbox.NewMessage("A", "key", []byte(`{}`), publishedAt)
+		require.NoError(t, err)
+		message.Published(publishedAt)
+		repository.messages = append(repository.messages, *message)
+	}
+	unpublished, err := outbox.NewMessage("A", "key", []byte(`{}`), now.Add(-outbox.RetentionPeriod-time.Hour))
+	require.NoError(t, err)
+	repository.messages = append(repository.messages, *unpublished)
+
+	// Only published messages past the retention period are purged
+	relay := outbox.NewRelay(log, repository, outbox.NewMemoryPublisher())
+	purged, err := relay.PurgePublished(context.Background(), now)
+	require.NoError(t, err)
+	require.Equal(t, 1, purged)
+	require.Len(t, repository.messages, 2)
+	require.NotNil(t, repository.messages[0].PublishedAt)
+	require.Equal(t, unpublished.Id, repository.messages[1].Id)
+}
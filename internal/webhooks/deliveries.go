@@ -0,0 +1,146 @@
+package webhooks
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iainvm/deposits/internal/outbox"
+)
+
+var (
+	ErrDeliveryNotFound      = errors.New("webhook delivery not found")
+	ErrInvalidDeliveryId     = errors.New("invalid webhook delivery id given")
+	ErrInvalidDeliveryStatus = errors.New("invalid webhook delivery status given")
+	// ErrDeliveryPending is returned redelivering a delivery that's still being tried
+	ErrDeliveryPending = errors.New("webhook delivery is still pending")
+)
+
+const (
+	// MaxDeliveryAttempts is how many times a delivery is tried before it's dead lettered
+	MaxDeliveryAttempts = 10
+	// RetryBaseDelay is how long a delivery waits before it's retried the first time, doubling each time it fails again
+	RetryBaseDelay = 30 * time.Second
+	// RetryMaxDelay caps how long a delivery waits between retries
+	RetryMaxDelay = 6 * time.Hour
+)
+
+type DeliveryStatus string
+
+const (
+	// DeliveryStatusPending is a delivery that hasn't been accepted yet, and is still being tried
+	DeliveryStatusPending DeliveryStatus = "PENDING"
+	// DeliveryStatusDelivered is a delivery the subscriber accepted
+	DeliveryStatusDelivered DeliveryStatus = "DELIVERED"
+	// DeliveryStatusDead is a delivery that failed every attempt, it's only tried again if it's redelivered
+	DeliveryStatusDead DeliveryStatus = "DEAD"
+)
+
+func ParseDeliveryStatus(status string) (DeliveryStatus, error) {
+	switch DeliveryStatus(status) {
+	case DeliveryStatusPending, DeliveryStatusDelivered, DeliveryStatusDead:
+		return DeliveryStatus(status), nil
+	}
+
+	return "", ErrInvalidDeliveryStatus
+}
+
+func (status DeliveryStatus) String() string {
+	return string(status)
+}
+
+type DeliveryId string
+
+func newDeliveryId() (DeliveryId, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return "", errors.Join(ErrIdGeneration, err)
+	}
+
+	return DeliveryId(id.String()), nil
+}
+
+func ParseDeliveryId(id string) (DeliveryId, error) {
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return "", errors.Join(ErrInvalidDeliveryId, err)
+	}
+
+	return DeliveryId(id), nil
+}
+
+func (id DeliveryId) String() string {
+	return string(id)
+}
+
+// Delivery is an event being sent to a subscription
+type Delivery struct {
+	Id             DeliveryId
+	SubscriptionId SubscriptionId
+	// MessageId is the id of the outbox message the event was published in, it's the same for every attempt and
+	// redelivery so subscribers can ignore repeats
+	MessageId   string
+	EventType   string
+	Payload     []byte
+	Status      DeliveryStatus
+	Attempts    int
+	LastError   string
+	CreatedAt   time.Time
+	DeliveredAt *time.Time
+	// NextAttemptAt is when a pending delivery is next tried
+	NextAttemptAt time.Time
+}
+
+// NewDelivery creates a Delivery of the message to the subscription, due to be sent straight away
+func NewDelivery(subscriptionId SubscriptionId, message outbox.Message, createdAt time.Time) (*Delivery, error) {
+	// Generate Id
+	id, err := newDeliveryId()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Delivery{
+		Id:             id,
+		SubscriptionId: subscriptionId,
+		MessageId:      message.Id.String(),
+		EventType:      message.Topic,
+		Payload:        message.Payload,
+		Status:         DeliveryStatusPending,
+		CreatedAt:      createdAt,
+		NextAttemptAt:  createdAt,
+	}, nil
+}
+
+// Delivered records the subscriber accepting the delivery
+func (delivery *Delivery) Delivered(deliveredAt time.Time) {
+	delivery.Status = DeliveryStatusDelivered
+	delivery.DeliveredAt = &deliveredAt
+	delivery.LastError = ""
+}
+
+// Failed records a failed attempt at the delivery, delaying it before it's retried, or dead lettering it once it's
+// been tried MaxDeliveryAttempts times
+func (delivery *Delivery) Failed(err error, failedAt time.Time) {
+	delivery.Attempts++
+	delivery.LastError = err.Error()
+	if delivery.Attempts >= MaxDeliveryAttempts {
+		delivery.Status = DeliveryStatusDead
+		return
+	}
+
+	delivery.NextAttemptAt = failedAt.Add(outbox.ExponentialBackoff(delivery.Attempts, RetryBaseDelay, RetryMaxDelay))
+}
+
+// Redeliver sends a dead or delivered delivery again, with a fresh set of attempts
+func (delivery *Delivery) Redeliver(redeliverAt time.Time) error {
+	if delivery.Status == DeliveryStatusPending {
+		return ErrDeliveryPending
+	}
+
+	delivery.Status = DeliveryStatusPending
+	delivery.Attempts = 0
+	delivery.LastError = ""
+	delivery.DeliveredAt = nil
+	delivery.NextAttemptAt = redeliverAt
+	return nil
+}
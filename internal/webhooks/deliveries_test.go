@@ -0,0 +1,92 @@
+package webhooks_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/iainvm/deposits/internal/outbox"
+	"github.com/iainvm/deposits/internal/webhooks"
+	"github.com/stretchr/testify/require"
+)
+
+func newDelivery(t *testing.T, now time.Time) *webhooks.Delivery {
+	t.Helper()
+
+	message, err := outbox.NewMessage("DEPOSIT_FUNDED", "deposit-id", []byte(`{}`), now)
+	require.NoError(t, err)
+	delivery, err := webhooks.NewDelivery(webhooks.SubscriptionId("subscription-id"), *message, now)
+	require.NoError(t, err)
+
+	return delivery
+}
+
+func TestDeliveryFailed(t *testing.T) {
+	now := time.Now()
+
+	t.Run("retries with an exponential backoff", func(t *testing.T) {
+		delivery := newDelivery(t, now)
+
+		delivery.Failed(errors.New("503 Service Unavailable"), now)
+		require.Equal(t, webhooks.DeliveryStatusPending, delivery.Status)
+		require.Equal(t, 1, delivery.Attempts)
+		require.Equal(t, "503 Service Unavailable", delivery.LastError)
+		require.Equal(t, now.Add(webhooks.RetryBaseDelay), delivery.NextAttemptAt)
+
+		delivery.Failed(errors.New("503 Service Unavailable"), now)
+		require.Equal(t, now.Add(2*webhooks.RetryBaseDelay), delivery.NextAttemptAt)
+
+		delivery.Failed(errors.New("503 Service Unavailable"), now)
+		require.Equal(t, now.Add(4*webhooks.RetryBaseDelay), delivery.NextAttemptAt)
+	})
+
+	t.Run("dead lettered after the last attempt", func(t *testing.T) {
+		delivery := newDelivery(t, now)
+
+		for range webhooks.MaxDeliveryAttempts - 1 {
+			delivery.Failed(errors.New("timeout"), now)
+			require.Equal(t, webhooks.DeliveryStatusPending, delivery.Status)
+			require.LessOrEqual(t, delivery.NextAttemptAt, now.Add(webhooks.RetryMaxDelay))
+		}
+
+		delivery.Failed(errors.New("timeout"), now)
+		require.Equal(t, webhooks.DeliveryStatusDead, delivery.Status)
+		require.Equal(t, webhooks.MaxDeliveryAttempts, delivery.Attempts)
+	})
+}
+
+func TestDeliveryRedeliver(t *testing.T) {
+	now := time.Now()
+
+	t.Run("pending deliveries can't be redelivered", func(t *testing.T) {
+		delivery := newDelivery(t, now)
+
+		err := delivery.Redeliver(now)
+		require.ErrorIs(t, err, webhooks.ErrDeliveryPending)
+	})
+
+	t.Run("dead deliveries are tried again", func(t *testing.T) {
+		delivery := newDelivery(t, now)
+		for range webhooks.MaxDeliveryAttempts {
+			delivery.Failed(errors.New("timeout"), now)
+		}
+
+		later := now.Add(24 * time.Hour)
+		err := delivery.Redeliver(later)
+		require.NoError(t, err)
+		require.Equal(t, webhooks.DeliveryStatusPending, delivery.Status)
+		require.Zero(t, delivery.Attempts)
+		require.Empty(t, delivery.LastError)
+		require.Equal(t, later, delivery.NextAttemptAt)
+	})
+
+	t.Run("delivered deliveries are sent again", func(t *testing.T) {
+		delivery := newDelivery(t, now)
+		delivery.Delivered(now)
+
+		err := delivery.Redeliver(now)
+		require.NoError(t, err)
+		require.Equal(t, webhooks.DeliveryStatusPending, delivery.Status)
+		require.Nil(t, delivery.DeliveredAt)
+	})
+}
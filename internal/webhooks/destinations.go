@@ -0,0 +1,121 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"syscall"
+	"time"
+)
+
+var (
+	ErrPrivateDestination  = errors.New("webhook url must be a public address")
+	ErrRedirectNotFollowed = errors.New("webhook redirects aren't followed")
+)
+
+// Resolver looks up the addresses of a host, net.DefaultResolver is one
+type Resolver interface {
+	LookupNetIP(ctx context.Context, network string, host string) ([]netip.Addr, error)
+}
+
+// PublicAddress is false for loopback, private, link local and other addresses that aren't reachable from the
+// internet, which webhooks mustn't be sent to so subscriptions can't be used to reach our internal services
+func PublicAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	if !addr.IsValid() || !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	for _, prefix := range reservedPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// reservedPrefixes aren't public, but aren't caught by the netip.Addr checks
+var reservedPrefixes = []netip.Prefix{
+	// This network
+	netip.MustParsePrefix("0.0.0.0/8"),
+	// Carrier grade NAT
+	netip.MustParsePrefix("100.64.0.0/10"),
+	// Benchmarking
+	netip.MustParsePrefix("198.18.0.0/15"),
+}
+
+// validateHost rejects hosts that are, or are named as, an address that isn't public, without looking them up
+func validateHost(host string) error {
+	if strings.EqualFold(host, "localhost") || strings.HasSuffix(strings.ToLower(host), ".localhost") {
+		return ErrPrivateDestination
+	}
+
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		// Not an address, it's looked up when subscribing and checked again when it's dialled
+		return nil
+	}
+	if !PublicAddress(addr) {
+		return ErrPrivateDestination
+	}
+
+	return nil
+}
+
+// resolvePublic looks up the host, failing unless every address it has is public
+func resolvePublic(ctx context.Context, resolver Resolver, host string) error {
+	addrs, err := resolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return errors.Join(ErrInvalidWebhookUrl, err)
+	}
+
+	for _, addr := range addrs {
+		if !PublicAddress(addr) {
+			return ErrPrivateDestination
+		}
+	}
+
+	return nil
+}
+
+// NewClient creates the http.Client deliveries are sent with, which only connects to public addresses and doesn't
+// follow redirects
+//
+// Addresses are checked as they're dialled, after the host's looked up, so a host that's changed to point somewhere
+// private since it was subscribed still can't be reached
+func NewClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network string, address string, conn syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return errors.Join(ErrPrivateDestination, err)
+			}
+			if !PublicAddress(addrPort.Addr()) {
+				return ErrPrivateDestination
+			}
+
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			// A proxy would be dialled instead of the subscription, so its address would be the one checked
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConnsPerHost: 10,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		// Redirects could send the delivery anywhere, subscribers have to give the URL that takes it
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return ErrRedirectNotFollowed
+		},
+	}
+}
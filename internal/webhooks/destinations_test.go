@@ -0,0 +1,69 @@
+package webhooks_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/iainvm/deposits/internal/webhooks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublicAddress(t *testing.T) {
+	tests := map[string]struct {
+		addr     string
+		expected bool
+	}{
+		"public ipv4":             {addr: "203.0.113.10", expected: true},
+		"public ipv6":             {addr: "2001:4860:4860::8888", expected: true},
+		"loopback":                {addr: "127.0.0.1"},
+		"ipv6 loopback":           {addr: "::1"},
+		"private":                 {addr: "192.168.1.1"},
+		"ipv6 unique local":       {addr: "fd00::1"},
+		"link local":              {addr: "169.254.169.254"},
+		"ipv6 link local":         {addr: "fe80::1"},
+		"unspecified":             {addr: "0.0.0.0"},
+		"this network":            {addr: "0.1.2.3"},
+		"carrier grade nat":       {addr: "100.64.0.1"},
+		"multicast":               {addr: "224.0.0.1"},
+		"ipv4 mapped loopback":    {addr: "::ffff:127.0.0.1"},
+		"ipv4 mapped public ipv4": {addr: "::ffff:203.0.113.10", expected: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, test.expected, webhooks.PublicAddress(netip.MustParseAddr(test.addr)))
+		})
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	client := webhooks.NewClient(time.Second)
+
+	t.Run("doesn't connect to private addresses", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Error("delivered to a loopback address")
+		}))
+		t.Cleanup(server.Close)
+
+		// Named so it's only checked as it's dialled
+		url := strings.Replace(server.URL, "127.0.0.1", "localhost", 1)
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, url, nil)
+		require.NoError(t, err)
+
+		_, err = client.Do(req)
+		require.ErrorIs(t, err, webhooks.ErrPrivateDestination)
+	})
+
+	t.Run("doesn't follow redirects", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodPost, "https://partner.example.com/moved", nil)
+		require.NoError(t, err)
+
+		err = client.CheckRedirect(req, nil)
+		require.ErrorIs(t, err, webhooks.ErrRedirectNotFollowed)
+	})
+}
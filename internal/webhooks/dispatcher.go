@@ -0,0 +1,147 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+var (
+	ErrDeliveryFailed = errors.New("failed to deliver webhook")
+)
+
+const (
+	// DispatchInterval is how often the dispatcher checks for deliveries due to be sent
+	DispatchInterval = time.Second
+	// DispatchBatchSize is the most deliveries the dispatcher claims at a time
+	DispatchBatchSize = 100
+	// DispatchLease is how long claimed deliveries are held by a dispatcher, if it stops before recording what happened
+	// to them they're sent again once it runs out
+	DispatchLease = time.Minute
+)
+
+// Dispatcher sends due deliveries to their subscriptions, retrying those that fail with an exponential backoff until
+// they're dead lettered
+type Dispatcher struct {
+	log        *slog.Logger
+	repository Repository
+	client     *http.Client
+}
+
+func NewDispatcher(log *slog.Logger, repository Repository, client *http.Client) *Dispatcher {
+	return &Dispatcher{
+		log:        log,
+		repository: repository,
+		client:     client,
+	}
+}
+
+// Run dispatches deliveries every DispatchInterval until the context is cancelled
+func (dispatcher *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(DispatchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// Keep going while there's a backlog
+		for {
+			claimed, err := dispatcher.DispatchDue(ctx, time.Now())
+			if err != nil {
+				dispatcher.log.With("error", err).Error("failed to dispatch webhooks")
+				break
+			}
+			if claimed < DispatchBatchSize {
+				break
+			}
+		}
+	}
+}
+
+// DispatchDue sends a batch of the deliveries due by now, returning how many it claimed
+//
+// A delivery that fails is retried later, it doesn't stop the rest of the batch
+func (dispatcher *Dispatcher) DispatchDue(ctx context.Context, now time.Time) (int, error) {
+	deliveries, err := dispatcher.repository.ClaimDueDeliveries(ctx, now, DispatchBatchSize, DispatchLease)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, delivery := range deliveries {
+		subscription, err := dispatcher.repository.GetSubscription(ctx, delivery.SubscriptionId)
+		switch {
+		case errors.Is(err, ErrSubscriptionNotFound):
+			// Deleted since it was claimed, its deliveries went with it
+			continue
+		case err != nil:
+			return 0, err
+		}
+
+		err = dispatcher.deliver(ctx, *subscription, *delivery)
+		if err != nil {
+			delivery.Failed(err, time.Now())
+			log := dispatcher.log.
+				With("error", err).
+				With("delivery_id", delivery.Id).
+				With("subscription_id", delivery.SubscriptionId).
+				With("event_type", delivery.EventType).
+				With("attempts", delivery.Attempts)
+			if delivery.Status == DeliveryStatusDead {
+				log.Error("webhook delivery dead lettered")
+			} else {
+				log.With("next_attempt_at", delivery.NextAttemptAt).Warn("failed to deliver webhook")
+			}
+		} else {
+			delivery.Delivered(time.Now())
+		}
+
+		// The delivery is sent again once its lease runs out if this fails
+		err = dispatcher.repository.UpdateDelivery(ctx, *delivery)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return len(deliveries), nil
+}
+
+// deliver POSTs the delivery to the subscription's URL, signed with its secret, any response other than a 2xx is a
+// failure
+func (dispatcher *Dispatcher) deliver(ctx context.Context, subscription Subscription, delivery Delivery) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.Url, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return errors.Join(ErrDeliveryFailed, err)
+	}
+
+	timestamp := time.Now()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderId, delivery.MessageId)
+	req.Header.Set(HeaderEventType, delivery.EventType)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp.Unix(), 10))
+	req.Header.Set(HeaderSignature, Sign(subscription.Secret, timestamp, delivery.Payload))
+
+	res, err := dispatcher.client.Do(req)
+	if err != nil {
+		return errors.Join(ErrDeliveryFailed, err)
+	}
+	defer res.Body.Close()
+
+	// Drain the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return errors.Join(ErrDeliveryFailed, fmt.Errorf("webhook responded %s", res.Status))
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+package webhooks_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/iainvm/deposits/internal/outbox"
+	"github.com/iainvm/deposits/internal/webhooks"
+	"github.com/stretchr/testify/require"
+)
+
+// receiver is a subscriber's endpoint, checking the signature of each delivery it's sent
+type receiver struct {
+	mu       sync.Mutex
+	status   int
+	received []*http.Request
+	verified []bool
+}
+
+func (receiver *receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	receiver.mu.Lock()
+	defer receiver.mu.Unlock()
+
+	body, _ := io.ReadAll(r.Body)
+	seconds, _ := strconv.ParseInt(r.Header.Get(webhooks.HeaderTimestamp), 10, 64)
+	verified := webhooks.Verify(testSecret, time.Unix(seconds, 0), body, r.Header.Get(webhooks.HeaderSignature))
+
+	receiver.received = append(receiver.received, r)
+	receiver.verified = append(receiver.verified, verified)
+	w.WriteHeader(receiver.status)
+}
+
+func (receiver *receiver) respond(status int) {
+	receiver.mu.Lock()
+	defer receiver.mu.Unlock()
+	receiver.status = status
+}
+
+func TestDispatchDue(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	setup := func(t *testing.T, status int) (*memoryRepository, *webhooks.Service, *webhooks.Dispatcher, *receiver, *outbox.Message) {
+		receiver := &receiver{status: status}
+		server := httptest.NewServer(receiver)
+		t.Cleanup(server.Close)
+
+		repository := &memoryRepository{}
+		service := webhooks.NewService(repository, eventTypes, memoryResolver{})
+		dispatcher := webhooks.NewDispatcher(log, repository, server.Client())
+
+		// The server's on loopback, which can't be subscribed to, so it's saved directly
+		subscription, err := webhooks.NewSubscription("https://partner.example.com", []string{"DEPOSIT_FUNDED"}, testSecret, time.Now())
+		require.NoError(t, err)
+		subscription.Url = server.URL
+		require.NoError(t, repository.SaveSubscription(context.Background(), *subscription))
+
+		message, err := outbox.NewMessage("DEPOSIT_FUNDED", "deposit-id", []byte(`{"deposit_id":"deposit-id"}`), time.Now())
+		require.NoError(t, err)
+		err = service.Publish(context.Background(), *message)
+		require.NoError(t, err)
+
+		return repository, service, dispatcher, receiver, message
+	}
+
+	t.Run("sends signed deliveries", func(t *testing.T) {
+		repository, _, dispatcher, receiver, message := setup(t, http.StatusNoContent)
+
+		claimed, err := dispatcher.DispatchDue(context.Background(), time.Now())
+		require.NoError(t, err)
+		require.Equal(t, 1, claimed)
+
+		require.Len(t, receiver.received, 1)
+		require.True(t, receiver.verified[0])
+		received := receiver.received[0]
+		require.Equal(t, http.MethodPost, received.Method)
+		require.Equal(t, "application/json", received.Header.Get("Content-Type"))
+		require.Equal(t, message.Id.String(), received.Header.Get(webhooks.HeaderId))
+		require.Equal(t, "DEPOSIT_FUNDED", received.Header.Get(webhooks.HeaderEventType))
+
+		delivery := repository.deliveries[0]
+		require.Equal(t, webhooks.DeliveryStatusDelivered, delivery.Status)
+		require.NotNil(t, delivery.DeliveredAt)
+
+		// Delivered once
+		claimed, err = dispatcher.DispatchDue(context.Background(), time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		require.Zero(t, claimed)
+		require.Len(t, receiver.received, 1)
+	})
+
+	t.Run("retries failed deliveries with a backoff", func(t *testing.T) {
+		repository, _, dispatcher, receiver, _ := setup(t, http.StatusInternalServerError)
+
+		_, err := dispatcher.DispatchDue(context.Background(), time.Now())
+		require.NoError(t, err)
+
+		failed := repository.deliveries[0]
+		require.Equal(t, webhooks.DeliveryStatusPending, failed.Status)
+		require.Equal(t, 1, failed.Attempts)
+		require.Contains(t, failed.LastError, "500 Internal Server Error")
+
+		// Not retried until it's due
+		claimed, err := dispatcher.DispatchDue(context.Background(), time.Now())
+		require.NoError(t, err)
+		require.Zero(t, claimed)
+
+		// Delivered once the subscriber's back
+		receiver.respond(http.StatusOK)
+		claimed, err = dispatcher.DispatchDue(context.Background(), failed.NextAttemptAt)
+		require.NoError(t, err)
+		require.Equal(t, 1, claimed)
+		require.Len(t, receiver.received, 2)
+		require.Equal(t, webhooks.DeliveryStatusDelivered, repository.deliveries[0].Status)
+		require.Empty(t, repository.deliveries[0].LastError)
+	})
+
+	t.Run("dead letters deliveries that fail every attempt, and redelivers them", func(t *testing.T) {
+		repository, service, dispatcher, receiver, message := setup(t, http.StatusServiceUnavailable)
+
+		for range webhooks.MaxDeliveryAttempts {
+			claimed, err := dispatcher.DispatchDue(context.Background(), repository.deliveries[0].NextAttemptAt)
+			require.NoError(t, err)
+			require.Equal(t, 1, claimed)
+		}
+		require.Len(t, receiver.received, webhooks.MaxDeliveryAttempts)
+
+		// Dead deliveries aren't tried again
+		claimed, err := dispatcher.DispatchDue(context.Background(), time.Now().Add(24*time.Hour))
+		require.NoError(t, err)
+		require.Zero(t, claimed)
+
+		deadLetters, err := service.ListDeadLetters(context.Background(), "", 0)
+		require.NoError(t, err)
+		require.Len(t, deadLetters, 1)
+		require.Equal(t, webhooks.DeliveryStatusDead, deadLetters[0].Status)
+		require.Equal(t, message.Id.String(), deadLetters[0].MessageId)
+
+		// Redelivered once the subscriber's back
+		receiver.respond(http.StatusOK)
+		redelivered, err := service.Redeliver(context.Background(), deadLetters[0].Id)
+		require.NoError(t, err)
+		require.Equal(t, webhooks.DeliveryStatusPending, redelivered.Status)
+
+		_, err = service.Redeliver(context.Background(), deadLetters[0].Id)
+		require.ErrorIs(t, err, webhooks.ErrDeliveryPending)
+
+		claimed, err = dispatcher.DispatchDue(context.Background(), time.Now())
+		require.NoError(t, err)
+		require.Equal(t, 1, claimed)
+		require.Equal(t, webhooks.DeliveryStatusDelivered, repository.deliveries[0].Status)
+
+		// The same event id is sent each time
+		for _, received := range receiver.received {
+			require.Equal(t, message.Id.String(), received.Header.Get(webhooks.HeaderId))
+		}
+
+		deadLetters, err = service.ListDeadLetters(context.Background(), "", 0)
+		require.NoError(t, err)
+		require.Empty(t, deadLetters)
+	})
+
+	t.Run("redelivering unknown deliveries fails", func(t *testing.T) {
+		_, service, _, _, _ := setup(t, http.StatusOK)
+
+		_, err := service.Redeliver(context.Background(), webhooks.DeliveryId("00000000-0000-0000-0000-000000000000"))
+		require.ErrorIs(t, err, webhooks.ErrDeliveryNotFound)
+	})
+}
@@ -0,0 +1,380 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/iainvm/deposits/internal/webhooks"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+var (
+	ErrSaveFailed = errors.New("failed to save webhook")
+)
+
+type Store struct {
+	db *sqlx.DB
+}
+
+func NewStore(db *sqlx.DB) Store {
+	return Store{
+		db: db,
+	}
+}
+
+type SubscriptionRow struct {
+	Id         string         `db:"id"`
+	Url        string         `db:"url"`
+	EventTypes pq.StringArray `db:"event_types"`
+	Secret     string         `db:"secret"`
+	CreatedAt  time.Time      `db:"created_at"`
+}
+
+type DeliveryRow struct {
+	Id             string       `db:"id"`
+	SubscriptionId string       `db:"subscription_id"`
+	MessageId      string       `db:"message_id"`
+	EventType      string       `db:"event_type"`
+	Payload        string       `db:"payload"`
+	Status         string       `db:"status"`
+	Attempts       int          `db:"attempts"`
+	LastError      string       `db:"last_error"`
+	CreatedAt      time.Time    `db:"created_at"`
+	DeliveredAt    sql.NullTime `db:"delivered_at"`
+	NextAttemptAt  time.Time    `db:"next_attempt_at"`
+}
+
+func (store Store) SaveSubscription(ctx context.Context, subscription webhooks.Subscription) error {
+	// Define query separately for easy editting
+	const query = `--sql
+	INSERT INTO webhook_subscriptions (id, url, event_types, secret, created_at)
+	VALUES (:id, :url, :event_types, :secret, :created_at)
+	`
+
+	// Create Row
+	row := SubscriptionRow{
+		Id:         subscription.Id.String(),
+		Url:        subscription.Url,
+		EventTypes: pq.StringArray(subscription.EventTypes),
+		Secret:     subscription.Secret,
+		CreatedAt:  subscription.CreatedAt,
+	}
+
+	// Execute query
+	_, err := store.db.NamedExecContext(
+		ctx,
+		query,
+		row,
+	)
+	if err != nil {
+		return errors.Join(ErrSaveFailed, err)
+	}
+
+	return nil
+}
+
+func (store Store) GetSubscription(ctx context.Context, id webhooks.SubscriptionId) (*webhooks.Subscription, error) {
+	const query = `--sql
+	SELECT id, url, event_types, secret, created_at
+	FROM webhook_subscriptions
+	WHERE id=$1
+	`
+
+	row := SubscriptionRow{}
+	err := store.db.GetContext(ctx, &row, query, id.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, webhooks.ErrSubscriptionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return createDomainSubscription(row)
+}
+
+func (store Store) ListSubscriptions(ctx context.Context) ([]*webhooks.Subscription, error) {
+	const query = `--sql
+	SELECT id, url, event_types, secret, created_at
+	FROM webhook_subscriptions
+	ORDER BY created_at, id
+	`
+
+	rows := []SubscriptionRow{}
+	err := store.db.SelectContext(ctx, &rows, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return createDomainSubscriptions(rows)
+}
+
+func (store Store) ListSubscriptionsForEventType(ctx context.Context, eventType string) ([]*webhooks.Subscription, error) {
+	const query = `--sql
+	SELECT id, url, event_types, secret, created_at
+	FROM webhook_subscriptions
+	WHERE $1 = ANY(event_types)
+	ORDER BY created_at, id
+	`
+
+	rows := []SubscriptionRow{}
+	err := store.db.SelectContext(ctx, &rows, query, eventType)
+	if err != nil {
+		return nil, err
+	}
+
+	return createDomainSubscriptions(rows)
+}
+
+func (store Store) DeleteSubscription(ctx context.Context, id webhooks.SubscriptionId) error {
+	// Define query separately for easy editting
+	const query = `--sql
+	DELETE FROM webhook_subscriptions
+	WHERE id=$1
+	`
+
+	// Execute query
+	result, err := store.db.ExecContext(
+		ctx,
+		query,
+		id.String(),
+	)
+	if err != nil {
+		return errors.Join(ErrSaveFailed, err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return errors.Join(ErrSaveFailed, err)
+	}
+	if deleted == 0 {
+		return webhooks.ErrSubscriptionNotFound
+	}
+
+	return nil
+}
+
+func (store Store) SaveDelivery(ctx context.Context, delivery webhooks.Delivery) error {
+	// Define query separately for easy editting
+	const query = `--sql
+	INSERT INTO webhook_deliveries (id, subscription_id, message_id, event_type, payload, status, attempts, last_error, created_at, delivered_at, next_attempt_at)
+	VALUES (:id, :subscription_id, :message_id, :event_type, :payload, :status, :attempts, :last_error, :created_at, :delivered_at, :next_attempt_at)
+	ON CONFLICT (subscription_id, message_id) DO NOTHING
+	`
+
+	// Create Row
+	row := createDeliveryRow(delivery)
+
+	// Execute query
+	_, err := store.db.NamedExecContext(
+		ctx,
+		query,
+		row,
+	)
+	if err != nil {
+		return errors.Join(ErrSaveFailed, err)
+	}
+
+	return nil
+}
+
+func (store Store) GetDelivery(ctx context.Context, id webhooks.DeliveryId) (*webhooks.Delivery, error) {
+	const query = `--sql
+	SELECT id, subscription_id, message_id, event_type, payload, status, attempts, last_error, created_at, delivered_at, next_attempt_at
+	FROM webhook_deliveries
+	WHERE id=$1
+	`
+
+	row := DeliveryRow{}
+	err := store.db.GetContext(ctx, &row, query, id.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, webhooks.ErrDeliveryNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return createDomainDelivery(row)
+}
+
+func (store Store) UpdateDelivery(ctx context.Context, delivery webhooks.Delivery) error {
+	// Define query separately for easy editting
+	const query = `--sql
+	UPDATE webhook_deliveries
+	SET status=:status,
+		attempts=:attempts,
+		last_error=:last_error,
+		delivered_at=:delivered_at,
+		next_attempt_at=:next_attempt_at
+	WHERE id=:id
+	`
+
+	// Create Row
+	row := createDeliveryRow(delivery)
+
+	// Execute query
+	_, err := store.db.NamedExecContext(
+		ctx,
+		query,
+		row,
+	)
+	if err != nil {
+		return errors.Join(ErrSaveFailed, err)
+	}
+
+	return nil
+}
+
+// ClaimDueDeliveries moves the next attempt of the deliveries it returns on by the lease in the same statement it
+// selects them, and skips rows locked by another dispatcher claiming them, so concurrent dispatchers don't send the
+// same deliveries
+func (store Store) ClaimDueDeliveries(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*webhooks.Delivery, error) {
+	const query = `--sql
+	UPDATE webhook_deliveries
+	SET next_attempt_at = $2
+	WHERE id IN (
+		SELECT id
+		FROM webhook_deliveries
+		WHERE status = 'PENDING'
+			AND next_attempt_at <= $1
+		ORDER BY next_attempt_at, id
+		LIMIT $3
+		FOR UPDATE SKIP LOCKED
+	)
+	RETURNING id, subscription_id, message_id, event_type, payload, status, attempts, last_error, created_at, delivered_at, next_attempt_at
+	`
+
+	rows := []DeliveryRow{}
+	err := store.db.SelectContext(ctx, &rows, query, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, err := createDomainDeliveries(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	// Returned rows aren't ordered
+	slices.SortFunc(deliveries, func(delivery *webhooks.Delivery, other *webhooks.Delivery) int {
+		return delivery.CreatedAt.Compare(other.CreatedAt)
+	})
+
+	return deliveries, nil
+}
+
+func (store Store) ListDeadDeliveries(ctx context.Context, subscriptionId webhooks.SubscriptionId, limit int) ([]*webhooks.Delivery, error) {
+	const query = `--sql
+	SELECT id, subscription_id, message_id, event_type, payload, status, attempts, last_error, created_at, delivered_at, next_attempt_at
+	FROM webhook_deliveries
+	WHERE status = 'DEAD'
+		AND ($1 = '' OR subscription_id = $1)
+	ORDER BY created_at DESC, id DESC
+	LIMIT $2
+	`
+
+	rows := []DeliveryRow{}
+	err := store.db.SelectContext(ctx, &rows, query, subscriptionId.String(), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return createDomainDeliveries(rows)
+}
+
+func createDomainSubscriptions(rows []SubscriptionRow) ([]*webhooks.Subscription, error) {
+	subscriptions := []*webhooks.Subscription{}
+	for _, row := range rows {
+		subscription, err := createDomainSubscription(row)
+		if err != nil {
+			return nil, err
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	return subscriptions, nil
+}
+
+func createDomainSubscription(row SubscriptionRow) (*webhooks.Subscription, error) {
+	id, err := webhooks.ParseSubscriptionId(row.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhooks.Subscription{
+		Id:         id,
+		Url:        row.Url,
+		EventTypes: []string(row.EventTypes),
+		Secret:     row.Secret,
+		CreatedAt:  row.CreatedAt,
+	}, nil
+}
+
+func createDeliveryRow(delivery webhooks.Delivery) DeliveryRow {
+	row := DeliveryRow{
+		Id:             delivery.Id.String(),
+		SubscriptionId: delivery.SubscriptionId.String(),
+		MessageId:      delivery.MessageId,
+		EventType:      delivery.EventType,
+		Payload:        string(delivery.Payload),
+		Status:         delivery.Status.String(),
+		Attempts:       delivery.Attempts,
+		LastError:      delivery.LastError,
+		CreatedAt:      delivery.CreatedAt,
+		NextAttemptAt:  delivery.NextAttemptAt,
+	}
+	if delivery.DeliveredAt != nil {
+		row.DeliveredAt = sql.NullTime{Time: *delivery.DeliveredAt, Valid: true}
+	}
+
+	return row
+}
+
+func createDomainDeliveries(rows []DeliveryRow) ([]*webhooks.Delivery, error) {
+	deliveries := []*webhooks.Delivery{}
+	for _, row := range rows {
+		delivery, err := createDomainDelivery(row)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, nil
+}
+
+func createDomainDelivery(row DeliveryRow) (*webhooks.Delivery, error) {
+	id, err := webhooks.ParseDeliveryId(row.Id)
+	if err != nil {
+		return nil, err
+	}
+	subscriptionId, err := webhooks.ParseSubscriptionId(row.SubscriptionId)
+	if err != nil {
+		return nil, err
+	}
+	status, err := webhooks.ParseDeliveryStatus(row.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery := &webhooks.Delivery{
+		Id:             id,
+		SubscriptionId: subscriptionId,
+		MessageId:      row.MessageId,
+		EventType:      row.EventType,
+		Payload:        []byte(row.Payload),
+		Status:         status,
+		Attempts:       row.Attempts,
+		LastError:      row.LastError,
+		CreatedAt:      row.CreatedAt,
+		NextAttemptAt:  row.NextAttemptAt,
+	}
+	if row.DeliveredAt.Valid {
+		delivery.DeliveredAt = &row.DeliveredAt.Time
+	}
+
+	return delivery, nil
+}
@@ -0,0 +1,152 @@
+package webhooks
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/iainvm/deposits/internal/outbox"
+)
+
+const (
+	// DefaultDeadLetterLimit is how many dead letters are listed when no limit is given
+	DefaultDeadLetterLimit = 50
+	// MaxDeadLetterLimit is the most dead letters listed at once
+	MaxDeadLetterLimit = 100
+)
+
+type Repository interface {
+	SaveSubscription(ctx context.Context, subscription Subscription) error
+	GetSubscription(ctx context.Context, id SubscriptionId) (*Subscription, error)
+	ListSubscriptions(ctx context.Context) ([]*Subscription, error)
+	// ListSubscriptionsForEventType returns the subscriptions sent events of the type
+	ListSubscriptionsForEventType(ctx context.Context, eventType string) ([]*Subscription, error)
+	DeleteSubscription(ctx context.Context, id SubscriptionId) error
+	// SaveDelivery saves a new delivery, doing nothing if the subscription already has one for the message
+	SaveDelivery(ctx context.Context, delivery Delivery) error
+	GetDelivery(ctx context.Context, id DeliveryId) (*Delivery, error)
+	UpdateDelivery(ctx context.Context, delivery Delivery) error
+	// ClaimDueDeliveries returns up to limit pending deliveries due by now, oldest first, holding them for the lease
+	// so other dispatchers skip them
+	ClaimDueDeliveries(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*Delivery, error)
+	// ListDeadDeliveries returns up to limit dead deliveries, newest first, for the subscription or every subscription
+	// if it's blank
+	ListDeadDeliveries(ctx context.Context, subscriptionId SubscriptionId, limit int) ([]*Delivery, error)
+}
+
+type Service struct {
+	repository Repository
+	// eventTypes are those that can be subscribed to
+	eventTypes []string
+	// resolver looks up subscriptions' hosts, to check they're public
+	resolver Resolver
+}
+
+// NewService creates a Service for subscribing to the event types given, which are the topics of the outbox messages
+// it's published
+func NewService(repository Repository, eventTypes []string, resolver Resolver) *Service {
+	return &Service{
+		repository: repository,
+		eventTypes: eventTypes,
+		resolver:   resolver,
+	}
+}
+
+// CreateSubscription registers the URL to be sent events of the types, signed with the secret
+func (service *Service) CreateSubscription(ctx context.Context, webhookUrl string, eventTypes []string, secret string) (*Subscription, error) {
+	for _, eventType := range eventTypes {
+		if !slices.Contains(service.eventTypes, eventType) {
+			return nil, ErrInvalidEventType
+		}
+	}
+
+	subscription, err := NewSubscription(webhookUrl, eventTypes, secret, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// Hosts can't be named for a private address either
+	parsed, err := url.Parse(subscription.Url)
+	if err != nil {
+		return nil, err
+	}
+	err = resolvePublic(ctx, service.resolver, parsed.Hostname())
+	if err != nil {
+		return nil, err
+	}
+
+	err = service.repository.SaveSubscription(ctx, *subscription)
+	if err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+// ListSubscriptions returns every subscription, oldest first
+func (service *Service) ListSubscriptions(ctx context.Context) ([]*Subscription, error) {
+	return service.repository.ListSubscriptions(ctx)
+}
+
+// DeleteSubscription stops events being sent to the subscription, its deliveries are deleted with it
+func (service *Service) DeleteSubscription(ctx context.Context, id SubscriptionId) error {
+	return service.repository.DeleteSubscription(ctx, id)
+}
+
+// ListDeadLetters returns the most recent deliveries that failed every attempt, for the subscription or every
+// subscription if it's blank
+func (service *Service) ListDeadLetters(ctx context.Context, subscriptionId SubscriptionId, limit int) ([]*Delivery, error) {
+	if limit <= 0 {
+		limit = DefaultDeadLetterLimit
+	}
+	limit = min(limit, MaxDeadLetterLimit)
+
+	return service.repository.ListDeadDeliveries(ctx, subscriptionId, limit)
+}
+
+// Redeliver sends a delivery again straight away, with a fresh set of attempts
+func (service *Service) Redeliver(ctx context.Context, id DeliveryId) (*Delivery, error) {
+	delivery, err := service.repository.GetDelivery(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = delivery.Redeliver(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	err = service.repository.UpdateDelivery(ctx, *delivery)
+	if err != nil {
+		return nil, err
+	}
+
+	return delivery, nil
+}
+
+// Publish creates a delivery of the outbox message to every subscription to its topic, making the Service an
+// outbox.Publisher
+//
+// The dispatcher sends the deliveries, so a subscriber being unavailable doesn't hold up the outbox. Messages
+// published again don't create more deliveries
+func (service *Service) Publish(ctx context.Context, message outbox.Message) error {
+	subscriptions, err := service.repository.ListSubscriptionsForEventType(ctx, message.Topic)
+	if err != nil {
+		return err
+	}
+
+	for _, subscription := range subscriptions {
+		delivery, err := NewDelivery(subscription.Id, message, time.Now())
+		if err != nil {
+			return err
+		}
+
+		err = service.repository.SaveDelivery(ctx, *delivery)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
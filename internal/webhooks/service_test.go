@@ -0,0 +1,225 @@
+package webhooks_test
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/iainvm/deposits/internal/outbox"
+	"github.com/iainvm/deposits/internal/webhooks"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryRepository is an in memory webhooks.Repository
+type memoryRepository struct {
+	subscriptions []webhooks.Subscription
+	deliveries    []webhooks.Delivery
+}
+
+func (repository *memoryRepository) SaveSubscription(ctx context.Context, subscription webhooks.Subscription) error {
+	repository.subscriptions = append(repository.subscriptions, subscription)
+	return nil
+}
+
+func (repository *memoryRepository) GetSubscription(ctx context.Context, id webhooks.SubscriptionId) (*webhooks.Subscription, error) {
+	for _, subscription := range repository.subscriptions {
+		if subscription.Id == id {
+			return &subscription, nil
+		}
+	}
+
+	return nil, webhooks.ErrSubscriptionNotFound
+}
+
+func (repository *memoryRepository) ListSubscriptions(ctx context.Context) ([]*webhooks.Subscription, error) {
+	subscriptions := []*webhooks.Subscription{}
+	for _, subscription := range repository.subscriptions {
+		subscriptions = append(subscriptions, &subscription)
+	}
+
+	return subscriptions, nil
+}
+
+func (repository *memoryRepository) ListSubscriptionsForEventType(ctx context.Context, eventType string) ([]*webhooks.Subscription, error) {
+	subscriptions := []*webhooks.Subscription{}
+	for _, subscription := range repository.subscriptions {
+		if subscription.Subscribed(eventType) {
+			subscriptions = append(subscriptions, &subscription)
+		}
+	}
+
+	return subscriptions, nil
+}
+
+func (repository *memoryRepository) DeleteSubscription(ctx context.Context, id webhooks.SubscriptionId) error {
+	i := slices.IndexFunc(repository.subscriptions, func(subscription webhooks.Subscription) bool {
+		return subscription.Id == id
+	})
+	if i == -1 {
+		return webhooks.ErrSubscriptionNotFound
+	}
+
+	repository.subscriptions = slices.Delete(repository.subscriptions, i, i+1)
+	repository.deliveries = slices.DeleteFunc(repository.deliveries, func(delivery webhooks.Delivery) bool {
+		return delivery.SubscriptionId == id
+	})
+	return nil
+}
+
+func (repository *memoryRepository) SaveDelivery(ctx context.Context, delivery webhooks.Delivery) error {
+	for _, saved := range repository.deliveries {
+		if saved.SubscriptionId == delivery.SubscriptionId && saved.MessageId == delivery.MessageId {
+			return nil
+		}
+	}
+
+	repository.deliveries = append(repository.deliveries, delivery)
+	return nil
+}
+
+func (repository *memoryRepository) GetDelivery(ctx context.Context, id webhooks.DeliveryId) (*webhooks.Delivery, error) {
+	for _, delivery := range repository.deliveries {
+		if delivery.Id == id {
+			return &delivery, nil
+		}
+	}
+
+	return nil, webhooks.ErrDeliveryNotFound
+}
+
+func (repository *memoryRepository) UpdateDelivery(ctx context.Context, delivery webhooks.Delivery) error {
+	i := slices.IndexFunc(repository.deliveries, func(saved webhooks.Delivery) bool {
+		return saved.Id == delivery.Id
+	})
+	repository.deliveries[i] = delivery
+	return nil
+}
+
+func (repository *memoryRepository) ClaimDueDeliveries(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*webhooks.Delivery, error) {
+	claimed := []*webhooks.Delivery{}
+	for i, delivery := range repository.deliveries {
+		if delivery.Status != webhooks.DeliveryStatusPending || delivery.NextAttemptAt.After(now) || len(claimed) == limit {
+			continue
+		}
+
+		repository.deliveries[i].NextAttemptAt = now.Add(lease)
+		delivery := repository.deliveries[i]
+		claimed = append(claimed, &delivery)
+	}
+
+	return claimed, nil
+}
+
+func (repository *memoryRepository) ListDeadDeliveries(ctx context.Context, subscriptionId webhooks.SubscriptionId, limit int) ([]*webhooks.Delivery, error) {
+	dead := []*webhooks.Delivery{}
+	for i := len(repository.deliveries) - 1; i >= 0; i-- {
+		delivery := repository.deliveries[i]
+		if delivery.Status != webhooks.DeliveryStatusDead || (subscriptionId != "" && delivery.SubscriptionId != subscriptionId) {
+			continue
+		}
+		if len(dead) == limit {
+			break
+		}
+
+		dead = append(dead, &delivery)
+	}
+
+	return dead, nil
+}
+
+// memoryResolver resolves the hosts it has to their addresses, and every other host to a public address
+type memoryResolver map[string][]netip.Addr
+
+func (resolver memoryResolver) LookupNetIP(ctx context.Context, network string, host string) ([]netip.Addr, error) {
+	addrs, ok := resolver[host]
+	if !ok {
+		return []netip.Addr{netip.MustParseAddr("203.0.113.10")}, nil
+	}
+	if len(addrs) == 0 {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+
+	return addrs, nil
+}
+
+const testSecret = "0123456789abcdef"
+
+var eventTypes = []string{"DEPOSIT_FUNDED", "RECEIPT_REVERSED"}
+
+func TestServiceCreateSubscription(t *testing.T) {
+	t.Run("saves the subscription", func(t *testing.T) {
+		repository := &memoryRepository{}
+		service := webhooks.NewService(repository, eventTypes, memoryResolver{})
+
+		subscription, err := service.CreateSubscription(context.Background(), "https://partner.example.com", []string{"DEPOSIT_FUNDED"}, testSecret)
+		require.NoError(t, err)
+
+		subscriptions, err := service.ListSubscriptions(context.Background())
+		require.NoError(t, err)
+		require.Len(t, subscriptions, 1)
+		require.Equal(t, subscription.Id, subscriptions[0].Id)
+	})
+
+	t.Run("hosts must only resolve to public addresses", func(t *testing.T) {
+		repository := &memoryRepository{}
+		service := webhooks.NewService(repository, eventTypes, memoryResolver{
+			"internal.example.com": {netip.MustParseAddr("203.0.113.10"), netip.MustParseAddr("10.0.0.5")},
+			"unknown.example.com":  nil,
+		})
+
+		_, err := service.CreateSubscription(context.Background(), "https://internal.example.com", []string{"DEPOSIT_FUNDED"}, testSecret)
+		require.ErrorIs(t, err, webhooks.ErrPrivateDestination)
+		_, err = service.CreateSubscription(context.Background(), "https://unknown.example.com", []string{"DEPOSIT_FUNDED"}, testSecret)
+		require.ErrorIs(t, err, webhooks.ErrInvalidWebhookUrl)
+		require.Empty(t, repository.subscriptions)
+	})
+
+	t.Run("only published event types can be subscribed to", func(t *testing.T) {
+		repository := &memoryRepository{}
+		service := webhooks.NewService(repository, eventTypes, memoryResolver{})
+
+		_, err := service.CreateSubscription(context.Background(), "https://partner.example.com", []string{"DEPOSIT_FUNDED", "UNKNOWN"}, testSecret)
+		require.ErrorIs(t, err, webhooks.ErrInvalidEventType)
+		require.Empty(t, repository.subscriptions)
+	})
+}
+
+func TestServicePublish(t *testing.T) {
+	repository := &memoryRepository{}
+	service := webhooks.NewService(repository, eventTypes, memoryResolver{})
+
+	funded, err := service.CreateSubscription(context.Background(), "https://funded.example.com", []string{"DEPOSIT_FUNDED"}, testSecret)
+	require.NoError(t, err)
+	both, err := service.CreateSubscription(context.Background(), "https://both.example.com", eventTypes, testSecret)
+	require.NoError(t, err)
+
+	message, err := outbox.NewMessage("DEPOSIT_FUNDED", "deposit-id", []byte(`{}`), time.Now())
+	require.NoError(t, err)
+	reversed, err := outbox.NewMessage("RECEIPT_REVERSED", "account-id", []byte(`{}`), time.Now())
+	require.NoError(t, err)
+
+	// The outbox delivers at least once, publishing again doesn't duplicate deliveries
+	require.NoError(t, service.Publish(context.Background(), *message))
+	require.NoError(t, service.Publish(context.Background(), *message))
+	require.NoError(t, service.Publish(context.Background(), *reversed))
+
+	require.Len(t, repository.deliveries, 3)
+	require.Equal(t, funded.Id, repository.deliveries[0].SubscriptionId)
+	require.Equal(t, message.Id.String(), repository.deliveries[0].MessageId)
+	require.Equal(t, both.Id, repository.deliveries[1].SubscriptionId)
+	require.Equal(t, message.Id.String(), repository.deliveries[1].MessageId)
+	require.Equal(t, both.Id, repository.deliveries[2].SubscriptionId)
+	require.Equal(t, "RECEIPT_REVERSED", repository.deliveries[2].EventType)
+	for _, delivery := range repository.deliveries {
+		require.Equal(t, webhooks.DeliveryStatusPending, delivery.Status)
+	}
+
+	// Deleting the subscription deletes its deliveries
+	require.NoError(t, service.DeleteSubscription(context.Background(), both.Id))
+	require.Len(t, repository.deliveries, 1)
+	err = service.DeleteSubscription(context.Background(), both.Id)
+	require.ErrorIs(t, err, webhooks.ErrSubscriptionNotFound)
+}
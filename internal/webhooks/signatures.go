@@ -0,0 +1,42 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"time"
+)
+
+// Headers every delivery is sent with, the body is the event's JSON payload
+const (
+	// HeaderId is the id of the event, the same for every attempt at delivering it
+	HeaderId        = "Webhook-Id"
+	HeaderEventType = "Webhook-Event-Type"
+	// HeaderTimestamp is when the delivery was sent, in seconds since the Unix epoch
+	HeaderTimestamp = "Webhook-Timestamp"
+	// HeaderSignature is the delivery's signature, see Sign
+	HeaderSignature = "Webhook-Signature"
+)
+
+// signatureVersion prefixes signatures, so the scheme can change without subscribers mistaking one for another
+const signatureVersion = "v1="
+
+// Sign is the signature of a delivery sent at the timestamp, "v1=" followed by the hex encoded HMAC-SHA256 of the
+// timestamp, a full stop, and the payload, keyed with the subscription's secret
+//
+// Subscribers check it by computing the same, and should reject deliveries with old timestamps to stop them being
+// replayed
+func Sign(secret string, timestamp time.Time, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+
+	return signatureVersion + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify is true if the signature is the one Sign gives for the delivery
+func Verify(secret string, timestamp time.Time, payload []byte, signature string) bool {
+	return hmac.Equal([]byte(signature), []byte(Sign(secret, timestamp, payload)))
+}
@@ -0,0 +1,31 @@
+package webhooks_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/iainvm/deposits/internal/webhooks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSign(t *testing.T) {
+	const secret = "0123456789abcdef"
+	timestamp := time.Unix(1712394000, 0)
+	payload := []byte(`{"deposit_id":"1"}`)
+
+	// Computed the way a subscriber would
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(`1712394000.{"deposit_id":"1"}`))
+	expected := "v1=" + hex.EncodeToString(mac.Sum(nil))
+
+	signature := webhooks.Sign(secret, timestamp, payload)
+	require.Equal(t, expected, signature)
+	require.True(t, webhooks.Verify(secret, timestamp, payload, signature))
+
+	require.False(t, webhooks.Verify("fedcba9876543210", timestamp, payload, signature))
+	require.False(t, webhooks.Verify(secret, timestamp.Add(time.Second), payload, signature))
+	require.False(t, webhooks.Verify(secret, timestamp, []byte(`{"deposit_id":"2"}`), signature))
+}
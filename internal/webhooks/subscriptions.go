@@ -0,0 +1,111 @@
+package webhooks
+
+import (
+	"errors"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrIdGeneration          = errors.New("failed to generate id")
+	ErrSubscriptionNotFound  = errors.New("webhook subscription not found")
+	ErrInvalidWebhookUrl     = errors.New("webhook url must be an absolute http or https url")
+	ErrEventTypesRequired    = errors.New("webhook subscription needs at least one event type")
+	ErrInvalidEventType      = errors.New("invalid webhook event type given")
+	ErrInvalidWebhookSecret  = errors.New("webhook secret is too short")
+	ErrInvalidSubscriptionId = errors.New("invalid webhook subscription id given")
+)
+
+// MinSecretLength is the shortest secret deliveries can be signed with
+const MinSecretLength = 16
+
+type SubscriptionId string
+
+func newSubscriptionId() (SubscriptionId, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return "", errors.Join(ErrIdGeneration, err)
+	}
+
+	return SubscriptionId(id.String()), nil
+}
+
+func ParseSubscriptionId(id string) (SubscriptionId, error) {
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return "", errors.Join(ErrInvalidSubscriptionId, err)
+	}
+
+	return SubscriptionId(id), nil
+}
+
+func (id SubscriptionId) String() string {
+	return string(id)
+}
+
+// Subscription is a URL that's sent the events of the types it's subscribed to, signed with its secret
+type Subscription struct {
+	Id         SubscriptionId
+	Url        string
+	EventTypes []string
+	// Secret is shared with the subscriber, who uses it to check deliveries came from us
+	//
+	// It's stored as it's given, rather than hashed or encrypted by us, as every delivery's signed with it. It's never
+	// logged or returned by the API, and a leaked secret is rotated by subscribing again and deleting the old subscription
+	Secret    string
+	CreatedAt time.Time
+}
+
+// NewSubscription creates a Subscription with a new Id
+func NewSubscription(webhookUrl string, eventTypes []string, secret string, createdAt time.Time) (*Subscription, error) {
+	parsed, err := url.Parse(webhookUrl)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidWebhookUrl, err)
+	}
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, ErrInvalidWebhookUrl
+	}
+	err = validateHost(parsed.Hostname())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(eventTypes) == 0 {
+		return nil, ErrEventTypesRequired
+	}
+	for _, eventType := range eventTypes {
+		if eventType == "" {
+			return nil, ErrInvalidEventType
+		}
+	}
+
+	if len(secret) < MinSecretLength {
+		return nil, ErrInvalidWebhookSecret
+	}
+
+	// Generate Id
+	id, err := newSubscriptionId()
+	if err != nil {
+		return nil, err
+	}
+
+	// Each type is only delivered once however many times it's given
+	eventTypes = slices.Clone(eventTypes)
+	slices.Sort(eventTypes)
+
+	return &Subscription{
+		Id:         id,
+		Url:        webhookUrl,
+		EventTypes: slices.Compact(eventTypes),
+		Secret:     secret,
+		CreatedAt:  createdAt,
+	}, nil
+}
+
+// Subscribed is true if the subscription is sent events of the type
+func (subscription Subscription) Subscribed(eventType string) bool {
+	return slices.Contains(subscription.EventTypes, eventType)
+}
@@ -0,0 +1,110 @@
+package webhooks_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iainvm/deposits/internal/webhooks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSubscription(t *testing.T) {
+	const secret = "0123456789abcdef"
+	now := time.Now()
+
+	tests := map[string]struct {
+		url        string
+		eventTypes []string
+		secret     string
+		err        error
+	}{
+		"https url": {
+			url:        "https://partner.example.com/webhooks",
+			eventTypes: []string{"DEPOSIT_FUNDED"},
+			secret:     secret,
+		},
+		"http url": {
+			url:        "http://partner.example.com:9000",
+			eventTypes: []string{"DEPOSIT_FUNDED"},
+			secret:     secret,
+		},
+		"localhost": {
+			url:        "http://localhost:9000",
+			eventTypes: []string{"DEPOSIT_FUNDED"},
+			secret:     secret,
+			err:        webhooks.ErrPrivateDestination,
+		},
+		"loopback address": {
+			url:        "http://[::1]:9000",
+			eventTypes: []string{"DEPOSIT_FUNDED"},
+			secret:     secret,
+			err:        webhooks.ErrPrivateDestination,
+		},
+		"private address": {
+			url:        "https://10.0.0.5/webhooks",
+			eventTypes: []string{"DEPOSIT_FUNDED"},
+			secret:     secret,
+			err:        webhooks.ErrPrivateDestination,
+		},
+		"link local address": {
+			url:        "http://169.254.169.254/latest/meta-data",
+			eventTypes: []string{"DEPOSIT_FUNDED"},
+			secret:     secret,
+			err:        webhooks.ErrPrivateDestination,
+		},
+		"relative url": {
+			url:        "/webhooks",
+			eventTypes: []string{"DEPOSIT_FUNDED"},
+			secret:     secret,
+			err:        webhooks.ErrInvalidWebhookUrl,
+		},
+		"other scheme": {
+			url:        "ftp://partner.example.com",
+			eventTypes: []string{"DEPOSIT_FUNDED"},
+			secret:     secret,
+			err:        webhooks.ErrInvalidWebhookUrl,
+		},
+		"no event types": {
+			url:    "https://partner.example.com/webhooks",
+			secret: secret,
+			err:    webhooks.ErrEventTypesRequired,
+		},
+		"blank event type": {
+			url:        "https://partner.example.com/webhooks",
+			eventTypes: []string{""},
+			secret:     secret,
+			err:        webhooks.ErrInvalidEventType,
+		},
+		"short secret": {
+			url:        "https://partner.example.com/webhooks",
+			eventTypes: []string{"DEPOSIT_FUNDED"},
+			secret:     "too-short",
+			err:        webhooks.ErrInvalidWebhookSecret,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			subscription, err := webhooks.NewSubscription(test.url, test.eventTypes, test.secret, now)
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotEmpty(t, subscription.Id)
+			require.Equal(t, test.url, subscription.Url)
+			require.Equal(t, test.eventTypes, subscription.EventTypes)
+			require.Equal(t, now, subscription.CreatedAt)
+		})
+	}
+
+	t.Run("each event type is subscribed once", func(t *testing.T) {
+		eventTypes := []string{"RECEIPT_REVERSED", "DEPOSIT_FUNDED", "RECEIPT_REVERSED"}
+		subscription, err := webhooks.NewSubscription("https://partner.example.com", eventTypes, secret, now)
+		require.NoError(t, err)
+		require.Equal(t, []string{"DEPOSIT_FUNDED", "RECEIPT_REVERSED"}, subscription.EventTypes)
+		require.True(t, subscription.Subscribed("DEPOSIT_FUNDED"))
+		require.False(t, subscription.Subscribed("ACCOUNT_OPENED"))
+	})
+}